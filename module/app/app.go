@@ -90,6 +90,7 @@ import (
 
 	gravityparams "github.com/althea-net/cosmos-gravity-bridge/module/app/params"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity"
+	gravityclient "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
@@ -116,6 +117,8 @@ var (
 			distrclient.ProposalHandler,
 			upgradeclient.ProposalHandler,
 			upgradeclient.CancelProposalHandler,
+			gravityclient.DepositEscrowReleaseProposalHandler,
+			gravityclient.TransferMinimumProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -341,12 +344,24 @@ func NewGravityApp(
 		scopedIBCKeeper,
 	)
 
+	app.gravityKeeper = keeper.NewKeeper(
+		appCodec,
+		keys[gravitytypes.StoreKey],
+		app.GetSubspace(gravitytypes.ModuleName),
+		stakingKeeper,
+		app.bankKeeper,
+		app.distrKeeper,
+		app.slashingKeeper,
+		app.accountKeeper,
+	)
+
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
 		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
-		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.ibcKeeper.ClientKeeper))
+		AddRoute(ibchost.RouterKey, ibcclient.NewClientProposalHandler(app.ibcKeeper.ClientKeeper)).
+		AddRoute(gravitytypes.RouterKey, keeper.NewGravityProposalHandler(app.gravityKeeper))
 
 	app.govKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -377,17 +392,6 @@ func NewGravityApp(
 	)
 	app.evidenceKeeper = *evidenceKeeper
 
-	app.gravityKeeper = keeper.NewKeeper(
-		appCodec,
-		keys[gravitytypes.StoreKey],
-		app.GetSubspace(gravitytypes.ModuleName),
-		stakingKeeper,
-		app.bankKeeper,
-		app.distrKeeper,
-		app.slashingKeeper,
-		app.accountKeeper,
-	)
-
 	app.stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
 			app.distrKeeper.Hooks(),
@@ -496,10 +500,11 @@ func NewGravityApp(
 		minttypes.ModuleName,
 		crisistypes.ModuleName,
 		ibchost.ModuleName,
+		// the gentxs delivered by genutil set delegate keys, which are verified against the gravity params
+		gravitytypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
-		gravitytypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -20,6 +20,7 @@ import (
 	tmos "github.com/tendermint/tendermint/libs/os"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	gravitycli "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client/cli"
 	gravitytypes "github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -51,11 +52,13 @@ func GenTxCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, genBalI
 		Long: fmt.Sprintf(`Generate a genesis transaction that creates a validator with a self-delegation, oracle key 
 delegation and orchestrator key delegation that is signed by the key in the Keyring referenced by a given name. A node 
 ID and Bech32 consensus pubkey may optionally be provided. If they are omitted, they will be retrieved from the 
-priv_validator.json file. The following default parameters are included:
+priv_validator.json file. The Ethereum key must be in the eth keystore (see eth_keys add), it signs the delegate
+keys to prove that the validator controls it. The following default parameters are included:
     %s
 
 Example:
 $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn --home=/path/to/home/dir --keyring-backend=os --chain-id=test-chain-1 \
+    --eth-passphrase="..." \
     --moniker="myvalidator" \
     --commission-max-change-rate=0.01 \
     --commission-max-rate=1.0 \
@@ -121,9 +124,8 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 				return errors.Wrapf(err, "failed to fetch '%s' from the keyring", name)
 			}
 
-			ethAddress := args[2]
-
-			if err := gravitytypes.ValidateEthAddress(ethAddress); err != nil {
+			ethAddress, err := gravitytypes.NewEthAddress(args[2])
+			if err != nil {
 				return errors.Wrapf(err, "invalid ethereum address")
 			}
 
@@ -180,11 +182,22 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 				return errors.Wrap(err, "failed to build create-validator message")
 			}
 
-			delegateKeySetMsg := &gravitytypes.MsgSetOrchestratorAddress{
-				Validator:    sdk.ValAddress(key.GetAddress()).String(),
-				Orchestrator: orchAddress.String(),
-				EthAddress:   ethAddress,
+			// the gravity id of the genesis separates the delegate key signature from other bridges
+			var gravityGenesis gravitytypes.GenesisState
+			if err := cdc.UnmarshalJSON(genesisState[gravitytypes.ModuleName], &gravityGenesis); err != nil {
+				return errors.Wrap(err, "failed to unmarshal gravity genesis state")
+			}
+			if gravityGenesis.Params == nil {
+				return errors.Errorf("gravity genesis state in %s has no params, its gravity id is needed to sign the delegate keys", config.GenesisFile())
 			}
+			valAddress := sdk.ValAddress(key.GetAddress())
+			ethSignature, err := gravitycli.SignDelegateKeysFromKeystore(cmd, clientCtx.KeyringDir, *ethAddress,
+				gravityGenesis.Params.GravityId, valAddress.String(), orchAddress.String())
+			if err != nil {
+				return errors.Wrap(err, "failed to sign delegate keys with the ethereum key")
+			}
+
+			delegateKeySetMsg := gravitytypes.NewMsgSetOrchestratorAddress(valAddress, orchAddress, *ethAddress, ethSignature)
 
 			msgs := []sdk.Msg{msg, delegateKeySetMsg}
 
@@ -239,6 +252,7 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the genesis transaction JSON document to the given file instead of the default location")
 	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
 	cmd.Flags().AddFlagSet(fsCreateValidator)
+	gravitycli.AddEthKeystoreFlagsToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -1,8 +1,8 @@
 package gravity
 
 import (
+	"encoding/hex"
 	"fmt"
-	"sort"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/keeper"
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
@@ -17,11 +17,15 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	unhaltBridge(ctx, k, params)
 	slashing(ctx, k)
 	attestationTally(ctx, k)
+	k.TallyEthereumHeightVotes(ctx)
 	cleanupTimedOutBatches(ctx, k)
 	cleanupTimedOutLogicCalls(ctx, k)
+	createBatches(ctx, k, params)
 	createValsets(ctx, k)
 	pruneValsets(ctx, k, params)
 	pruneAttestations(ctx, k)
+	pruneTransferStatuses(ctx, k, params)
+	k.PruneEthereumHeightSamples(ctx)
 }
 
 // In the event the bridge is halted and governance has decided to reset oracle
@@ -41,6 +45,14 @@ func unhaltBridge(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	}
 }
 
+// createBatches builds the automatic batches of tokens without an active relayer requesting batches
+func createBatches(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+	if !params.BridgeActive {
+		return
+	}
+	k.BuildAutoBatches(ctx, params)
+}
+
 func createValsets(ctx sdk.Context, k keeper.Keeper) {
 	// Auto ValsetRequest Creation.
 	// WARNING: do not use k.GetLastObservedValset in this function, it *will* result in losing control of the bridge
@@ -103,11 +115,12 @@ func slashing(ctx sdk.Context, k keeper.Keeper) {
 	valsetSlashing(ctx, k, params)
 	batchSlashing(ctx, k, params)
 	logicCallSlashing(ctx, k, params)
+	oracleLivenessSlashing(ctx, k, params)
 }
 
-// Iterate over all attestations currently being voted on in order of nonce and
+// Iterate over the attestations of the event nonces after the last observed one in order of nonce and
 // "Observe" those who have passed the threshold. Break the loop once we see
-// an attestation that has not passed the threshold
+// a nonce with no attestation that has passed the threshold
 func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 	params := k.GetParams(ctx)
 	// bridge is currently disabled, do not process attestations from Ethereum
@@ -115,43 +128,24 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper) {
 		return
 	}
 
-	attmap := k.GetAttestationMapping(ctx)
-	// We make a slice with all the event nonces that are in the attestation mapping
-	keys := make([]uint64, 0, len(attmap))
-	for k := range attmap {
-		keys = append(keys, k)
-	}
-	// Then we sort it
-	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
-
-	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
-	// a slice with one or more attestations at that event nonce. There can be multiple attestations
-	// at one event nonce when validators disagree about what event happened at that nonce.
-	for _, nonce := range keys {
-		// This iterates over all attestations at a particular event nonce.
-		// They are ordered by when the first attestation at the event nonce was received.
-		// This order is not important.
-		for _, att := range attmap[nonce] {
-			// We check if the event nonce is exactly 1 higher than the last attestation that was
-			// observed. If it is not, we just move on to the next nonce. This will skip over all
-			// attestations that have already been observed.
-			//
-			// Once we hit an event nonce that is one higher than the last observed event, we stop
-			// skipping over this conditional and start calling tryAttestation (counting votes)
-			// Once an attestation at a given event nonce has enough votes and becomes observed,
-			// every other attestation at that nonce will be skipped, since the lastObservedEventNonce
-			// will be incremented.
-			//
-			// Then we go to the next event nonce in the attestation mapping, if there is one. This
-			// nonce will once again be one higher than the lastObservedEventNonce.
-			// If there is an attestation at this event nonce which has enough votes to be observed,
-			// we skip the other attestations and move on to the next nonce again.
-			// If no attestation becomes observed, when we get to the next nonce, every attestation in
-			// it will be skipped. The same will happen for every nonce after that.
-			if nonce == uint64(k.GetLastObservedEventNonce(ctx))+1 {
-				k.TryAttestation(ctx, &att)
+	// Attestations at or below the last observed event nonce have already been tallied, so we start at
+	// the next event nonce and only look at the attestations stored for that nonce. There can be multiple
+	// attestations at one event nonce when validators disagree about what event happened at that nonce.
+	for nonce := k.GetLastObservedEventNonce(ctx) + 1; ; nonce++ {
+		atts := k.GetAttestationsByNonce(ctx, nonce)
+		// The attestations at a nonce are ordered by their claim hash, this order is not important.
+		// Once an attestation at this nonce has enough votes and becomes observed, every other
+		// attestation at this nonce is skipped, since the lastObservedEventNonce will be incremented.
+		for i := range atts {
+			if k.GetLastObservedEventNonce(ctx) == nonce-1 {
+				k.TryAttestation(ctx, &atts[i])
 			}
 		}
+		// If no attestation at this nonce became observed (or there are none) no later nonce can be
+		// observed either, so we are done until more votes come in
+		if k.GetLastObservedEventNonce(ctx) != nonce {
+			return
+		}
 	}
 }
 
@@ -201,18 +195,20 @@ func prepValsetConfirms(ctx sdk.Context, k keeper.Keeper, nonce uint64) map[stri
 	// bytes are incomparable in go, so we convert the sdk.ValAddr bytes to a string
 	ret := make(map[string]types.MsgValsetConfirm)
 	for _, confirm := range confirms {
-		// TODO this presents problems for delegate key rotation see issue #344
+		// the orchestrator may have been rotated since the confirm was submitted,
+		// in which case it is resolved through the historical delegate keys
 		confVal, _ := sdk.AccAddressFromBech32(confirm.Orchestrator)
-		val, foundValidator := k.GetOrchestratorValidator(ctx, confVal)
+		val, foundValidator := k.GetValidatorAddressByOrchestrator(ctx, confVal)
 		if !foundValidator {
 			panic("Confirm from validator we can't identify?")
 		}
-		ret[val.GetOperator().String()] = confirm
+		ret[val.String()] = confirm
 	}
 	return ret
 }
 
-// valsetSlashing slashes validators who have not signed validator sets during the signing window
+// valsetSlashing slashes validators who have not signed validator sets during the signing window once they
+// have missed too many confirmations in their ConfirmSigningWindow
 func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	// don't slash in the beginning before there aren't even SignedValsetsWindow blocks yet
 	if uint64(ctx.BlockHeight()) <= params.SignedValsetsWindow {
@@ -239,11 +235,12 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 			if exist && startedBeforeValsetCreated {
 				// Check if validator has confirmed valset or not
 				_, found := confirms[val.GetOperator().String()]
-				// slash validators for not confirming valsets
-				if !found {
+				// slash validators for missing too many confirmations in their signing window
+				if k.HandleConfirmSignature(ctx, val.GetOperator(), found) {
 					// refresh validator before slashing/jailing
 					val = updateValidator(ctx, k, val.GetOperator())
 					k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionValset)
+					k.RecordSlash(ctx, val.GetOperator(), types.SLASH_REASON_VALSET_SIGNATURE, vs.Nonce, "", params.SlashFractionValset, !val.IsJailed())
 					ctx.EventManager().EmitEvent(
 						sdk.NewEvent(
 							sdk.EventTypeMessage,
@@ -278,11 +275,12 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 				// Check if validator has confirmed valset or not
 				_, found := confirms[validator.GetOperator().String()]
 
-				// slash validators for not confirming valsets
-				if !found {
+				// slash validators for missing too many confirmations in their signing window
+				if k.HandleConfirmSignature(ctx, validator.GetOperator(), found) {
 					// refresh validator before slashing/jailing
 					validator = updateValidator(ctx, k, validator.GetOperator())
 					k.StakingKeeper.Slash(ctx, valConsAddr, ctx.BlockHeight(), validator.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionValset)
+					k.RecordSlash(ctx, validator.GetOperator(), types.SLASH_REASON_VALSET_SIGNATURE, vs.Nonce, "", params.SlashFractionValset, !validator.IsJailed())
 					ctx.EventManager().EmitEvent(
 						sdk.NewEvent(
 							sdk.EventTypeMessage,
@@ -300,6 +298,88 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	}
 }
 
+// oracleLivenessSlashing slashes and jails validators which have not submitted a claim for an event that was
+// first claimed more than OracleLivenessWindow blocks ago and has since been observed. A validator is only slashed
+// once for falling behind, it can be slashed again after it has caught up with the events it missed
+func oracleLivenessSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+	// don't slash in the beginning before there aren't even OracleLivenessWindow blocks yet
+	if params.OracleLivenessWindow == 0 || uint64(ctx.BlockHeight()) <= params.OracleLivenessWindow {
+		return
+	}
+	cutoff := uint64(ctx.BlockHeight()) - params.OracleLivenessWindow
+
+	// missedClaim returns the first observed event the validator missed the window for and its nonce,
+	// nil if it has not missed any or has already been slashed for falling behind
+	missedClaim := func(val stakingtypes.Validator, startHeight int64) (*types.Attestation, uint64) {
+		valAddr := val.GetOperator()
+		if k.GetOracleLivenessSlash(ctx, valAddr) > k.GetLastEventNonceByValidator(ctx, valAddr) {
+			return nil, 0
+		}
+		att := k.GetFirstMissedClaim(ctx, valAddr, startHeight)
+		if att == nil || att.Height >= cutoff {
+			return nil, 0
+		}
+		claim, err := k.UnpackAttestationClaim(att)
+		if err != nil {
+			panic("could not cast to claim")
+		}
+		return att, claim.GetEventNonce()
+	}
+
+	// SLASH BONDED VALIDTORS who didn't claim observed events
+	for _, val := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		consAddr, _ := val.GetConsAddr()
+		valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		if !exist {
+			continue
+		}
+		//  Slash validator ONLY for events first claimed after he joined
+		att, nonce := missedClaim(val, valSigningInfo.StartHeight)
+		if att == nil {
+			continue
+		}
+		// refresh validator before slashing/jailing
+		val = updateValidator(ctx, k, val.GetOperator())
+		slashOracleLiveness(ctx, k, params, val, consAddr, nonce)
+	}
+
+	// SLASH UNBONDING VALIDATORS who didn't claim observed events
+	for _, valAddr := range getUnbondingValidators(ctx, k) {
+		addr, err := sdk.ValAddressFromBech32(valAddr)
+		if err != nil {
+			panic(err)
+		}
+		validator, _ := k.StakingKeeper.GetValidator(ctx, sdk.ValAddress(addr))
+		valConsAddr, _ := validator.GetConsAddr()
+		valSigningInfo, exist := k.SlashingKeeper.GetValidatorSigningInfo(ctx, valConsAddr)
+		if !exist || !validator.IsUnbonding() {
+			continue
+		}
+		// Only slash unbonding validators for events claimed before the UNBOND_SLASHING_WINDOW passed
+		att, nonce := missedClaim(validator, valSigningInfo.StartHeight)
+		if att == nil || att.Height >= uint64(validator.UnbondingHeight)+params.UnbondSlashingValsetsWindow {
+			continue
+		}
+		slashOracleLiveness(ctx, k, params, validator, valConsAddr, nonce)
+	}
+}
+
+// slashOracleLiveness slashes and jails a validator for not claiming the event at nonce
+func slashOracleLiveness(ctx sdk.Context, k keeper.Keeper, params types.Params, val stakingtypes.Validator, consAddr sdk.ConsAddress, nonce uint64) {
+	k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionOracleLiveness)
+	k.RecordSlash(ctx, val.GetOperator(), types.SLASH_REASON_ORACLE_LIVENESS, nonce, "", params.SlashFractionOracleLiveness, !val.IsJailed())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute("OracleLivenessSlashing", consAddr.String()),
+		),
+	)
+	if !val.IsJailed() {
+		k.StakingKeeper.Jail(ctx, consAddr)
+	}
+	k.SetOracleLivenessSlash(ctx, val.GetOperator(), nonce)
+}
+
 // updateValidator is a very specific utility function, used to update the validator object during
 // slashing loops. This allows us to load the validators list at the start of our slashing and only
 // pull in individual validators as needed to check that we are not jailing them twice, or slashing
@@ -337,13 +417,14 @@ func prepBatchConfirms(ctx sdk.Context, k keeper.Keeper, batch types.InternalOut
 	// bytes are incomparable in go, so we convert the sdk.ValAddr bytes to a string (note this is NOT bech32)
 	ret := make(map[string]types.MsgConfirmBatch)
 	for _, confirm := range confirms {
-		// TODO this presents problems for delegate key rotation see issue #344
+		// the orchestrator may have been rotated since the confirm was submitted,
+		// in which case it is resolved through the historical delegate keys
 		confVal, _ := sdk.AccAddressFromBech32(confirm.Orchestrator)
-		val, foundValidator := k.GetOrchestratorValidator(ctx, confVal)
+		val, foundValidator := k.GetValidatorAddressByOrchestrator(ctx, confVal)
 		if !foundValidator {
 			panic("Confirm from validator we can't identify?")
 		}
-		ret[val.GetOperator().String()] = confirm
+		ret[val.String()] = confirm
 	}
 	return ret
 }
@@ -379,11 +460,12 @@ func batchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 			if exist && startedBeforeBatchCreated {
 				// check if validator confirmed the batch
 				_, found := confirms[val.GetOperator().String()]
-				// slashing for not confirming the batch
-				if !found {
+				// slashing for missing too many confirmations in the signing window
+				if k.HandleConfirmSignature(ctx, val.GetOperator(), found) {
 					// refresh validator before slashing/jailing
 					val = updateValidator(ctx, k, val.GetOperator())
 					k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBatch)
+					k.RecordSlash(ctx, val.GetOperator(), types.SLASH_REASON_BATCH_SIGNATURE, batch.BatchNonce, batch.TokenContract.GetAddress(), params.SlashFractionBatch, !val.IsJailed())
 					ctx.EventManager().EmitEvent(
 						sdk.NewEvent(
 							sdk.EventTypeMessage,
@@ -409,13 +491,14 @@ func prepLogicCallConfirms(ctx sdk.Context, k keeper.Keeper, call types.Outgoing
 	// bytes are incomparable in go, so we convert the sdk.ValAddr bytes to a string (note this is NOT bech32)
 	ret := make(map[string]*types.MsgConfirmLogicCall)
 	for _, confirm := range confirms {
-		// TODO this presents problems for delegate key rotation see issue #344
+		// the orchestrator may have been rotated since the confirm was submitted,
+		// in which case it is resolved through the historical delegate keys
 		confVal, _ := sdk.AccAddressFromBech32(confirm.Orchestrator)
-		val, foundValidator := k.GetOrchestratorValidator(ctx, confVal)
+		val, foundValidator := k.GetValidatorAddressByOrchestrator(ctx, confVal)
 		if !foundValidator {
 			panic("Confirm from validator we can't identify?")
 		}
-		ret[val.GetOperator().String()] = &confirm
+		ret[val.String()] = &confirm
 	}
 	return ret
 }
@@ -451,10 +534,12 @@ func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 			if exist && startedBeforeCallCreated {
 				// check that the validator confirmed the logic call
 				_, found := confirms[val.GetOperator().String()]
-				if !found {
+				// slashing for missing too many confirmations in the signing window
+				if k.HandleConfirmSignature(ctx, val.GetOperator(), found) {
 					// refresh validator before slashing/jailing
 					val = updateValidator(ctx, k, val.GetOperator())
 					k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionLogicCall)
+					k.RecordSlash(ctx, val.GetOperator(), types.SLASH_REASON_LOGIC_CALL_SIGNATURE, call.InvalidationNonce, hex.EncodeToString(call.InvalidationId), params.SlashFractionLogicCall, !val.IsJailed())
 					ctx.EventManager().EmitEvent(
 						sdk.NewEvent(
 							sdk.EventTypeMessage,
@@ -472,21 +557,12 @@ func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params) {
 	}
 }
 
-// Iterate over all attestations currently being voted on in order of nonce
+// Iterate over the attestations in order of nonce
 // and prune those that are older than the current nonce and no longer have any
 // use. This could be combined with create attestation and save some computation
 // but (A) pruning keeps the iteration small in the first place and (B) there is
 // already enough nuance in the other handler that it's best not to complicate it further
 func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
-	attmap := k.GetAttestationMapping(ctx)
-	// We make a slice with all the event nonces that are in the attestation mapping
-	keys := make([]uint64, 0, len(attmap))
-	for k := range attmap {
-		keys = append(keys, k)
-	}
-	// Then we sort it
-	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
-
 	// we delete all attestations earlier than the current event nonce
 	// minus some buffer value. This buffer value is purely to allow
 	// frontends and other UI components to view recent oracle history
@@ -499,24 +575,20 @@ func pruneAttestations(ctx sdk.Context, k keeper.Keeper) {
 		cutoff = lastNonce - eventsToKeep
 	}
 
-	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
-	// a slice with one or more attestations at that event nonce. There can be multiple attestations
-	// at one event nonce when validators disagree about what event happened at that nonce.
-	for _, nonce := range keys {
-		// This iterates over all attestations at a particular event nonce.
-		// They are ordered by when the first attestation at the event nonce was received.
-		// This order is not important.
-		for _, att := range attmap[nonce] {
-			// delete all before the cutoff
-			if nonce < cutoff {
-				k.DeleteAttestation(ctx, att)
-			}
-		}
+	// Attestations are stored in nonce order, so only the attestations before the cutoff are visited.
+	// Since this runs every block that is usually just the nonce which fell out of the buffer
+	var expired []types.Attestation
+	k.IterateAttestationsByNonce(ctx, 0, cutoff, func(_ []byte, att types.Attestation) bool {
+		expired = append(expired, att)
+		return false
+	})
+	for _, att := range expired {
+		k.DeleteAttestation(ctx, att)
 	}
 }
 
-// Iterate over all attestations currently being voted on in order of nonce
-// and prune those that are older than nonceCutoff
+// Iterate over the attestations in order of nonce
+// and prune those that are newer than nonceCutoff
 func pruneAttestationsAfterNonce(ctx sdk.Context, k keeper.Keeper, nonceCutoff uint64) {
 	// Decide on the most recent nonce we can actually roll back to
 	lastObserved := k.GetLastObservedEventNonce(ctx)
@@ -525,14 +597,12 @@ func pruneAttestationsAfterNonce(ctx sdk.Context, k keeper.Keeper, nonceCutoff u
 		return
 	}
 
-	// Get relevant event nonces
-	attmap := k.GetAttestationMapping(ctx)
-	keys := make([]uint64, 0, len(attmap))
-	for k := range attmap {
-		keys = append(keys, k)
-	}
-	// Sort the nonces for iteration
-	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	// Get the reverted attestations, which are every attestation after the cutoff event nonce
+	var reverted []types.Attestation
+	k.IterateAttestationsByNonce(ctx, nonceCutoff+1, 0, func(_ []byte, att types.Attestation) bool {
+		reverted = append(reverted, att)
+		return false
+	})
 
 	// Discover all affected validators whose LastEventNonce must be reset to nonceCutoff
 
@@ -544,20 +614,15 @@ func pruneAttestationsAfterNonce(ctx sdk.Context, k keeper.Keeper, nonceCutoff u
 	affectedValidatorsSet := make(map[string]void, numValidators)
 
 	// Delete all reverted attestations, keeping track of the validators who attested to any of them
-	for _, nonce := range keys {
-		for _, att := range attmap[nonce] {
-			// we delete all attestations earlier than the cutoff event nonce
-			if nonce > nonceCutoff {
-				ctx.Logger().Info(fmt.Sprintf("Deleting attestation at height %v", att.Height))
-				for _, vote := range att.Votes {
-					if _, ok := affectedValidatorsSet[vote]; !ok { // if set does not contain vote
-						affectedValidatorsSet[vote] = setMember // add key to set
-					}
-				}
-
-				k.DeleteAttestation(ctx, att)
+	for _, att := range reverted {
+		ctx.Logger().Info(fmt.Sprintf("Deleting attestation at height %v", att.Height))
+		for _, vote := range att.Votes {
+			if _, ok := affectedValidatorsSet[vote]; !ok { // if set does not contain vote
+				affectedValidatorsSet[vote] = setMember // add key to set
 			}
 		}
+
+		k.DeleteAttestation(ctx, att)
 	}
 
 	// Reset the last event nonce for all validators affected by history deletion
@@ -573,3 +638,9 @@ func pruneAttestationsAfterNonce(ctx sdk.Context, k keeper.Keeper, nonceCutoff u
 		}
 	}
 }
+
+// Expired transfer statuses are pruned so that the status of every executed or cancelled
+// transfer is not kept in state forever
+func pruneTransferStatuses(ctx sdk.Context, k keeper.Keeper, params types.Params) {
+	k.PruneTransferStatuses(ctx, params.TransferStatusRetentionBlocks)
+}
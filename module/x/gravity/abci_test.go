@@ -1,7 +1,9 @@
 package gravity
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -106,6 +108,72 @@ func TestValsetSlashing_ValsetCreated_After_ValidatorBonded(t *testing.T) {
 
 }
 
+func TestValsetSlashing_SigningWindow(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+
+	// validators may miss up to half of the last 4 signing requests
+	params.ConfirmSigningWindow = 4
+	params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+	pk.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedValsetsWindow) + 2)
+	height := uint64(ctx.BlockHeight()) - (params.SignedValsetsWindow + 1)
+
+	// the first validator signs none of the valsets, the second misses only the first one
+	storeValset := func(missedBy ...int) {
+		vs := pk.GetCurrentValset(ctx)
+		vs.Height = height
+		vs.Nonce = pk.GetLatestValsetNonce(ctx) + 1
+		pk.StoreValsetUnsafe(ctx, vs)
+		for i, orch := range keeper.OrchAddrs {
+			missed := false
+			for _, m := range missedBy {
+				missed = missed || m == i
+			}
+			if missed {
+				continue
+			}
+			ethAddr, err := types.NewEthAddress(keeper.EthAddrs[i].String())
+			require.NoError(t, err)
+			pk.SetValsetConfirm(ctx, *types.NewMsgValsetConfirm(vs.Nonce, *ethAddr, orch, "dummysig"))
+		}
+	}
+	storeValset(0, 1)
+	storeValset(0)
+	storeValset(0)
+	valsetSlashing(ctx, pk, params)
+
+	// nobody is slashed before a full window has been counted
+	for _, valAddr := range keeper.ValAddrs {
+		require.False(t, input.StakingKeeper.Validator(ctx, valAddr).IsJailed())
+	}
+	info, found := pk.GetConfirmSigningInfo(ctx, keeper.ValAddrs[0])
+	require.True(t, found)
+	assert.Equal(t, uint64(3), info.IndexOffset)
+	assert.Equal(t, uint64(3), info.MissedConfirmsCounter)
+	info, found = pk.GetConfirmSigningInfo(ctx, keeper.ValAddrs[1])
+	require.True(t, found)
+	assert.Equal(t, uint64(1), info.MissedConfirmsCounter)
+
+	// the first validator breaches the window and starts over, a single miss is not slashed
+	storeValset(0)
+	valsetSlashing(ctx, pk, params)
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1]).IsJailed())
+	info, _ = pk.GetConfirmSigningInfo(ctx, keeper.ValAddrs[0])
+	assert.Equal(t, uint64(0), info.IndexOffset)
+	assert.Equal(t, uint64(0), info.MissedConfirmsCounter)
+
+	// the missed confirmation of the second validator drops out of its window
+	storeValset()
+	valsetSlashing(ctx, pk, params)
+	info, _ = pk.GetConfirmSigningInfo(ctx, keeper.ValAddrs[1])
+	assert.Equal(t, uint64(5), info.IndexOffset)
+	assert.Equal(t, uint64(0), info.MissedConfirmsCounter)
+}
+
 func TestValsetSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testing.T) {
 	//	Slashing Conditions for Unbonding Validator
 
@@ -235,6 +303,404 @@ func TestBatchSlashing(t *testing.T) {
 	lastSlashedBatchBlock := input.GravityKeeper.GetLastSlashedBatchBlock(ctx)
 	assert.Equal(t, lastSlashedBatchBlock, batch.Block)
 
+	// the slash is recorded in the slashing history of the validator and its reason
+	byVal, err := pk.SlashingHistoryByValidator(sdk.WrapSDKContext(ctx), &types.QuerySlashingHistoryByValidatorRequest{
+		ValidatorAddress: keeper.ValAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, byVal.Slashes, 1)
+	record := byVal.Slashes[0]
+	assert.Equal(t, types.SlashRecord{
+		Id:           1,
+		Validator:    keeper.ValAddrs[0].String(),
+		Reason:       types.SLASH_REASON_BATCH_SIGNATURE,
+		SubjectNonce: batch.BatchNonce,
+		SubjectToken: keeper.TokenContractAddrs[0],
+		BlockHeight:  uint64(ctx.BlockHeight()),
+		Fraction:     params.SlashFractionBatch,
+		Jailed:       true,
+	}, record)
+	byVal, err = pk.SlashingHistoryByValidator(sdk.WrapSDKContext(ctx), &types.QuerySlashingHistoryByValidatorRequest{
+		ValidatorAddress: keeper.ValAddrs[1].String(),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, byVal.Slashes)
+
+	byReason, err := pk.SlashingHistoryByReason(sdk.WrapSDKContext(ctx), &types.QuerySlashingHistoryByReasonRequest{
+		Reason: types.SLASH_REASON_BATCH_SIGNATURE,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []types.SlashRecord{record}, byReason.Slashes)
+	byReason, err = pk.SlashingHistoryByReason(sdk.WrapSDKContext(ctx), &types.QuerySlashingHistoryByReasonRequest{
+		Reason: types.SLASH_REASON_VALSET_SIGNATURE,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, byReason.Slashes)
+}
+
+func TestConfirmPruning(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedValsetsWindow) + 2)
+
+	// a valset which is older than the slashing window and has been superseded on Ethereum
+	vs := pk.GetCurrentValset(ctx)
+	vs.Height = uint64(ctx.BlockHeight()) - (params.SignedValsetsWindow + 1)
+	vs.Nonce = pk.GetLatestValsetNonce(ctx) + 1
+	pk.StoreValsetUnsafe(ctx, vs)
+	pk.SetLastObservedValset(ctx, types.Valset{Nonce: vs.Nonce + 1, Members: vs.Members, RewardToken: types.ZeroAddressString})
+
+	batch, err := types.NewInternalOutgingTxBatchFromExternalBatch(types.OutgoingTxBatch{
+		BatchNonce:    1,
+		BatchTimeout:  0,
+		Transactions:  []types.OutgoingTransferTx{},
+		TokenContract: keeper.TokenContractAddrs[0],
+		Block:         uint64(ctx.BlockHeight()),
+	})
+	require.NoError(t, err)
+	pk.StoreBatchUnsafe(ctx, *batch)
+
+	call := types.OutgoingLogicCall{
+		Transfers:            []types.ERC20Token{},
+		Fees:                 []types.ERC20Token{},
+		LogicContractAddress: "0x510ab76899430424d209a6c9a5b9951fb8a6f47d",
+		Payload:              []byte("fake bytes"),
+		Timeout:              10000,
+		InvalidationId:       []byte("GravityTesting"),
+		InvalidationNonce:    1,
+	}
+	pk.SetOutgoingLogicCall(ctx, call)
+
+	for i, orch := range keeper.OrchAddrs {
+		ethAddr, err := types.NewEthAddress(keeper.EthAddrs[i].String())
+		require.NoError(t, err)
+		pk.SetValsetConfirm(ctx, *types.NewMsgValsetConfirm(vs.Nonce, *ethAddr, orch, "dummysig"))
+		pk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+			Nonce:         batch.BatchNonce,
+			TokenContract: keeper.TokenContractAddrs[0],
+			EthSigner:     ethAddr.GetAddress(),
+			Orchestrator:  orch.String(),
+			Signature:     "dummysig",
+		})
+		pk.SetLogicCallConfirm(ctx, &types.MsgConfirmLogicCall{
+			InvalidationId:    hex.EncodeToString(call.InvalidationId),
+			InvalidationNonce: call.InvalidationNonce,
+			EthSigner:         ethAddr.GetAddress(),
+			Orchestrator:      orch.String(),
+			Signature:         "dummysig",
+		})
+	}
+
+	// the valset confirms are pruned with the valset once it has been slashed
+	EndBlocker(ctx, pk)
+	require.Nil(t, pk.GetValset(ctx, vs.Nonce))
+	assert.Empty(t, pk.GetValsetConfirms(ctx, vs.Nonce))
+	for i := range keeper.ValAddrs {
+		require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[i]).IsJailed())
+	}
+
+	// the batch and logic call confirms are kept while their subject can still be relayed
+	assert.Len(t, pk.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract), len(keeper.OrchAddrs))
+	assert.Len(t, pk.GetLogicConfirmByInvalidationIDAndNonce(ctx, call.InvalidationId, call.InvalidationNonce), len(keeper.OrchAddrs))
+
+	require.NoError(t, pk.CancelOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce))
+	require.NoError(t, pk.CancelOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce))
+	assert.Empty(t, pk.GetBatchConfirmByNonceAndTokenContract(ctx, batch.BatchNonce, batch.TokenContract))
+	assert.Empty(t, pk.GetLogicConfirmByInvalidationIDAndNonce(ctx, call.InvalidationId, call.InvalidationNonce))
+}
+
+func TestAttestationTally(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	h := NewHandler(pk)
+	receiver, _ := sdk.AccAddressFromBech32("gravity16ahjkfqxpp6lvfy9fpfnfjg39xr96qet0l08hu")
+
+	claim := func(nonce uint64) types.MsgSendToCosmosClaim {
+		return types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    nonce,
+			TokenContract:  keeper.TokenContractAddrs[0],
+			Amount:         sdk.NewInt(100),
+			EthereumSender: keeper.EthAddrs[0].String(),
+			CosmosReceiver: receiver.String(),
+		}
+	}
+	vote := func(msg types.MsgSendToCosmosClaim, orchs []sdk.AccAddress) {
+		for _, orch := range orchs {
+			msg.Orchestrator = orch.String()
+			_, err := h(ctx, &msg)
+			require.NoError(t, err)
+		}
+	}
+
+	// several pending nonces are observed in the same block
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		sendSendToCosmosClaim(claim(nonce), ctx, h, t)
+	}
+	EndBlocker(ctx, pk)
+	assert.Equal(t, uint64(3), pk.GetLastObservedEventNonce(ctx))
+
+	// a nonce without enough votes holds back the tally
+	vote(claim(4), keeper.OrchAddrs[:2])
+	EndBlocker(ctx, pk)
+	assert.Equal(t, uint64(3), pk.GetLastObservedEventNonce(ctx))
+	assert.False(t, pk.GetAttestationsByNonce(ctx, 4)[0].Observed)
+
+	// until it has been voted on by the rest, then the following nonce is observed as well
+	vote(claim(4), keeper.OrchAddrs[2:])
+	sendSendToCosmosClaim(claim(5), ctx, h, t)
+	EndBlocker(ctx, pk)
+	assert.Equal(t, uint64(5), pk.GetLastObservedEventNonce(ctx))
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		atts := pk.GetAttestationsByNonce(ctx, nonce)
+		require.Len(t, atts, 1)
+		assert.True(t, atts[0].Observed)
+	}
+}
+
+func TestAttestationPowerSnapshot(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	h := NewHandler(pk)
+	receiver, _ := sdk.AccAddressFromBech32("gravity16ahjkfqxpp6lvfy9fpfnfjg39xr96qet0l08hu")
+
+	// four of the five validators vote while they hold equal power
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  keeper.TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: receiver.String(),
+	}
+	for _, orch := range keeper.OrchAddrs[:4] {
+		claim.Orchestrator = orch.String()
+		_, err := h(ctx, &claim)
+		require.NoError(t, err)
+	}
+
+	// before the tally half of the voters redelegate to the validator who did not vote,
+	// leaving the votes short of the current threshold
+	for _, val := range keeper.ValAddrs[:2] {
+		input.StakingKeeper.SetLastValidatorPower(ctx, val, 0)
+	}
+	input.StakingKeeper.SetLastValidatorPower(ctx, keeper.ValAddrs[4], 30)
+
+	res, err := pk.AttestationVoteWeights(sdk.WrapSDKContext(ctx), &types.QueryAttestationVoteWeightsRequest{EventNonce: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Attestations, 1)
+	weights := res.Attestations[0]
+	require.Len(t, weights.Votes, 4)
+	for _, vote := range weights.Votes {
+		assert.Equal(t, int64(10), vote.SnapshotPower)
+		assert.True(t, sdk.NewInt(50).Equal(vote.SnapshotTotalPower))
+	}
+	assert.Equal(t, sdk.NewDecWithPrec(8, 1), weights.SnapshotWeight)
+	assert.Equal(t, sdk.NewDecWithPrec(4, 1), weights.CurrentWeight)
+	assert.Equal(t, sdk.NewDecWithPrec(66, 2), weights.Threshold)
+	assert.Equal(t, types.ATTESTATION_POWER_POLICY_SNAPSHOT, res.Policy)
+
+	// tallying with the current power does not observe the attestation
+	params := pk.GetParams(ctx)
+	params.AttestationPowerPolicy = types.ATTESTATION_POWER_POLICY_CURRENT
+	pk.SetParams(ctx, params)
+	attestationTally(ctx, pk)
+	assert.Equal(t, uint64(0), pk.GetLastObservedEventNonce(ctx))
+
+	// while the votes keep the power they were cast with under the snapshot policy
+	params.AttestationPowerPolicy = types.ATTESTATION_POWER_POLICY_SNAPSHOT
+	pk.SetParams(ctx, params)
+	attestationTally(ctx, pk)
+	assert.Equal(t, uint64(1), pk.GetLastObservedEventNonce(ctx))
+}
+
+func TestAttestationThresholdByClaimType(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	h := NewHandler(pk)
+	receiver, _ := sdk.AccAddressFromBech32("gravity16ahjkfqxpp6lvfy9fpfnfjg39xr96qet0l08hu")
+
+	// deposits require the votes of more than four of the five validators
+	params := pk.GetParams(ctx)
+	for i := range params.AttestationThresholds {
+		if params.AttestationThresholds[i].ClaimType == types.CLAIM_TYPE_SEND_TO_COSMOS {
+			params.AttestationThresholds[i].Threshold = sdk.NewDecWithPrec(9, 1)
+		}
+	}
+	pk.SetParams(ctx, params)
+	assert.Equal(t, sdk.NewDecWithPrec(9, 1), pk.GetAttestationThreshold(ctx, types.CLAIM_TYPE_SEND_TO_COSMOS))
+	assert.Equal(t, types.DefaultAttestationThreshold(), pk.GetAttestationThreshold(ctx, types.CLAIM_TYPE_VALSET_UPDATED))
+
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  keeper.TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: receiver.String(),
+	}
+	for _, orch := range keeper.OrchAddrs[:4] {
+		claim.Orchestrator = orch.String()
+		_, err := h(ctx, &claim)
+		require.NoError(t, err)
+	}
+	EndBlocker(ctx, pk)
+	assert.Equal(t, uint64(0), pk.GetLastObservedEventNonce(ctx))
+
+	claim.Orchestrator = keeper.OrchAddrs[4].String()
+	_, err := h(ctx, &claim)
+	require.NoError(t, err)
+	EndBlocker(ctx, pk)
+	assert.Equal(t, uint64(1), pk.GetLastObservedEventNonce(ctx))
+}
+
+// Tests that an attestation needs more than its threshold, votes holding exactly the threshold do not observe it
+func TestAttestationThresholdIsExclusive(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	h := NewHandler(pk)
+	receiver, _ := sdk.AccAddressFromBech32("gravity16ahjkfqxpp6lvfy9fpfnfjg39xr96qet0l08hu")
+
+	params := pk.GetParams(ctx)
+	for i := range params.AttestationThresholds {
+		if params.AttestationThresholds[i].ClaimType == types.CLAIM_TYPE_SEND_TO_COSMOS {
+			params.AttestationThresholds[i].Threshold = sdk.NewDecWithPrec(6, 1)
+		}
+	}
+	pk.SetParams(ctx, params)
+
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  keeper.TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: receiver.String(),
+	}
+	// three of the five equally powered validators hold exactly the threshold
+	for _, orch := range keeper.OrchAddrs[:3] {
+		claim.Orchestrator = orch.String()
+		_, err := h(ctx, &claim)
+		require.NoError(t, err)
+	}
+	EndBlocker(ctx, pk)
+	assert.Equal(t, uint64(0), pk.GetLastObservedEventNonce(ctx))
+
+	claim.Orchestrator = keeper.OrchAddrs[3].String()
+	_, err := h(ctx, &claim)
+	require.NoError(t, err)
+	EndBlocker(ctx, pk)
+	assert.Equal(t, uint64(1), pk.GetLastObservedEventNonce(ctx))
+}
+
+func TestOracleLivenessSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	params := pk.GetParams(ctx)
+	h := NewHandler(pk)
+	receiver, _ := sdk.AccAddressFromBech32("gravity16ahjkfqxpp6lvfy9fpfnfjg39xr96qet0l08hu")
+
+	// the last validator does not claim the event, which is observed without it
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  keeper.TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: receiver.String(),
+	}
+	for _, orch := range keeper.OrchAddrs[:4] {
+		claim.Orchestrator = orch.String()
+		_, err := h(ctx, &claim)
+		require.NoError(t, err)
+	}
+	attestationTally(ctx, pk)
+	require.Equal(t, uint64(1), pk.GetLastObservedEventNonce(ctx))
+
+	// it is not slashed while the window is open
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.OracleLivenessWindow))
+	oracleLivenessSlashing(ctx, pk, params)
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).IsJailed())
+
+	// but is slashed and jailed once it has passed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	oracleLivenessSlashing(ctx, pk, params)
+	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4])
+	require.True(t, val.IsJailed())
+	assert.Equal(t, uint64(1), pk.GetOracleLivenessSlash(ctx, keeper.ValAddrs[4]))
+	for _, valAddr := range keeper.ValAddrs[:4] {
+		assert.False(t, input.StakingKeeper.Validator(ctx, valAddr).IsJailed())
+		assert.Equal(t, uint64(0), pk.GetOracleLivenessSlash(ctx, valAddr))
+	}
+
+	// it is only slashed once for falling behind
+	tokens := val.GetTokens()
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	oracleLivenessSlashing(ctx, pk, params)
+	assert.Equal(t, tokens, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).GetTokens())
+}
+
+func TestConflictingClaimSlashing(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	h := NewHandler(pk)
+	receiver, _ := sdk.AccAddressFromBech32("gravity16ahjkfqxpp6lvfy9fpfnfjg39xr96qet0l08hu")
+
+	// the last validator claims a different amount than the rest
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    1,
+		TokenContract:  keeper.TokenContractAddrs[0],
+		Amount:         sdk.NewInt(100),
+		EthereumSender: keeper.EthAddrs[0].String(),
+		CosmosReceiver: receiver.String(),
+	}
+	for _, orch := range keeper.OrchAddrs[:4] {
+		claim.Orchestrator = orch.String()
+		_, err := h(ctx, &claim)
+		require.NoError(t, err)
+	}
+	conflicting := claim
+	conflicting.Amount = sdk.NewInt(200)
+	conflicting.Orchestrator = keeper.OrchAddrs[4].String()
+	_, err := h(ctx, &conflicting)
+	require.NoError(t, err)
+
+	tokens := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).GetTokens()
+	attestationTally(ctx, pk)
+	require.Equal(t, uint64(1), pk.GetLastObservedEventNonce(ctx))
+
+	// it is slashed but not jailed, the validators which voted for the observed claim are untouched
+	conflicts := pk.GetConflictingClaims(ctx)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, keeper.ValAddrs[4].String(), conflicts[0].Validator)
+	assert.Equal(t, uint64(1), conflicts[0].EventNonce)
+	assert.Equal(t, types.CLAIM_TYPE_SEND_TO_COSMOS, conflicts[0].ClaimType)
+	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4])
+	assert.False(t, val.IsJailed())
+	assert.True(t, val.GetTokens().LT(tokens))
+	for _, valAddr := range keeper.ValAddrs[:4] {
+		assert.Empty(t, pk.GetConflictingClaimsByValidator(ctx, valAddr))
+	}
+
+	// a conflicting claim made after the nonce was observed is slashed right away
+	claim.EventNonce = 2
+	for _, orch := range keeper.OrchAddrs[:4] {
+		claim.Orchestrator = orch.String()
+		_, err := h(ctx, &claim)
+		require.NoError(t, err)
+	}
+	attestationTally(ctx, pk)
+	require.Equal(t, uint64(2), pk.GetLastObservedEventNonce(ctx))
+	tokens = val.GetTokens()
+	conflicting.EventNonce = 2
+	_, err = h(ctx, &conflicting)
+	require.NoError(t, err)
+	assert.Len(t, pk.GetConflictingClaimsByValidator(ctx, keeper.ValAddrs[4]), 2)
+	assert.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[4]).GetTokens().LT(tokens))
 }
 
 func TestValsetEmission(t *testing.T) {
@@ -359,3 +825,123 @@ func TestBatchTimeout(t *testing.T) {
 	gotThirdBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, b3.TokenContract, b3.BatchNonce)
 	require.NotNil(t, gotThirdBatch)
 }
+
+// tests that the end blocker builds batches once the pool pays the fee threshold of a token or waited too long
+func TestAutomaticBatchCreation(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5" // Pickle
+		token, err          = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
+		allVouchers         = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+	addToPool := func(fee int64) {
+		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(100), myTokenContractAddr)
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewInt(fee), myTokenContractAddr)
+		require.NoError(t, err)
+		_, err = pk.AddToOutgoingPool(ctx, mySender, *receiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+		require.NoError(t, err)
+	}
+
+	ctx = ctx.WithBlockHeight(100)
+	addToPool(2)
+	addToPool(3)
+
+	// without a threshold or max age nothing is batched
+	EndBlocker(ctx, pk)
+	assert.Empty(t, pk.GetOutgoingTxBatches(ctx))
+
+	// the pool pays 5 which is below the threshold, which governance may set for the token in any case
+	params := pk.GetParams(ctx)
+	params.AutoBatchFeeThresholds = []types.AutoBatchFeeThreshold{{Token: strings.ToLower(myTokenContractAddr), MinTotalFee: sdk.NewInt(6)}}
+	pk.SetParams(ctx, params)
+	EndBlocker(ctx, pk)
+	assert.Empty(t, pk.GetOutgoingTxBatches(ctx))
+
+	params.AutoBatchFeeThresholds[0].MinTotalFee = sdk.NewInt(5)
+	pk.SetParams(ctx, params)
+	EndBlocker(ctx, pk)
+	batches := pk.GetOutgoingTxBatches(ctx)
+	require.Len(t, batches, 1)
+	assert.Len(t, batches[0].Transactions, 2)
+	assert.Empty(t, pk.GetUnbatchedTransactions(ctx))
+
+	// a transfer paying less than the threshold is batched once it waited more than the max age
+	ctx = ctx.WithBlockHeight(101)
+	addToPool(1)
+	params.AutoBatchMaxTxAge = 10
+	pk.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(111)
+	EndBlocker(ctx, pk)
+	assert.Len(t, pk.GetOutgoingTxBatches(ctx), 1)
+	ctx = ctx.WithBlockHeight(112)
+	EndBlocker(ctx, pk)
+	assert.Len(t, pk.GetOutgoingTxBatches(ctx), 2)
+	assert.Empty(t, pk.GetUnbatchedTransactions(ctx))
+}
+
+func TestEthereumHeightHeartbeat(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	pk := input.GravityKeeper
+	h := NewHandler(pk)
+	ctx = ctx.WithBlockHeight(250)
+	pk.SetLastObservedEthereumBlockHeight(ctx, 500)
+
+	batch, err := types.NewInternalOutgingTxBatchFromExternalBatch(types.OutgoingTxBatch{
+		BatchNonce:    1,
+		BatchTimeout:  600,
+		Transactions:  []types.OutgoingTransferTx{},
+		TokenContract: keeper.TokenContractAddrs[0],
+		Block:         uint64(ctx.BlockHeight()),
+	})
+	require.NoError(t, err)
+	pk.StoreBatchUnsafe(ctx, *batch)
+
+	heartbeat := func(i int, height uint64) {
+		_, err := h(ctx, &types.MsgEthereumHeightClaim{BlockHeight: height, Orchestrator: keeper.OrchAddrs[i].String()})
+		require.NoError(t, err)
+	}
+
+	// three of five validators are not enough to move the observed height
+	for i := 0; i < 3; i++ {
+		heartbeat(i, 700)
+	}
+	EndBlocker(ctx, pk)
+	assert.Equal(t, uint64(500), pk.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight)
+	require.NotNil(t, pk.GetOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce))
+
+	// with a fourth the height is the highest one reached by all four, and the batch times out
+	heartbeat(3, 650)
+	ctx = ctx.WithBlockHeight(251)
+	EndBlocker(ctx, pk)
+	assert.Equal(t, types.LastObservedEthereumBlockHeight{
+		CosmosBlockHeight:   251,
+		EthereumBlockHeight: 650,
+	}, pk.GetLastObservedEthereumBlockHeight(ctx))
+	require.Nil(t, pk.GetOutgoingTXBatch(ctx, batch.TokenContract, batch.BatchNonce))
+	vote, found := pk.GetEthereumHeightVote(ctx, keeper.ValAddrs[3])
+	require.True(t, found)
+	assert.Equal(t, uint64(650), vote.EthereumBlockHeight)
+	assert.Equal(t, uint64(250), vote.CosmosBlockHeight)
+
+	// a single validator can not push the height ahead, and the observed height never goes down
+	heartbeat(4, 10000)
+	for i := 0; i < 3; i++ {
+		heartbeat(i, 100)
+	}
+	EndBlocker(ctx, pk)
+	assert.Equal(t, uint64(650), pk.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight)
+
+	// claims from orchestrators which are not in the active set are rejected
+	_, err = h(ctx, &types.MsgEthereumHeightClaim{BlockHeight: 700, Orchestrator: "gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm"})
+	require.Error(t, err)
+}
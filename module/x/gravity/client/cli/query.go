@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -25,6 +27,21 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetValsetConfirm(),
 		CmdGetPendingValsetRequest(),
 		CmdGetPendingOutgoingTXBatchRequest(),
+		CmdGetTransfersBySender(),
+		CmdGetTransfersByDestination(),
+		CmdGetTransferStatus(),
+		CmdGetDepositsByReceiver(),
+		CmdGetDepositsBySender(),
+		CmdGetDepositsByToken(),
+		CmdGetDepositEscrows(),
+		CmdGetBridgeEscrow(),
+		CmdGetAttestationVoteWeights(),
+		CmdGetConflictingClaims(),
+		CmdGetConfirmSigningInfos(),
+		CmdGetSlashingHistoryByValidator(),
+		CmdGetSlashingHistoryByReason(),
+		CmdGetEstimatedEthereumHeight(),
+		CmdGetTransferMinimums(),
 		// CmdGetAllOutgoingTXBatchRequest(),
 		// CmdGetOutgoingTXBatchByNonceRequest(),
 		// CmdGetAllAttestationsRequest(),
@@ -210,3 +227,452 @@ func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdGetTransfersBySender() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "transfers-by-sender [sender-address]",
+		Short: "Query the transfers of a sender which have not been executed on Ethereum",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryTransfersBySenderRequest{
+				SenderAddress: args[0],
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.GetTransfersBySender(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfers-by-sender")
+	return cmd
+}
+
+func CmdGetTransfersByDestination() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "transfers-by-destination [eth-address]",
+		Short: "Query the transfers to an Ethereum address which have not been executed on Ethereum",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryTransfersByDestinationRequest{
+				EthAddress: args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.GetTransfersByDestination(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfers-by-destination")
+	return cmd
+}
+
+func CmdGetTransferStatus() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "transfer-status [tx-id]",
+		Short: "Query the lifecycle status of an outgoing transfer by the id returned from send-to-eth",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			txID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTransferStatusRequest{
+				TxId: txID,
+			}
+
+			res, err := queryClient.TransferStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetDepositsByReceiver() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "deposits-by-receiver [cosmos-address]",
+		Short: "Query the deposits from Ethereum to a Cosmos address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryDepositsByReceiverRequest{
+				ReceiverAddress: args[0],
+				Pagination:      pageReq,
+			}
+
+			res, err := queryClient.DepositsByReceiver(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deposits-by-receiver")
+	return cmd
+}
+
+func CmdGetDepositsBySender() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "deposits-by-sender [eth-address]",
+		Short: "Query the deposits from an Ethereum address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryDepositsBySenderRequest{
+				EthAddress: args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DepositsBySender(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deposits-by-sender")
+	return cmd
+}
+
+func CmdGetDepositsByToken() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "deposits-by-token [erc20-address]",
+		Short: "Query the deposits of an ERC20 token from Ethereum",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryDepositsByTokenRequest{
+				TokenContract: args[0],
+				Pagination:    pageReq,
+			}
+
+			res, err := queryClient.DepositsByToken(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deposits-by-token")
+	return cmd
+}
+
+func CmdGetDepositEscrows() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "deposit-escrows [cosmos-address]",
+		Short: "Query the deposits held in escrow for a Cosmos address, or all escrowed deposits if no address is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDepositEscrowsRequest{}
+			if len(args) == 1 {
+				req.ReceiverAddress = args[0]
+			}
+
+			res, err := queryClient.DepositEscrows(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetBridgeEscrow() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "bridge-escrow [denom]",
+		Short: "Query the supply of a Cosmos originated denom locked in the bridge while it is on Ethereum, or of every such denom if no denom is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBridgeEscrowRequest{}
+			if len(args) == 1 {
+				req.Denom = args[0]
+			}
+
+			res, err := queryClient.BridgeEscrow(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetAttestationVoteWeights() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "attestation-vote-weights [event-nonce]",
+		Short: "Query the power each validator voted with on the attestations at an event nonce",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAttestationVoteWeightsRequest{
+				EventNonce: nonce,
+			}
+
+			res, err := queryClient.AttestationVoteWeights(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetConflictingClaims() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "conflicting-claims [validator-address]",
+		Short: "Query the claims of a validator which lost to the observed attestation, or all such claims if no validator is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConflictingClaimsRequest{}
+			if len(args) == 1 {
+				req.ValidatorAddress = args[0]
+			}
+
+			res, err := queryClient.ConflictingClaims(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetConfirmSigningInfos() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "confirm-signing-infos [validator-address]",
+		Short: "Query the confirmations a validator has missed in its signing window, or the signing info of all validators if no validator is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConfirmSigningInfosRequest{}
+			if len(args) == 1 {
+				req.ValidatorAddress = args[0]
+			}
+
+			res, err := queryClient.ConfirmSigningInfos(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetSlashingHistoryByValidator() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "slashing-history-by-validator [validator-address]",
+		Short: "Query the gravity specific slashes of a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QuerySlashingHistoryByValidatorRequest{
+				ValidatorAddress: args[0],
+				Pagination:       pageReq,
+			}
+
+			res, err := queryClient.SlashingHistoryByValidator(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slashing-history-by-validator")
+	return cmd
+}
+
+func CmdGetSlashingHistoryByReason() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "slashing-history-by-reason [reason]",
+		Short: "Query the gravity specific slashes for a reason, such as SLASH_REASON_BATCH_SIGNATURE",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			reason, ok := types.SlashReason_value[strings.ToUpper(args[0])]
+			if !ok {
+				return fmt.Errorf("unknown slash reason %s", args[0])
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QuerySlashingHistoryByReasonRequest{
+				Reason:     types.SlashReason(reason),
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.SlashingHistoryByReason(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slashing-history-by-reason")
+	return cmd
+}
+
+func CmdGetEstimatedEthereumHeight() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "estimated-ethereum-height",
+		Short: "Query the current Ethereum block height projected from the last observed Ethereum height and the average block times",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEstimatedEthereumHeightRequest{}
+
+			res, err := queryClient.EstimatedEthereumHeight(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetTransferMinimums() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "transfer-minimums [denom]",
+		Short: "Query the minimum amount and bridge fee of a send to Ethereum of a denom, or of every denom with a minimum if no denom is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTransferMinimumsRequest{}
+			if len(args) == 1 {
+				req.Denom = args[0]
+			}
+
+			res, err := queryClient.TransferMinimums(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
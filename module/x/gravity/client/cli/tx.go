@@ -4,6 +4,7 @@ import (
 	"crypto/ecdsa"
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"log"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -11,6 +12,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 
@@ -31,6 +37,8 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdSendToEth(),
 		CmdRequestBatch(),
 		CmdSetOrchestratorAddress(),
+		CmdRotateDelegateKeys(),
+		CmdClaimDepositEscrow(),
 		GetUnsafeTestingCmd(),
 	}...)
 
@@ -174,6 +182,31 @@ func CmdRequestBatch() *cobra.Command {
 	return cmd
 }
 
+func CmdClaimDepositEscrow() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "claim-deposit-escrow",
+		Short: "Claim the deposits from Ethereum which were held in escrow because they could not be credited to the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			msg := types.NewMsgClaimDepositEscrow(cosmosAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdSetOrchestratorAddress() *cobra.Command {
 	//nolint: exhaustivestruct
 	cmd := &cobra.Command{
@@ -185,10 +218,92 @@ func CmdSetOrchestratorAddress() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			ethAddress, err := types.NewEthAddress(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid eth address")
+			}
+			gravityID, err := queryGravityID(cmd, cliCtx)
+			if err != nil {
+				return err
+			}
+			// prove that the validator controls the ethereum key
+			signature, err := SignDelegateKeysFromKeystore(cmd, cliCtx.KeyringDir, *ethAddress, gravityID, args[0], args[1])
+			if err != nil {
+				return err
+			}
 			msg := types.MsgSetOrchestratorAddress{
 				Validator:    args[0],
 				Orchestrator: args[1],
-				EthAddress:   args[2],
+				EthAddress:   ethAddress.GetAddress(),
+				EthSignature: hex.EncodeToString(signature),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	AddEthKeystoreFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagNewOrchestrator = "new-orchestrator"
+	flagNewEthAddress   = "new-eth-address"
+)
+
+func CmdRotateDelegateKeys() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "rotate-delegate-keys [validator-address]",
+		Short: "Allows validators to replace their orchestrator address and/or their ethereum address.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			newOrchestrator, err := cmd.Flags().GetString(flagNewOrchestrator)
+			if err != nil {
+				return err
+			}
+			newEthAddress, err := cmd.Flags().GetString(flagNewEthAddress)
+			if err != nil {
+				return err
+			}
+			msg := types.MsgRotateDelegateKeys{
+				Validator:       args[0],
+				NewOrchestrator: newOrchestrator,
+				NewEthAddress:   newEthAddress,
+			}
+			if newEthAddress != "" {
+				ethAddress, err := types.NewEthAddress(newEthAddress)
+				if err != nil {
+					return sdkerrors.Wrap(err, "invalid eth address")
+				}
+				gravityID, err := queryGravityID(cmd, cliCtx)
+				if err != nil {
+					return err
+				}
+				// the new ethereum key signs for the orchestrator in use after the rotation
+				orchestrator := newOrchestrator
+				if orchestrator == "" {
+					queryClient := types.NewQueryClient(cliCtx)
+					res, err := queryClient.GetDelegateKeyByValidator(cmd.Context(), &types.QueryDelegateKeysByValidatorAddress{
+						ValidatorAddress: args[0],
+					})
+					if err != nil {
+						return err
+					}
+					orchestrator = res.OrchestratorAddress
+				}
+				signature, err := SignDelegateKeysFromKeystore(cmd, cliCtx.KeyringDir, *ethAddress, gravityID, args[0], orchestrator)
+				if err != nil {
+					return err
+				}
+				msg.EthSignature = hex.EncodeToString(signature)
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -197,6 +312,188 @@ func CmdSetOrchestratorAddress() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().String(flagNewOrchestrator, "", "the new orchestrator address, leave empty to keep the current one")
+	cmd.Flags().String(flagNewEthAddress, "", "the new ethereum address, leave empty to keep the current one")
+	AddEthKeystoreFlagsToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagEthKeystoreDir = "eth-keystore-dir"
+	flagEthPassphrase  = "eth-passphrase"
+)
+
+// AddEthKeystoreFlagsToCmd adds the flags used to unlock the ethereum key that signs the delegate keys
+func AddEthKeystoreFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().String(flagEthKeystoreDir, "", "The ethereum keystore directory holding the delegate ethereum key; if omitted, the keyring directory will be used")
+	cmd.Flags().String(flagEthPassphrase, "default", "Password of the delegate ethereum key")
+}
+
+// SignDelegateKeysFromKeystore signs the delegate keys of a validator with the ethereum key stored in the
+// keystore, by default this is the keyring directory which `eth_keys add` writes to
+func SignDelegateKeysFromKeystore(cmd *cobra.Command, keyringDir string, ethAddress types.EthAddress, gravityID string, validator string, orchestrator string) ([]byte, error) {
+	keystoreDir, err := cmd.Flags().GetString(flagEthKeystoreDir)
+	if err != nil {
+		return nil, err
+	}
+	if keystoreDir == "" {
+		keystoreDir = keyringDir
+	}
+	passphrase, err := cmd.Flags().GetString(flagEthPassphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	ks := keystore.NewKeyStore(keystoreDir, keystore.StandardScryptN, keystore.StandardScryptP)
+	//nolint: exhaustivestruct
+	account, err := ks.Find(accounts.Account{Address: gethcommon.HexToAddress(ethAddress.GetAddress())})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "ethereum key %s in keystore %s", ethAddress.GetAddress(), keystoreDir)
+	}
+	keyJSON, err := ioutil.ReadFile(account.URL.Path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "unlock ethereum key")
+	}
+
+	hash := types.GetDelegateKeysSignHash(gravityID, validator, orchestrator)
+	return types.NewEthereumSignature(hash, key.PrivateKey)
+}
+
+// queryGravityID returns the gravity id of the chain, the domain separator of delegate key signatures
+func queryGravityID(cmd *cobra.Command, cliCtx client.Context) (string, error) {
+	queryClient := types.NewQueryClient(cliCtx)
+	res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+	if err != nil {
+		return "", sdkerrors.Wrap(err, "query gravity id")
+	}
+	return res.Params.GravityId, nil
+}
+
+func CmdSubmitDepositEscrowReleaseProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "deposit-escrow-release [receiver] [recipient]",
+		Short: "Submit a proposal to release the escrowed deposits of a receiver to a recipient",
+		Long:  "Submit a proposal to release the deposits from Ethereum which could not be credited to receiver, along with an initial deposit",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			receiver, err := types.IBCAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "receiver")
+			}
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "recipient")
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return sdkerrors.Wrap(err, "deposit")
+			}
+
+			content := types.NewDepositEscrowReleaseProposal(title, description, receiver, recipient)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, cosmosAddr)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	//nolint: errcheck
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	//nolint: errcheck
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSubmitTransferMinimumProposal() *cobra.Command {
+	//nolint: exhaustivestruct
+	cmd := &cobra.Command{
+		Use:   "transfer-minimum [denom] [min-amount] [min-fee]",
+		Short: "Submit a proposal to set the minimum amount and bridge fee of a send to Ethereum of a denom",
+		Long:  "Submit a proposal to set the minimum amount and bridge fee of a send to Ethereum of a denom, along with an initial deposit. A zero amount and fee removes the minimum",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			minAmount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min-amount %s", args[1])
+			}
+			minFee, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "min-fee %s", args[2])
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return sdkerrors.Wrap(err, "deposit")
+			}
+
+			content := types.NewTransferMinimumProposal(title, description, args[0], minAmount, minFee)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, cosmosAddr)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	//nolint: errcheck
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	//nolint: errcheck
+	cmd.MarkFlagRequired(govcli.FlagDescription)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
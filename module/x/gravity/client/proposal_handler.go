@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client/cli"
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/client/rest"
+)
+
+// DepositEscrowReleaseProposalHandler is the governance client handler for DepositEscrowReleaseProposal
+var DepositEscrowReleaseProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitDepositEscrowReleaseProposal, rest.DepositEscrowReleaseProposalRESTHandler)
+
+// TransferMinimumProposalHandler is the governance client handler for TransferMinimumProposal
+var TransferMinimumProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitTransferMinimumProposal, rest.TransferMinimumProposalRESTHandler)
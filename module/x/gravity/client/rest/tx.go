@@ -8,6 +8,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	hexUtil "github.com/ethereum/go-ethereum/common/hexutil"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 
@@ -98,3 +100,112 @@ type bootstrapConfirmReq struct {
 	GravityID             string                 `json:"gravity_id"`
 	StartThreshold        uint64                 `json:"start_threshold"`
 }
+
+type depositEscrowReleaseProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Deposit     sdk.Coins    `json:"deposit"`
+	Receiver    string       `json:"receiver"`
+	Recipient   string       `json:"recipient"`
+}
+
+type transferMinimumProposalReq struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Deposit     sdk.Coins    `json:"deposit"`
+	Denom       string       `json:"denom"`
+	MinAmount   sdk.Int      `json:"min_amount"`
+	MinFee      sdk.Int      `json:"min_fee"`
+}
+
+// DepositEscrowReleaseProposalRESTHandler submits a governance proposal to release the escrowed deposits
+// of a receiver to a recipient
+func DepositEscrowReleaseProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "deposit_escrow_release",
+		Handler:  createDepositEscrowReleaseProposalHandler(cliCtx),
+	}
+}
+
+func createDepositEscrowReleaseProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req depositEscrowReleaseProposalReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		proposer, err := sdk.AccAddressFromBech32(baseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		receiver, err := types.IBCAddressFromBech32(req.Receiver)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewDepositEscrowReleaseProposal(req.Title, req.Description, receiver, recipient)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+	}
+}
+
+// TransferMinimumProposalRESTHandler submits a governance proposal to set the minimum amount and bridge fee
+// of a send to Ethereum of a denom
+func TransferMinimumProposalRESTHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "transfer_minimum",
+		Handler:  createTransferMinimumProposalHandler(cliCtx),
+	}
+}
+
+func createTransferMinimumProposalHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req transferMinimumProposalReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		proposer, err := sdk.AccAddressFromBech32(baseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewTransferMinimumProposal(req.Title, req.Description, req.Denom, req.MinAmount, req.MinFee)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, baseReq, msg)
+	}
+}
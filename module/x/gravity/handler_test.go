@@ -2,12 +2,18 @@ package gravity
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -78,6 +84,69 @@ func TestHandleMsgSendToEth(t *testing.T) {
 	assert.Equal(t, sdk.Coins{sdk.NewCoin(denom, finalAmount3)}, balance4)
 }
 
+func TestMsgSendToEthTransferMinimum(t *testing.T) {
+	var (
+		userCosmosAddr, _           = sdk.AccAddressFromBech32("gravity1990z7dqsvh8gthw9pa5sn4wuy2xrsd80lcx6lv")
+		denom                       = "gravity0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		startingCoins     sdk.Coins = sdk.Coins{sdk.NewCoin(denom, sdk.NewInt(1000))}
+		ethDestination              = "0x3c9289da00b02dC623d0D8D907619890301D26d4"
+	)
+
+	input := keeper.CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	h := NewHandler(k)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, startingCoins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, userCosmosAddr, startingCoins))
+	sendToEth := func(amount, fee int64) error {
+		_, err := h(ctx, &types.MsgSendToEth{
+			Sender:    userCosmosAddr.String(),
+			EthDest:   ethDestination,
+			Amount:    sdk.NewCoin(denom, sdk.NewInt(amount)),
+			BridgeFee: sdk.NewCoin(denom, sdk.NewInt(fee)),
+		})
+		return err
+	}
+
+	// without a minimum any dust amount and a zero fee are accepted
+	require.NoError(t, sendToEth(1, 0))
+
+	// governance sets a minimum amount and fee
+	proposalHandler := keeper.NewGravityProposalHandler(k)
+	proposal := types.NewTransferMinimumProposal("minimum", "set a transfer minimum", denom, sdk.NewInt(100), sdk.NewInt(10))
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, proposalHandler(ctx, proposal))
+
+	context := sdk.WrapSDKContext(ctx)
+	queried, err := k.TransferMinimums(context, &types.QueryTransferMinimumsRequest{Denom: denom})
+	require.NoError(t, err)
+	assert.Equal(t, []types.TransferMinimum{proposal.Minimum}, queried.Minimums)
+	queried, err = k.TransferMinimums(context, &types.QueryTransferMinimumsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []types.TransferMinimum{proposal.Minimum}, queried.Minimums)
+	queried, err = k.TransferMinimums(context, &types.QueryTransferMinimumsRequest{Denom: "stake"})
+	require.NoError(t, err)
+	assert.Empty(t, queried.Minimums)
+
+	// sends below either minimum are rejected and leave the balance untouched
+	require.ErrorIs(t, sendToEth(99, 10), types.ErrBelowMinimum)
+	require.ErrorIs(t, sendToEth(100, 9), types.ErrBelowMinimum)
+	assert.Equal(t, sdk.NewInt(999), input.BankKeeper.GetBalance(ctx, userCosmosAddr, denom).Amount)
+	require.NoError(t, sendToEth(100, 10))
+	assert.Equal(t, sdk.NewInt(889), input.BankKeeper.GetBalance(ctx, userCosmosAddr, denom).Amount)
+
+	// a zero minimum removes it again
+	proposal = types.NewTransferMinimumProposal("minimum", "remove a transfer minimum", denom, sdk.ZeroInt(), sdk.ZeroInt())
+	require.NoError(t, proposalHandler(ctx, proposal))
+	assert.Empty(t, k.GetTransferMinimums(ctx))
+	require.NoError(t, sendToEth(1, 0))
+
+	// negative minimums are invalid
+	proposal = types.NewTransferMinimumProposal("minimum", "negative transfer minimum", denom, sdk.NewInt(-1), sdk.ZeroInt())
+	require.Error(t, proposal.ValidateBasic())
+	require.Error(t, proposalHandler(ctx, proposal))
+}
+
 //nolint: exhaustivestruct
 func TestMsgSendToCosmosClaim(t *testing.T) {
 	var (
@@ -441,31 +510,226 @@ func TestMsgSendToCosmosForeignPrefixedAddress(t *testing.T) {
 	require.Equal(t, nativeBals, sdk.NewCoins(sdk.NewCoin(erc20Denom, expectedDoubleBalance)))
 }
 
+// Tests that every observed deposit leaves a record which can be found by receiver, sender and token
+func TestDepositRecords(t *testing.T) {
+	var (
+		myCosmosAddr, _ = sdk.AccAddressFromBech32("gravity16ahjkfqxpp6lvfy9fpfnfjg39xr96qet0l08hu")
+		blockedAddr     = authtypes.NewModuleAddress(distrtypes.ModuleName)
+		anyETHAddr      = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		otherETHAddr    = "0x2a24af0501a534fca004ee1bd667b783f205a546"
+		tokenETHAddr    = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		myBlockTime     = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
+	)
+	input, ctx := keeper.SetupFiveValChain(t)
+	k := input.GravityKeeper
+	h := NewHandler(k)
+	tokenAddress, err := types.NewEthAddress(tokenETHAddr)
+	require.NoError(t, err)
+
+	claims := []types.MsgSendToCosmosClaim{
+		{
+			EventNonce:     1,
+			BlockHeight:    100,
+			TokenContract:  tokenETHAddr,
+			Amount:         sdk.NewInt(10),
+			EthereumSender: anyETHAddr,
+			CosmosReceiver: myCosmosAddr.String(),
+			Orchestrator:   "",
+		},
+		{
+			EventNonce:     2,
+			BlockHeight:    101,
+			TokenContract:  tokenETHAddr,
+			Amount:         sdk.NewInt(20),
+			EthereumSender: otherETHAddr,
+			CosmosReceiver: blockedAddr.String(),
+			Orchestrator:   "",
+		},
+		{
+			EventNonce:     3,
+			BlockHeight:    102,
+			TokenContract:  tokenETHAddr,
+			Amount:         sdk.NewInt(30),
+			EthereumSender: anyETHAddr,
+			CosmosReceiver: myCosmosAddr.String(),
+			Orchestrator:   "",
+		},
+	}
+	for _, claim := range claims {
+		ctx = ctx.WithBlockTime(myBlockTime)
+		sendSendToCosmosClaim(claim, ctx, h, t)
+		EndBlocker(ctx, k)
+	}
+
+	// the deposit to a module account can not be credited
+	record := k.GetDepositRecord(ctx, 2)
+	require.NotNil(t, record)
+	assert.Equal(t, types.DepositRecord{
+		EventNonce:     2,
+		EthBlockHeight: 101,
+		TokenContract:  tokenAddress.GetAddress(),
+		Amount:         sdk.NewInt(20),
+		EthereumSender: otherETHAddr,
+		CosmosReceiver: blockedAddr.String(),
+		Outcome:        types.DEPOSIT_OUTCOME_FAILED,
+	}, *record)
+
+	context := sdk.WrapSDKContext(ctx)
+	byReceiver, err := k.DepositsByReceiver(context, &types.QueryDepositsByReceiverRequest{
+		ReceiverAddress: myCosmosAddr.String(),
+		Pagination:      &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, byReceiver.Deposits, 1)
+	assert.Equal(t, uint64(1), byReceiver.Deposits[0].EventNonce)
+	assert.Equal(t, types.DEPOSIT_OUTCOME_CREDITED, byReceiver.Deposits[0].Outcome)
+	byReceiver, err = k.DepositsByReceiver(context, &types.QueryDepositsByReceiverRequest{
+		ReceiverAddress: myCosmosAddr.String(),
+		Pagination:      &query.PageRequest{Key: byReceiver.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, byReceiver.Deposits, 1)
+	assert.Equal(t, uint64(3), byReceiver.Deposits[0].EventNonce)
+
+	bySender, err := k.DepositsBySender(context, &types.QueryDepositsBySenderRequest{EthAddress: otherETHAddr})
+	require.NoError(t, err)
+	require.Len(t, bySender.Deposits, 1)
+	assert.Equal(t, uint64(2), bySender.Deposits[0].EventNonce)
+
+	byToken, err := k.DepositsByToken(context, &types.QueryDepositsByTokenRequest{TokenContract: tokenETHAddr})
+	require.NoError(t, err)
+	assert.Len(t, byToken.Deposits, 3)
+
+	_, err = k.DepositsByReceiver(context, &types.QueryDepositsByReceiverRequest{ReceiverAddress: "invalid"})
+	assert.Error(t, err)
+}
+
+func TestDepositEscrow(t *testing.T) {
+	var (
+		myCosmosAddr, _ = sdk.AccAddressFromBech32("gravity16ahjkfqxpp6lvfy9fpfnfjg39xr96qet0l08hu")
+		recipient, _    = sdk.AccAddressFromBech32("gravity1dg55rtevlfxh46w88yjpdd08sqhh5cc3z8yqu6")
+		blockedAddr     = authtypes.NewModuleAddress(distrtypes.ModuleName)
+		anyETHAddr      = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		tokenETHAddr    = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		myBlockTime     = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
+	)
+	input, ctx := keeper.SetupFiveValChain(t)
+	k := input.GravityKeeper
+	h := NewHandler(k)
+	tokenAddress, err := types.NewEthAddress(tokenETHAddr)
+	require.NoError(t, err)
+	_, denom := k.ERC20ToDenomLookup(ctx, *tokenAddress)
+
+	// the deposit to a module account can not be credited and is escrowed instead
+	ctx = ctx.WithBlockTime(myBlockTime)
+	sendSendToCosmosClaim(types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		BlockHeight:    100,
+		TokenContract:  tokenETHAddr,
+		Amount:         sdk.NewInt(20),
+		EthereumSender: anyETHAddr,
+		CosmosReceiver: blockedAddr.String(),
+		Orchestrator:   "",
+	}, ctx, h, t)
+	EndBlocker(ctx, k)
+
+	escrows := k.GetDepositEscrowsByReceiver(ctx, blockedAddr)
+	require.Len(t, escrows, 1)
+	assert.Equal(t, types.DepositEscrow{
+		EventNonce: 1,
+		Receiver:   blockedAddr.String(),
+		Amount:     sdk.NewCoin(denom, sdk.NewInt(20)),
+	}, escrows[0])
+	res, stop := keeper.ModuleBalanceInvariant(k)(ctx)
+	require.False(t, stop, res)
+
+	context := sdk.WrapSDKContext(ctx)
+	queried, err := k.DepositEscrows(context, &types.QueryDepositEscrowsRequest{ReceiverAddress: blockedAddr.String()})
+	require.NoError(t, err)
+	assert.Equal(t, escrows, queried.Escrows)
+	queried, err = k.DepositEscrows(context, &types.QueryDepositEscrowsRequest{ReceiverAddress: myCosmosAddr.String()})
+	require.NoError(t, err)
+	assert.Empty(t, queried.Escrows)
+
+	// nothing is escrowed for the sender of the claim
+	_, err = h(ctx, types.NewMsgClaimDepositEscrow(myCosmosAddr))
+	require.ErrorIs(t, err, types.ErrEmpty)
+
+	// the module account can not claim for itself, governance releases its deposits instead
+	proposalHandler := keeper.NewGravityProposalHandler(k)
+	proposal := types.NewDepositEscrowReleaseProposal("release", "release escrowed deposits", blockedAddr, recipient)
+	require.NoError(t, proposal.ValidateBasic())
+	require.NoError(t, proposalHandler(ctx, proposal))
+	assert.Equal(t, sdk.NewInt(20), input.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+	assert.Empty(t, k.GetDepositEscrows(ctx))
+	require.ErrorIs(t, proposalHandler(ctx, proposal), types.ErrEmpty)
+
+	// a receiver claims its own escrowed deposits
+	escrowed := sdk.NewCoin(denom, sdk.NewInt(5))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(escrowed)))
+	k.SetDepositEscrow(ctx, types.DepositEscrow{EventNonce: 2, Receiver: myCosmosAddr.String(), Amount: escrowed})
+	res, stop = keeper.ModuleBalanceInvariant(k)(ctx)
+	require.False(t, stop, res)
+	_, err = h(ctx, types.NewMsgClaimDepositEscrow(myCosmosAddr))
+	require.NoError(t, err)
+	assert.Equal(t, escrowed, input.BankKeeper.GetBalance(ctx, myCosmosAddr, denom))
+	assert.Empty(t, k.GetDepositEscrows(ctx))
+	res, stop = keeper.ModuleBalanceInvariant(k)(ctx)
+	require.False(t, stop, res)
+}
+
 //nolint: exhaustivestruct
 func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	var (
-		ethAddress, _                 = types.NewEthAddress("0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255")
+		ethPrivKey, _                 = ethCrypto.GenerateKey()
+		ethAddress, _                 = types.NewEthAddress(ethCrypto.PubkeyToAddress(ethPrivKey.PublicKey).Hex())
 		cosmosAddress  sdk.AccAddress = bytes.Repeat([]byte{0x1}, 20)
-		ethAddress2, _                = types.NewEthAddress("0x26126048c706fB45a5a6De8432F428e794d0b952")
+		ethPrivKey2, _                = ethCrypto.GenerateKey()
+		ethAddress2, _                = types.NewEthAddress(ethCrypto.PubkeyToAddress(ethPrivKey2.PublicKey).Hex())
 		cosmosAddress2 sdk.AccAddress = bytes.Repeat([]byte{0x2}, 20)
 		valAddress     sdk.ValAddress = bytes.Repeat([]byte{0x2}, 20)
+		valAddress2    sdk.ValAddress = bytes.Repeat([]byte{0x3}, 20)
+		cosmosAddress3 sdk.AccAddress = bytes.Repeat([]byte{0x3}, 20)
+		ethPrivKey3, _                = ethCrypto.GenerateKey()
+		ethAddress3, _                = types.NewEthAddress(ethCrypto.PubkeyToAddress(ethPrivKey3.PublicKey).Hex())
 		blockTime                     = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
 		blockTime2                    = time.Date(2020, 9, 15, 15, 20, 10, 0, time.UTC)
 		blockHeight    int64          = 200
 		blockHeight2   int64          = 210
 	)
 	input := keeper.CreateTestEnv(t)
-	input.GravityKeeper.StakingKeeper = keeper.NewStakingKeeperMock(valAddress)
+	input.GravityKeeper.StakingKeeper = keeper.NewStakingKeeperMock(valAddress, valAddress2)
 	ctx := input.Context
 	wctx := sdk.WrapSDKContext(ctx)
 	k := input.GravityKeeper
 	h := NewHandler(input.GravityKeeper)
 	ctx = ctx.WithBlockTime(blockTime)
 
-	// test setting keys
-	msg := types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddress)
+	gravityID := k.GetGravityID(ctx)
+	signFor := func(val sdk.ValAddress, privKey *ecdsa.PrivateKey, orch sdk.AccAddress) []byte {
+		hash := types.GetDelegateKeysSignHash(gravityID, val.String(), orch.String())
+		sig, err := types.NewEthereumSignature(hash, privKey)
+		require.NoError(t, err)
+		return sig
+	}
+	sign := func(privKey *ecdsa.PrivateKey, orch sdk.AccAddress) []byte {
+		return signFor(valAddress, privKey, orch)
+	}
+
+	// a signature by a key other than the registered eth address is rejected
+	msg := types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddress, sign(ethPrivKey2, cosmosAddress))
 	ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(blockHeight)
 	_, err := h(ctx, msg)
+	require.Error(t, err)
+
+	// a signature over another orchestrator is rejected
+	msg = types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddress, sign(ethPrivKey, cosmosAddress2))
+	_, err = h(ctx, msg)
+	require.Error(t, err)
+
+	// test setting keys
+	msg = types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddress, sign(ethPrivKey, cosmosAddress))
+	_, err = h(ctx, msg)
 	require.NoError(t, err)
 
 	// test all lookup methods
@@ -492,10 +756,298 @@ func TestMsgSetOrchestratorAddresses(t *testing.T) {
 	_, err = k.GetDelegateKeyByEth(wctx, &queryE)
 	require.NoError(t, err)
 
-	// try to set values again. This should fail see issue #344 for why allowing this
-	// would require keeping a history of all validators delegate keys forever
-	msg = types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress2, *ethAddress2)
+	// try to set values again. This should fail, keys can only be replaced
+	// with MsgRotateDelegateKeys which keeps a history of the old keys
+	msg = types.NewMsgSetOrchestratorAddress(valAddress, cosmosAddress2, *ethAddress2, sign(ethPrivKey2, cosmosAddress2))
 	ctx = ctx.WithBlockTime(blockTime2).WithBlockHeight(blockHeight2)
 	_, err = h(ctx, msg)
 	require.Error(t, err)
+
+	// another validator can not register the keys in use
+	msg = types.NewMsgSetOrchestratorAddress(valAddress2, cosmosAddress3, *ethAddress, signFor(valAddress2, ethPrivKey, cosmosAddress3))
+	_, err = h(ctx, msg)
+	require.ErrorIs(t, err, types.ErrDuplicate)
+
+	// nor the keys rotated out, even when it holds the leaked ethereum key
+	k.RotateOrchestratorValidator(ctx, valAddress, cosmosAddress, cosmosAddress2)
+	k.RotateEthAddressForValidator(ctx, valAddress, *ethAddress, *ethAddress2)
+	msg = types.NewMsgSetOrchestratorAddress(valAddress2, cosmosAddress, *ethAddress3, signFor(valAddress2, ethPrivKey3, cosmosAddress))
+	_, err = h(ctx, msg)
+	require.ErrorIs(t, err, types.ErrDuplicate)
+	msg = types.NewMsgSetOrchestratorAddress(valAddress2, cosmosAddress3, *ethAddress, signFor(valAddress2, ethPrivKey, cosmosAddress3))
+	_, err = h(ctx, msg)
+	require.ErrorIs(t, err, types.ErrDuplicate)
+
+	// fresh keys are accepted
+	msg = types.NewMsgSetOrchestratorAddress(valAddress2, cosmosAddress3, *ethAddress3, signFor(valAddress2, ethPrivKey3, cosmosAddress3))
+	_, err = h(ctx, msg)
+	require.NoError(t, err)
+}
+
+//nolint: exhaustivestruct
+func TestMsgLogicCallExecutedClaim(t *testing.T) {
+	var (
+		invalidationId = []byte("GravityTesting")
+		tokenETHAddr   = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		logicContract  = "0x510ab76899430424d209a6c9a5b9951fb8a6f47d"
+		myBlockTime    = time.Date(2020, 9, 14, 15, 20, 10, 0, time.UTC)
+		token          = types.ERC20Token{Contract: tokenETHAddr, Amount: sdk.NewInt(500)}
+		fee            = types.ERC20Token{Contract: tokenETHAddr, Amount: sdk.NewInt(50)}
+		tokenAddr, _   = types.NewEthAddress(tokenETHAddr)
+		denom          = types.GravityDenom(*tokenAddr)
+		sender, _      = sdk.AccAddressFromBech32("gravity16ahjkfqxpp6lvfy9fpfnfjg39xr96qet0l08hu")
+	)
+	input, ctx := keeper.SetupFiveValChain(t)
+	h := NewHandler(input.GravityKeeper)
+
+	// escrow the vouchers of the sender for two calls sharing the same invalidation id
+	vouchers := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1100)))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, vouchers))
+	for _, nonce := range []uint64{1, 2} {
+		require.NoError(t, input.GravityKeeper.AddOutgoingLogicCall(ctx, sender, types.OutgoingLogicCall{
+			Transfers:            []types.ERC20Token{token},
+			Fees:                 []types.ERC20Token{fee},
+			LogicContractAddress: logicContract,
+			Payload:              []byte("fake bytes"),
+			Timeout:              10000,
+			InvalidationId:       invalidationId,
+			InvalidationNonce:    nonce,
+		}))
+		input.GravityKeeper.SetLogicCallConfirm(ctx, &types.MsgConfirmLogicCall{
+			InvalidationId:    hex.EncodeToString(invalidationId),
+			InvalidationNonce: nonce,
+			EthSigner:         keeper.EthAddrs[0].String(),
+			Orchestrator:      keeper.OrchAddrs[0].String(),
+			Signature:         "signature",
+		})
+	}
+
+	// send attestations from all five validators
+	for _, v := range keeper.OrchAddrs {
+		claim := types.MsgLogicCallExecutedClaim{
+			EventNonce:        1,
+			BlockHeight:       1,
+			InvalidationId:    invalidationId,
+			InvalidationNonce: 2,
+			Orchestrator:      v.String(),
+		}
+		ctx = ctx.WithBlockTime(myBlockTime)
+		_, err := h(ctx, &claim)
+		require.NoError(t, err)
+	}
+	EndBlocker(ctx, input.GravityKeeper)
+
+	// the executed call and the call it invalidated are gone along with their confirms
+	require.Nil(t, input.GravityKeeper.GetOutgoingLogicCall(ctx, invalidationId, 1))
+	require.Nil(t, input.GravityKeeper.GetOutgoingLogicCall(ctx, invalidationId, 2))
+	require.Empty(t, input.GravityKeeper.GetLogicConfirmByInvalidationIDAndNonce(ctx, invalidationId, 1))
+	require.Empty(t, input.GravityKeeper.GetLogicConfirmByInvalidationIDAndNonce(ctx, invalidationId, 2))
+
+	// only the vouchers of the executed call were burned, those of the invalidated call went back to the sender
+	require.Equal(t, sdk.NewInt(550), input.BankKeeper.GetSupply(ctx, denom).Amount)
+	require.Equal(t, sdk.NewInt(550), input.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+	res, stop := keeper.ModuleBalanceInvariant(input.GravityKeeper)(ctx)
+	require.False(t, stop, res)
+}
+
+//nolint: exhaustivestruct
+func TestMsgValsetUpdatedClaimHijack(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	h := NewHandler(input.GravityKeeper)
+
+	// the first valset request is created by the EndBlocker
+	EndBlocker(ctx, input.GravityKeeper)
+	valset := input.GravityKeeper.GetValset(ctx, 1)
+	require.NotNil(t, valset)
+
+	sendClaims := func(eventNonce uint64, members []types.BridgeValidator) {
+		for _, v := range keeper.OrchAddrs {
+			claim := types.MsgValsetUpdatedClaim{
+				EventNonce:   eventNonce,
+				ValsetNonce:  valset.Nonce,
+				BlockHeight:  1,
+				Members:      members,
+				RewardAmount: valset.RewardAmount,
+				RewardToken:  valset.RewardToken,
+				Orchestrator: v.String(),
+			}
+			_, err := h(ctx, &claim)
+			require.NoError(t, err)
+		}
+		EndBlocker(ctx, input.GravityKeeper)
+	}
+
+	// a matching validator set is observed normally
+	sendClaims(1, valset.Members)
+	require.True(t, input.GravityKeeper.GetParams(ctx).BridgeActive)
+	require.Empty(t, input.GravityKeeper.GetValsetHijackIncidents(ctx))
+	require.Equal(t, valset.Members, input.GravityKeeper.GetLastObservedValset(ctx).Members)
+
+	// a validator set with a different power distribution halts the bridge
+	hijacked := make([]types.BridgeValidator, len(valset.Members))
+	copy(hijacked, valset.Members)
+	hijacked[0].Power++
+	sendClaims(2, hijacked)
+	require.False(t, input.GravityKeeper.GetParams(ctx).BridgeActive)
+	incidents := input.GravityKeeper.GetValsetHijackIncidents(ctx)
+	require.Len(t, incidents, 1)
+	require.Equal(t, uint64(2), incidents[0].EventNonce)
+	require.Equal(t, hijacked, incidents[0].ClaimedValset.Members)
+	// the hijacked set is not recorded as the last observed valset
+	require.Equal(t, valset.Members, input.GravityKeeper.GetLastObservedValset(ctx).Members)
+}
+
+//nolint: exhaustivestruct
+func TestMsgRotateDelegateKeys(t *testing.T) {
+	var (
+		newOrch    sdk.AccAddress = bytes.Repeat([]byte{0x9}, 20)
+		newPriv, _                = ethCrypto.GenerateKey()
+		newEth, _                 = types.NewEthAddress(ethCrypto.PubkeyToAddress(newPriv.PublicKey).Hex())
+		oldOrch                   = keeper.OrchAddrs[0]
+		oldEth, _                 = types.NewEthAddress(keeper.EthAddrs[0].String())
+		val                       = keeper.ValAddrs[0]
+	)
+	input, ctx := keeper.SetupFiveValChain(t)
+	k := input.GravityKeeper
+	h := NewHandler(k)
+
+	// the first valset request is created by the EndBlocker, confirm it with the old key
+	EndBlocker(ctx, k)
+	valset := k.GetValset(ctx, 1)
+	require.NotNil(t, valset)
+	k.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+		Nonce:        valset.Nonce,
+		Orchestrator: oldOrch.String(),
+		EthAddress:   oldEth.GetAddress(),
+		Signature:    "",
+	})
+
+	// rotating nothing is rejected
+	_, err := h(ctx, types.NewMsgRotateDelegateKeys(val, nil, nil, nil))
+	require.Error(t, err)
+
+	// a key used by another validator is rejected
+	_, err = h(ctx, types.NewMsgRotateDelegateKeys(val, keeper.OrchAddrs[1], nil, nil))
+	require.Error(t, err)
+
+	// the new eth key must sign for the orchestrator used after the rotation
+	hash := types.GetDelegateKeysSignHash(k.GetGravityID(ctx), val.String(), oldOrch.String())
+	sig, err := types.NewEthereumSignature(hash, newPriv)
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgRotateDelegateKeys(val, newOrch, newEth, sig))
+	require.Error(t, err)
+
+	// rotate both keys
+	hash = types.GetDelegateKeysSignHash(k.GetGravityID(ctx), val.String(), newOrch.String())
+	sig, err = types.NewEthereumSignature(hash, newPriv)
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgRotateDelegateKeys(val, newOrch, newEth, sig))
+	require.NoError(t, err)
+
+	validator, found := k.GetOrchestratorValidator(ctx, newOrch)
+	require.True(t, found)
+	assert.Equal(t, val, validator.GetOperator())
+	_, found = k.GetOrchestratorValidator(ctx, oldOrch)
+	assert.False(t, found)
+	ethLookup, found := k.GetEthAddressByValidator(ctx, val)
+	require.True(t, found)
+	assert.Equal(t, newEth, ethLookup)
+	_, found = k.GetValidatorByEthAddress(ctx, *oldEth)
+	assert.False(t, found)
+
+	// the old keys still resolve to the validator
+	pastVal, found := k.GetPastOrchestratorValidator(ctx, oldOrch)
+	require.True(t, found)
+	assert.Equal(t, val, pastVal)
+	pastVal, found = k.GetPastValidatorByEthAddress(ctx, *oldEth)
+	require.True(t, found)
+	assert.Equal(t, val, pastVal)
+	confirms := prepValsetConfirms(ctx, k, valset.Nonce)
+	assert.Contains(t, confirms, val.String())
+
+	// the eth address change requests a new valset containing the new address
+	latest := k.GetLatestValset(ctx)
+	require.Equal(t, valset.Nonce+1, latest.Nonce)
+	var members []string
+	for _, m := range latest.Members {
+		members = append(members, m.EthereumAddress)
+	}
+	assert.Contains(t, members, newEth.GetAddress())
+	assert.NotContains(t, members, oldEth.GetAddress())
+
+	// rotated out keys can not be reused by any validator
+	_, err = h(ctx, types.NewMsgRotateDelegateKeys(keeper.ValAddrs[1], oldOrch, nil, nil))
+	require.Error(t, err)
+	_, err = h(ctx, types.NewMsgRotateDelegateKeys(keeper.ValAddrs[1], nil, oldEth, sig))
+	require.Error(t, err)
+
+	// the old orchestrator key can no longer submit claims
+	claim := types.MsgValsetUpdatedClaim{
+		EventNonce:   1,
+		ValsetNonce:  valset.Nonce,
+		BlockHeight:  1,
+		Members:      valset.Members,
+		RewardAmount: valset.RewardAmount,
+		RewardToken:  valset.RewardToken,
+		Orchestrator: oldOrch.String(),
+	}
+	_, err = h(ctx, &claim)
+	require.Error(t, err)
+}
+
+// Tests that a validator which rotated its ethereum key may confirm with the old key until a valset
+// containing the new key is observed, since the contract only verifies the old key until then
+func TestValsetConfirmAfterEthKeyRotation(t *testing.T) {
+	var (
+		oldPriv, _ = ethCrypto.GenerateKey()
+		oldEth, _  = types.NewEthAddress(ethCrypto.PubkeyToAddress(oldPriv.PublicKey).Hex())
+		newPriv, _ = ethCrypto.GenerateKey()
+		newEth, _  = types.NewEthAddress(ethCrypto.PubkeyToAddress(newPriv.PublicKey).Hex())
+		orch       = keeper.OrchAddrs[0]
+		val        = keeper.ValAddrs[0]
+	)
+	input, ctx := keeper.SetupFiveValChain(t)
+	k := input.GravityKeeper
+	h := NewHandler(k)
+	k.SetEthAddressForValidator(ctx, val, *oldEth)
+	k.SetLastObservedValset(ctx, k.GetCurrentValset(ctx))
+
+	hash := types.GetDelegateKeysSignHash(k.GetGravityID(ctx), val.String(), orch.String())
+	sig, err := types.NewEthereumSignature(hash, newPriv)
+	require.NoError(t, err)
+	_, err = h(ctx, types.NewMsgRotateDelegateKeys(val, nil, newEth, sig))
+	require.NoError(t, err)
+
+	confirm := func(valset types.Valset, eth *types.EthAddress, priv *ecdsa.PrivateKey) error {
+		sig, err := types.NewEthereumSignature(valset.GetCheckpoint(k.GetGravityID(ctx)), priv)
+		require.NoError(t, err)
+		_, err = h(ctx, &types.MsgValsetConfirm{
+			Nonce:        valset.Nonce,
+			Orchestrator: orch.String(),
+			EthAddress:   eth.GetAddress(),
+			Signature:    hex.EncodeToString(sig),
+		})
+		return err
+	}
+
+	// the valset requested by the rotation moves the bridge to the new key, the contract only knows the old one
+	transition := k.GetLatestValset(ctx)
+	require.NotNil(t, transition)
+	require.NoError(t, confirm(*transition, oldEth, oldPriv))
+	confirms := k.GetValsetConfirms(ctx, transition.Nonce)
+	require.Len(t, confirms, 1)
+	assert.Equal(t, oldEth.GetAddress(), confirms[0].EthAddress)
+
+	// a key which never belonged to the validator is still rejected
+	otherPriv, _ := ethCrypto.GenerateKey()
+	otherEth, _ := types.NewEthAddress(ethCrypto.PubkeyToAddress(otherPriv.PublicKey).Hex())
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	next := k.SetValsetRequest(ctx)
+	require.Error(t, confirm(next, otherEth, otherPriv))
+
+	// once a valset containing the new key is observed the old key is rejected
+	k.SetLastObservedValset(ctx, *transition)
+	require.Error(t, confirm(next, oldEth, oldPriv))
+	require.NoError(t, confirm(next, newEth, newPriv))
 }
@@ -1,11 +1,12 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
-	"sort"
 	"strconv"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -50,19 +51,31 @@ func (k Keeper) Attest(
 	// If it does not exist, create a new one.
 	if att == nil {
 		att = &types.Attestation{
-			Observed: false,
-			Votes:    []string{},
-			Height:   uint64(ctx.BlockHeight()),
-			Claim:    anyClaim,
+			Observed:   false,
+			Votes:      []string{},
+			Height:     uint64(ctx.BlockHeight()),
+			Claim:      anyClaim,
+			VotePowers: []types.VotePower{},
 		}
 	}
 
-	// Add the validator's vote to this attestation
+	// Add the validator's vote to this attestation, along with the power they vote with
 	att.Votes = append(att.Votes, valAddr.String())
+	att.VotePowers = append(att.VotePowers, types.VotePower{
+		Validator:  valAddr.String(),
+		Power:      k.StakingKeeper.GetLastValidatorPower(ctx, valAddr),
+		TotalPower: k.StakingKeeper.GetLastTotalPower(ctx),
+	})
 
 	k.SetAttestation(ctx, claim.GetEventNonce(), hash, att)
 	k.SetLastEventNonceByValidator(ctx, valAddr, claim.GetEventNonce())
 
+	// A vote arriving after another attestation was observed at this nonce can never win, so it is
+	// punished right away instead of waiting for the tally which only looks at unobserved nonces
+	if !att.Observed && claim.GetEventNonce() <= k.GetLastObservedEventNonce(ctx) {
+		k.punishConflictingClaims(ctx, claim.GetEventNonce())
+	}
+
 	return att, nil
 }
 
@@ -81,39 +94,27 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 	// If the attestation has not yet been Observed, sum up the votes and see if it is ready to apply to the state.
 	// This conditional stops the attestation from accidentally being applied twice.
 	if !att.Observed {
-		// Sum the current powers of all validators who have voted and see if it passes the current threshold
-		// TODO: The different integer types and math here needs a careful review
-		totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
-		requiredPower := types.AttestationVotesPowerThreshold.Mul(totalPower).Quo(sdk.NewInt(100))
-		attestationPower := sdk.NewInt(0)
-		for _, validator := range att.Votes {
-			val, err := sdk.ValAddressFromBech32(validator)
-			if err != nil {
-				panic(err)
+		// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
+		// process the attestation and set Observed to true
+		if k.attestationPassesThreshold(ctx, att, claim.GetType()) {
+			lastEventNonce := k.GetLastObservedEventNonce(ctx)
+			// this check is performed at the next level up so this should never panic
+			// outside of programmer error.
+			if claim.GetEventNonce() != lastEventNonce+1 {
+				panic("attempting to apply events to state out of order")
 			}
-			validatorPower := k.StakingKeeper.GetLastValidatorPower(ctx, val)
-			// Add it to the attestation power's sum
-			attestationPower = attestationPower.Add(sdk.NewInt(validatorPower))
-			// If the power of all the validators that have voted on the attestation is higher or equal to the threshold,
-			// process the attestation, set Observed to true, and break
-			if attestationPower.GTE(requiredPower) {
-				lastEventNonce := k.GetLastObservedEventNonce(ctx)
-				// this check is performed at the next level up so this should never panic
-				// outside of programmer error.
-				if claim.GetEventNonce() != lastEventNonce+1 {
-					panic("attempting to apply events to state out of order")
-				}
-				k.setLastObservedEventNonce(ctx, claim.GetEventNonce())
+			k.setLastObservedEventNonce(ctx, claim.GetEventNonce())
+			// the height heartbeat may have already moved the observed height past the block of this event
+			if claim.GetBlockHeight() > k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight {
 				k.SetLastObservedEthereumBlockHeight(ctx, claim.GetBlockHeight())
+			}
 
-				att.Observed = true
-				k.SetAttestation(ctx, claim.GetEventNonce(), hash, att)
-
-				k.processAttestation(ctx, att, claim)
-				k.emitObservedEvent(ctx, att, claim)
+			att.Observed = true
+			k.SetAttestation(ctx, claim.GetEventNonce(), hash, att)
 
-				break
-			}
+			k.processAttestation(ctx, att, claim)
+			k.emitObservedEvent(ctx, att, claim)
+			k.punishConflictingClaims(ctx, claim.GetEventNonce())
 		}
 	} else {
 		// We panic here because this should never happen
@@ -121,6 +122,107 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 	}
 }
 
+// attestationPassesThreshold sums up the votes on an attestation with the power selected by the
+// AttestationPowerPolicy param and checks if they pass the attestation threshold of its claim type
+func (k Keeper) attestationPassesThreshold(ctx sdk.Context, att *types.Attestation, claimType types.ClaimType) bool {
+	threshold := k.GetAttestationThreshold(ctx, claimType)
+	if k.GetAttestationPowerPolicy(ctx) == types.ATTESTATION_POWER_POLICY_SNAPSHOT {
+		// Every vote counts for the share of the total power its validator held when it was cast
+		return k.snapshotVoteWeight(ctx, att).GT(threshold)
+	}
+
+	// Every vote counts for the share of the current total power its validator holds
+	return k.currentVoteWeight(ctx, att).GT(threshold)
+}
+
+// snapshotVoteWeight returns the share of the total power which voted for an attestation, with
+// every vote weighted by the power its validator held when the vote was cast
+func (k Keeper) snapshotVoteWeight(ctx sdk.Context, att *types.Attestation) sdk.Dec {
+	weight := sdk.ZeroDec()
+	for _, validator := range att.Votes {
+		snapshot := k.voteSnapshot(ctx, att, validator)
+		if snapshot.TotalPower.IsPositive() {
+			weight = weight.Add(sdk.NewDec(snapshot.Power).QuoInt(snapshot.TotalPower))
+		}
+	}
+	return weight
+}
+
+// currentVoteWeight returns the share of the current total power which voted for an attestation
+func (k Keeper) currentVoteWeight(ctx sdk.Context, att *types.Attestation) sdk.Dec {
+	totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
+	if !totalPower.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	attestationPower := sdk.ZeroInt()
+	for _, validator := range att.Votes {
+		val, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			panic(err)
+		}
+		attestationPower = attestationPower.Add(sdk.NewInt(k.StakingKeeper.GetLastValidatorPower(ctx, val)))
+	}
+	return attestationPower.ToDec().QuoInt(totalPower)
+}
+
+// voteSnapshot returns the power a validator voted on an attestation with, votes cast before
+// vote powers were recorded fall back to the current power of the validator
+func (k Keeper) voteSnapshot(ctx sdk.Context, att *types.Attestation, validator string) types.VotePower {
+	for _, vp := range att.VotePowers {
+		if vp.Validator == validator {
+			return vp
+		}
+	}
+	val, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		panic(err)
+	}
+	return types.VotePower{
+		Validator:  validator,
+		Power:      k.StakingKeeper.GetLastValidatorPower(ctx, val),
+		TotalPower: k.StakingKeeper.GetLastTotalPower(ctx),
+	}
+}
+
+// GetAttestationVoteWeights returns the weight of the votes on every attestation at an event nonce
+func (k Keeper) GetAttestationVoteWeights(ctx sdk.Context, eventNonce uint64) []types.AttestationVoteWeights {
+	out := []types.AttestationVoteWeights{}
+	for _, att := range k.GetAttestationsByNonce(ctx, eventNonce) {
+		att := att
+		claim, err := k.UnpackAttestationClaim(&att)
+		if err != nil {
+			panic("could not cast to claim")
+		}
+		hash, err := claim.ClaimHash()
+		if err != nil {
+			panic("unable to compute claim hash")
+		}
+		votes := make([]types.VoteWeight, 0, len(att.Votes))
+		for _, validator := range att.Votes {
+			val, err := sdk.ValAddressFromBech32(validator)
+			if err != nil {
+				panic(err)
+			}
+			snapshot := k.voteSnapshot(ctx, &att, validator)
+			votes = append(votes, types.VoteWeight{
+				Validator:          validator,
+				SnapshotPower:      snapshot.Power,
+				SnapshotTotalPower: snapshot.TotalPower,
+				CurrentPower:       k.StakingKeeper.GetLastValidatorPower(ctx, val),
+			})
+		}
+		out = append(out, types.AttestationVoteWeights{
+			ClaimHash:      hex.EncodeToString(hash),
+			Observed:       att.Observed,
+			Votes:          votes,
+			SnapshotWeight: k.snapshotVoteWeight(ctx, &att),
+			CurrentWeight:  k.currentVoteWeight(ctx, &att),
+			Threshold:      k.GetAttestationThreshold(ctx, claim.GetType()),
+		})
+	}
+	return out
+}
+
 // processAttestation actually applies the attestation to the consensus state
 func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, claim types.EthereumClaim) {
 	hash, err := claim.ClaimHash()
@@ -200,30 +302,26 @@ func (k Keeper) DeleteAttestation(ctx sdk.Context, att types.Attestation) {
 	store.Delete([]byte(types.GetAttestationKey(claim.GetEventNonce(), hash)))
 }
 
-// GetAttestationMapping returns a mapping of eventnonce -> attestations at that nonce
-func (k Keeper) GetAttestationMapping(ctx sdk.Context) (out map[uint64][]types.Attestation) {
-	out = make(map[uint64][]types.Attestation)
-	k.IterateAttestaions(ctx, func(_ []byte, att types.Attestation) bool {
-		claim, err := k.UnpackAttestationClaim(&att)
-		if err != nil {
-			panic("couldn't cast to claim")
-		}
+// IterateAttestaions iterates through all attestations in ASC nonce order
+func (k Keeper) IterateAttestaions(ctx sdk.Context, cb func([]byte, types.Attestation) bool) {
+	start, end := prefixRange([]byte(types.OracleAttestationKey))
+	k.iterateAttestations(ctx, start, end, cb)
+}
 
-		if val, ok := out[claim.GetEventNonce()]; !ok {
-			out[claim.GetEventNonce()] = []types.Attestation{att}
-		} else {
-			out[claim.GetEventNonce()] = append(val, att)
-		}
-		return false
-	})
-	return
+// IterateAttestationsByNonce iterates through the attestations with an event nonce from startNonce up to
+// but excluding endNonce in ASC nonce order, an endNonce of zero iterates through every later attestation
+func (k Keeper) IterateAttestationsByNonce(ctx sdk.Context, startNonce uint64, endNonce uint64, cb func([]byte, types.Attestation) bool) {
+	start := []byte(types.GetAttestationNoncePrefix(startNonce))
+	_, end := prefixRange([]byte(types.OracleAttestationKey))
+	if endNonce != 0 {
+		end = []byte(types.GetAttestationNoncePrefix(endNonce))
+	}
+	k.iterateAttestations(ctx, start, end, cb)
 }
 
-// IterateAttestaions iterates through all attestations
-func (k Keeper) IterateAttestaions(ctx sdk.Context, cb func([]byte, types.Attestation) bool) {
+func (k Keeper) iterateAttestations(ctx sdk.Context, start []byte, end []byte, cb func([]byte, types.Attestation) bool) {
 	store := ctx.KVStore(k.storeKey)
-	prefix := types.OracleAttestationKey
-	iter := store.Iterator(prefixRange([]byte(prefix)))
+	iter := store.Iterator(start, end)
 	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
@@ -238,6 +336,7 @@ func (k Keeper) IterateAttestaions(ctx sdk.Context, cb func([]byte, types.Attest
 				XXX_unrecognized:     []byte{},
 				XXX_sizecache:        0,
 			},
+			VotePowers: []types.VotePower{},
 		}
 		k.cdc.MustUnmarshal(iter.Value(), &att)
 		// cb returns true to stop early
@@ -247,33 +346,30 @@ func (k Keeper) IterateAttestaions(ctx sdk.Context, cb func([]byte, types.Attest
 	}
 }
 
+// GetAttestationsByNonce returns the attestations for an event nonce, there is more than one
+// when validators disagree about what event happened at that nonce
+func (k Keeper) GetAttestationsByNonce(ctx sdk.Context, eventNonce uint64) (out []types.Attestation) {
+	k.IterateAttestationsByNonce(ctx, eventNonce, eventNonce+1, func(_ []byte, att types.Attestation) bool {
+		out = append(out, att)
+		return false
+	})
+	return
+}
+
 // GetMostRecentAttestations returns sorted (by nonce) attestations up to a provided limit number of attestations
-// Note: calls GetAttestationMapping in the hopes that there are potentially many attestations
-// which are distributed between few nonces to minimize sorting time
 func (k Keeper) GetMostRecentAttestations(ctx sdk.Context, limit uint64) []types.Attestation {
-	attestationMapping := k.GetAttestationMapping(ctx)
 	attestations := make([]types.Attestation, 0, limit)
-
-	keys := make([]uint64, 0, len(attestationMapping))
-	for k := range attestationMapping {
-		keys = append(keys, k)
+	if limit == 0 {
+		return attestations
 	}
-	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
-
-	// Iterate the nonces and collect the attestations
-	count := 0
-	for _, nonce := range keys {
-		if count >= int(limit) {
-			break
-		}
-		for _, att := range attestationMapping[nonce] {
-			if count >= int(limit) {
-				break
-			}
-			attestations = append(attestations, att)
-			count++
+	k.IterateAttestaions(ctx, func(_ []byte, att types.Attestation) bool {
+		// unpacking caches the claim in the attestation for the callers
+		if _, err := k.UnpackAttestationClaim(&att); err != nil {
+			panic("couldn't cast to claim")
 		}
-	}
+		attestations = append(attestations, att)
+		return uint64(len(attestations)) >= limit
+	})
 
 	return attestations
 }
@@ -309,13 +405,20 @@ func (k Keeper) GetLastObservedEthereumBlockHeight(ctx sdk.Context) types.LastOb
 	return height
 }
 
-// SetLastObservedEthereumBlockHeight sets the block height in the store.
+// SetLastObservedEthereumBlockHeight sets the block height in the store and samples it to calibrate the
+// average block times
 func (k Keeper) SetLastObservedEthereumBlockHeight(ctx sdk.Context, ethereumHeight uint64) {
-	store := ctx.KVStore(k.storeKey)
 	height := types.LastObservedEthereumBlockHeight{
 		EthereumBlockHeight: ethereumHeight,
 		CosmosBlockHeight:   uint64(ctx.BlockHeight()),
 	}
+	k.setLastObservedEthereumBlockHeight(ctx, height)
+	k.recordEthereumHeightSample(ctx, ethereumHeight)
+}
+
+// setLastObservedEthereumBlockHeight stores the given Ethereum and Cosmos height pair as is
+func (k Keeper) setLastObservedEthereumBlockHeight(ctx sdk.Context, height types.LastObservedEthereumBlockHeight) {
+	store := ctx.KVStore(k.storeKey)
 	store.Set([]byte(types.LastObservedEthereumBlockHeightKey), k.cdc.MustMarshal(&height))
 }
 
@@ -384,3 +487,18 @@ func (k Keeper) SetLastEventNonceByValidator(ctx sdk.Context, validator sdk.ValA
 	store := ctx.KVStore(k.storeKey)
 	store.Set([]byte(types.GetLastEventNonceByValidatorKey(validator)), types.UInt64Bytes(nonce))
 }
+
+// IterateLastEventNonceByValidator iterates through the last event nonce of every validator
+// that has ever submitted a claim
+func (k Keeper) IterateLastEventNonceByValidator(ctx sdk.Context, cb func(validator sdk.ValAddress, nonce uint64) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.LastEventNonceByValidatorKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(sdk.ValAddress(iter.Key()), types.UInt64FromBytes(iter.Value())) {
+			break
+		}
+	}
+}
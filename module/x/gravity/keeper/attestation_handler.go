@@ -75,10 +75,14 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 				// error needs to be detected and resolved
 				return sdkerrors.Wrapf(err, "mint vouchers coins: %s", coins)
 			}
+		} else {
+			// Cosmos-originated coins are coming back from Ethereum, they are no longer locked
+			a.keeper.unlockBridgeEscrow(ctx, coins[0])
 		}
+		outcome := types.DEPOSIT_OUTCOME_CREDITED
 		if !invalidAddress { // valid address, lock up the coins
 			if err := a.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, nativeReceiver, coins); err != nil {
-				// someone attempted to send tokens to a blacklisted user from Ethereum, log and send to Community pool
+				// someone attempted to send tokens to a blacklisted user from Ethereum, log and escrow the tokens
 				hash, _ := claim.ClaimHash()
 				a.keeper.logger(ctx).Error("Blacklisted deposit",
 					"cause", err.Error(),
@@ -86,6 +90,22 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 					"id", types.GetAttestationKey(claim.GetEventNonce(), hash),
 					"nonce", fmt.Sprint(claim.GetEventNonce()),
 				)
+				// the coins stay in the module account, escrow them so the receiver or governance can recover them
+				a.keeper.SetDepositEscrow(ctx, types.DepositEscrow{
+					EventNonce: claim.EventNonce,
+					Receiver:   claim.CosmosReceiver,
+					Amount:     coins[0],
+				})
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeDepositEscrowed,
+						sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+						sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(claim.EventNonce)),
+						sdk.NewAttribute(types.AttributeKeyReceiver, claim.CosmosReceiver),
+						sdk.NewAttribute(types.AttributeKeyAmount, coins.String()),
+					),
+				)
+				outcome = types.DEPOSIT_OUTCOME_FAILED
 			}
 		} else {
 			// invalid deposit address, send coins to community pool
@@ -95,7 +115,18 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 			if err = a.SendToCommunityPool(ctx, coins); err != nil {
 				return sdkerrors.Wrap(err, "failed to send to Community pool")
 			}
+			outcome = types.DEPOSIT_OUTCOME_COMMUNITY_POOL
 		}
+		// keep a record of the deposit which outlives the attestation
+		a.keeper.SetDepositRecord(ctx, types.DepositRecord{
+			EventNonce:     claim.EventNonce,
+			EthBlockHeight: claim.BlockHeight,
+			TokenContract:  tokenAddress.GetAddress(),
+			Amount:         claim.Amount,
+			EthereumSender: claim.EthereumSender,
+			CosmosReceiver: claim.CosmosReceiver,
+			Outcome:        outcome,
+		})
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				sdk.EventTypeMessage,
@@ -187,21 +218,39 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 				sdk.NewAttribute("MsgERC20DeployedClaim", strconv.Itoa(int(claim.GetEventNonce()))),
 			),
 		)
+	case *types.MsgLogicCallExecutedClaim:
+		if err := a.keeper.OutgoingLogicCallExecuted(ctx, claim.InvalidationId, claim.InvalidationNonce); err != nil {
+			return sdkerrors.Wrap(err, "failed to execute logic call")
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute("MsgLogicCallExecutedClaim", strconv.Itoa(int(claim.GetEventNonce()))),
+			),
+		)
 	case *types.MsgValsetUpdatedClaim:
 		rewardAddress, err := types.NewEthAddress(claim.RewardToken)
 		if err != nil {
 			return sdkerrors.Wrap(err, "invalid reward token on claim")
 		}
-		// TODO here we should check the contents of the validator set against
-		// the store, if they differ we should take some action to indicate to the
-		// user that bridge highjacking has occurred
-		a.keeper.SetLastObservedValset(ctx, types.Valset{
+		observed := types.Valset{
 			Nonce:        claim.ValsetNonce,
 			Members:      claim.Members,
 			Height:       0,
 			RewardAmount: claim.RewardAmount,
 			RewardToken:  claim.RewardToken,
-		})
+		}
+		// check the contents of the validator set against the store, if they differ the
+		// bridge contract has been hijacked. Nonce zero is the validator set the contract
+		// was deployed with and was never created on Cosmos, so it can not be checked.
+		// Note we must not return an error here, that would discard the halt
+		if claim.ValsetNonce != 0 {
+			if reason := a.keeper.GetValsetHijackReason(ctx, observed); reason != "" {
+				a.keeper.HandleValsetHijack(ctx, claim.GetEventNonce(), observed, reason)
+				return nil
+			}
+		}
+		a.keeper.SetLastObservedValset(ctx, observed)
 		// if the reward is greater than zero and the reward token
 		// is valid then some reward was issued by this validator set
 		// and we need to either add to the total tokens for a Cosmos native
@@ -234,6 +283,8 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 					)
 					return sdkerrors.Wrapf(err, "unable to mint cosmos originated coins %v", coins)
 				}
+				// the minted coins are locked in the module until they come back from Ethereum
+				a.keeper.lockBridgeEscrow(ctx, coins[0])
 			} else {
 				// // If it is not cosmos originated, burn the coins (aka Vouchers)
 				// // so that we don't think we have more in the bridge than we actually do
@@ -51,3 +51,54 @@ func TestGetMostRecentAttestations(t *testing.T) {
 			"The %vth claim does not match our message: claim %v\n message %v", n, attest.Claim, msgs[n])
 	}
 }
+
+// Sets up attestations at nonces which encode to keys of different lengths and checks that they are
+// iterated in nonce order and that a nonce range only returns the attestations within it
+func TestIterateAttestationsByNonce(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	nonces := []uint64{256, 1, 128, 127, 255, 2, 128}
+	for i, nonce := range nonces {
+		msg := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			BlockHeight:    1,
+			TokenContract:  TokenContractAddrs[0],
+			Amount:         sdktypes.NewInt(100 + int64(i)),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: AccAddrs[0].String(),
+			Orchestrator:   AccAddrs[0].String(),
+		}
+		any, err := codectypes.NewAnyWithValue(&msg)
+		require.NoError(t, err)
+		hash, err := msg.ClaimHash()
+		require.NoError(t, err)
+		k.SetAttestation(ctx, nonce, hash, &types.Attestation{
+			Observed: false,
+			Votes:    []string{},
+			Height:   uint64(ctx.BlockHeight()),
+			Claim:    any,
+		})
+	}
+
+	iterated := func(start, end uint64) []uint64 {
+		var out []uint64
+		k.IterateAttestationsByNonce(ctx, start, end, func(_ []byte, att types.Attestation) bool {
+			claim, err := k.UnpackAttestationClaim(&att)
+			require.NoError(t, err)
+			out = append(out, claim.GetEventNonce())
+			return false
+		})
+		return out
+	}
+	require.Equal(t, []uint64{1, 2, 127, 128, 128, 255, 256}, iterated(0, 0))
+	require.Equal(t, []uint64{127, 128, 128, 255}, iterated(3, 256))
+	require.Equal(t, []uint64{255, 256}, iterated(129, 0))
+	require.Empty(t, iterated(3, 127))
+
+	require.Len(t, k.GetAttestationsByNonce(ctx, 128), 2)
+	require.Len(t, k.GetAttestationsByNonce(ctx, 255), 1)
+	require.Empty(t, k.GetAttestationsByNonce(ctx, 129))
+	require.Len(t, k.GetMostRecentAttestations(ctx, 3), 3)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -11,13 +12,16 @@ import (
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
 
+// OutgoingTxBatchSize is the largest number of transactions in a batch of a token without a batch size limit
 const OutgoingTxBatchSize = 100
 
 // BuildOutgoingTXBatch starts the following process chain:
 // - find bridged denominator for given voucher type
 // - determine if an unexecuted batch is already waiting for this token type, if so confirm the new batch would
-//   have a higher total fees. If not exit without creating a batch
-// - select available transactions from the outgoing transaction pool sorted by fee desc
+//   have a higher total fees, or a higher total score if the batch selection policy is age weighted. If not exit
+//   without creating a batch
+// - select available transactions from the outgoing transaction pool in the order of the batch selection policy,
+//   at most maxElements or the batch size limit of the token
 // - persist an outgoing batch object with an incrementing ID = nonce
 // - emit an event
 func (k Keeper) BuildOutgoingTXBatch(
@@ -37,16 +41,25 @@ func (k Keeper) BuildOutgoingTXBatch(
 	// lastBatch may be nil if there are no existing batches, we only need
 	// to perform this check if a previous batch exists
 	if lastBatch != nil {
-		// this traverses the current tx pool for this token type and determines what
-		// fees a hypothetical batch would have if created
-		currentFees := k.GetBatchFeeByTokenType(ctx, contract, maxElements)
-		if currentFees == nil {
-			return nil, sdkerrors.Wrap(types.ErrInvalid, "error getting fees from tx pool")
-		}
+		if k.GetBatchSelectionPolicy(ctx) == types.BATCH_SELECTION_POLICY_AGE_WEIGHTED {
+			// both batches are scored at the current block, so the transactions which waited
+			// in the pool eventually outweigh the last batch
+			lastScore := k.ageWeightedScore(ctx, lastBatch.Transactions)
+			if lastScore.GT(k.ageWeightedScore(ctx, k.selectUnbatchedTX(ctx, contract, maxElements))) {
+				return nil, sdkerrors.Wrap(types.ErrInvalid, "new batch would not be more profitable")
+			}
+		} else {
+			// this traverses the current tx pool for this token type and determines what
+			// fees a hypothetical batch would have if created
+			currentFees := k.GetBatchFeeByTokenType(ctx, contract, maxElements)
+			if currentFees == nil {
+				return nil, sdkerrors.Wrap(types.ErrInvalid, "error getting fees from tx pool")
+			}
 
-		lastFees := lastBatch.ToExternal().GetFees()
-		if lastFees.GT(currentFees.TotalFees) {
-			return nil, sdkerrors.Wrap(types.ErrInvalid, "new batch would not be more profitable")
+			lastFees := lastBatch.ToExternal().GetFees()
+			if lastFees.GT(currentFees.TotalFees) {
+				return nil, sdkerrors.Wrap(types.ErrInvalid, "new batch would not be more profitable")
+			}
 		}
 	}
 
@@ -62,6 +75,9 @@ func (k Keeper) BuildOutgoingTXBatch(
 	// set the current block height when storing the batch
 	batch.Block = uint64(ctx.BlockHeight())
 	k.StoreBatch(ctx, *batch)
+	for _, tx := range batch.Transactions {
+		k.updateTransferStatus(ctx, tx, types.TRANSFER_STATE_BATCHED, nextID)
+	}
 
 	// Get the checkpoint and store it as a legit past batch
 	checkpoint := batch.GetCheckpoint(k.GetGravityID(ctx))
@@ -82,23 +98,67 @@ func (k Keeper) BuildOutgoingTXBatch(
 // This gets the batch timeout height in Ethereum blocks.
 func (k Keeper) getBatchTimeoutHeight(ctx sdk.Context) uint64 {
 	params := k.GetParams(ctx)
-	currentCosmosHeight := ctx.BlockHeight()
 	// we store the last observed Cosmos and Ethereum heights, we do not concern ourselves if these values are zero because
 	// no batch can be produced if the last Ethereum block height is not first populated by a deposit event.
 	heights := k.GetLastObservedEthereumBlockHeight(ctx)
 	if heights.CosmosBlockHeight == 0 || heights.EthereumBlockHeight == 0 {
 		return 0
 	}
-	// we project how long it has been in milliseconds since the last Ethereum block height was observed
-	projectedMillis := (uint64(currentCosmosHeight) - heights.CosmosBlockHeight) * params.AverageBlockTime
-	// we convert that projection into the current Ethereum height using the average Ethereum block time in millis
-	projectedCurrentEthereumHeight := (projectedMillis / params.AverageEthereumBlockTime) + heights.EthereumBlockHeight
+	// the average block times are calibrated from the observed Ethereum heights when there are enough of them
+	averageBlockTime, averageEthereumBlockTime := k.GetAverageBlockTimes(ctx)
+	projectedCurrentEthereumHeight := projectEthereumHeight(ctx, heights, averageBlockTime, averageEthereumBlockTime)
 	// we convert our target time for block timeouts (lets say 12 hours) into a number of blocks to
 	// place on top of our projection of the current Ethereum block height.
-	blocksToAdd := params.TargetBatchTimeout / params.AverageEthereumBlockTime
+	blocksToAdd := params.TargetBatchTimeout / averageEthereumBlockTime
 	return projectedCurrentEthereumHeight + blocksToAdd
 }
 
+// BuildAutoBatches builds a batch for every token whose pool would pay at least its auto batch fee threshold, or
+// whose oldest pooled transfer has waited more than AutoBatchMaxTxAge blocks, so that tokens without an active
+// relayer requesting batches still make it to Ethereum
+func (k Keeper) BuildAutoBatches(ctx sdk.Context, params types.Params) {
+	if len(params.AutoBatchFeeThresholds) == 0 && params.AutoBatchMaxTxAge == 0 {
+		return
+	}
+
+	// only the fees of tokens with a threshold are summed, up to the size of the batch they would be paid by
+	var tokens []types.EthAddress
+	triggered := make(map[string]bool)
+	if len(params.AutoBatchFeeThresholds) != 0 {
+		k.iteratePooledTokens(ctx, func(token types.EthAddress) bool {
+			threshold, found := k.GetAutoBatchFeeThreshold(ctx, token)
+			if found && k.GetBatchFeeByTokenType(ctx, token, k.GetBatchSizeLimit(ctx, token)).TotalFees.GTE(threshold) {
+				triggered[token.GetAddress()] = true
+				tokens = append(tokens, token)
+			}
+			return false
+		})
+	}
+	if params.AutoBatchMaxTxAge != 0 {
+		for _, token := range k.GetAgedUnbatchedTokens(ctx, params.AutoBatchMaxTxAge) {
+			if !triggered[token.GetAddress()] {
+				triggered[token.GetAddress()] = true
+				tokens = append(tokens, token)
+			}
+		}
+	}
+
+	for _, token := range tokens {
+		// the batch is built as if it was requested, so it is skipped if it would not be more
+		// profitable than the last batch of the token
+		xCtx, commit := ctx.CacheContext()
+		batch, err := k.BuildOutgoingTXBatch(xCtx, token, k.GetBatchSizeLimit(ctx, token))
+		if err != nil {
+			k.logger(ctx).Debug("Skipped automatic batch", "token", token.GetAddress(), "cause", err.Error())
+			continue
+		}
+		commit()
+		if batch != nil {
+			k.logger(ctx).Info("Created automatic batch", "token", token.GetAddress(), "nonce", batch.BatchNonce)
+		}
+	}
+}
+
 // OutgoingTxBatchExecuted is run when the Cosmos chain detects that a batch has been executed on Ethereum
 // It frees all the transactions in the batch, then cancels all earlier batches, this function panics instead
 // of returning errors because any failure will cause a double spend.
@@ -108,14 +168,14 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 		panic(fmt.Sprintf("unknown batch nonce for outgoing tx batch %s %d", tokenContract, nonce))
 	}
 	contract := b.TokenContract
+	totalSent := sdk.NewInt(0)
+	for _, tx := range b.Transactions {
+		totalSent = totalSent.Add(tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount))
+	}
 	// Burn tokens if they're Ethereum originated
-	if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, contract); !isCosmosOriginated {
-		totalToBurn := sdk.NewInt(0)
-		for _, tx := range b.Transactions {
-			totalToBurn = totalToBurn.Add(tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount))
-		}
+	if isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, contract); !isCosmosOriginated {
 		// burn vouchers to send them back to ETH
-		erc20, err := types.NewInternalERC20Token(totalToBurn, contract.GetAddress())
+		erc20, err := types.NewInternalERC20Token(totalSent, contract.GetAddress())
 		if err != nil {
 			panic(sdkerrors.Wrapf(err, "invalid ERC20 address in executed batch"))
 		}
@@ -123,6 +183,9 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnVouchers); err != nil {
 			panic(err)
 		}
+	} else {
+		// Cosmos originated tokens stay locked in the module while they exist on ETH
+		k.lockBridgeEscrow(ctx, sdk.NewCoin(denom, totalSent))
 	}
 
 	// Iterate through remaining batches
@@ -137,6 +200,12 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 		return false
 	})
 
+	// the transactions of the batch are no longer pending
+	for _, tx := range b.Transactions {
+		k.deletePendingTransferIndexes(ctx, tx)
+		k.updateTransferStatus(ctx, tx, types.TRANSFER_STATE_EXECUTED, b.BatchNonce)
+	}
+
 	// Delete batch since it is finished
 	k.DeleteBatch(ctx, *b)
 }
@@ -150,6 +219,7 @@ func (k Keeper) StoreBatch(ctx sdk.Context, batch types.InternalOutgoingTxBatch)
 	store := ctx.KVStore(k.storeKey)
 	key := []byte(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce))
 	store.Set(key, k.cdc.MustMarshal(&externalBatch))
+	k.indexBatchTransactions(ctx, batch, key)
 }
 
 // StoreBatchUnsafe stores a transaction batch w/o setting the height
@@ -161,38 +231,119 @@ func (k Keeper) StoreBatchUnsafe(ctx sdk.Context, batch types.InternalOutgoingTx
 	store := ctx.KVStore(k.storeKey)
 	key := []byte(types.GetOutgoingTxBatchKey(batch.TokenContract, batchExt.BatchNonce))
 	store.Set(key, k.cdc.MustMarshal(&batchExt))
+	k.indexBatchTransactions(ctx, batch, key)
 }
 
-// DeleteBatch deletes an outgoing transaction batch
+// indexBatchTransactions points the pending transfer indexes of the batch transactions at the batch
+func (k Keeper) indexBatchTransactions(ctx sdk.Context, batch types.InternalOutgoingTxBatch, key []byte) {
+	for _, tx := range batch.Transactions {
+		k.setPendingTransferIndexes(ctx, tx, key)
+	}
+}
+
+// DeleteBatch deletes an outgoing transaction batch together with its confirms
 func (k Keeper) DeleteBatch(ctx sdk.Context, batch types.InternalOutgoingTxBatch) {
 	if err := batch.ValidateBasic(); err != nil {
 		panic(sdkerrors.Wrap(err, "attempted to delete invalid batch"))
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete([]byte(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce)))
+	k.pruneBatchConfirms(ctx, batch.TokenContract, batch.BatchNonce)
 }
 
-// pickUnbatchedTX find TX in pool and remove from "available" second index
+// pickUnbatchedTX find TX in pool and remove from "available" second index, the id index
+// is pointed at the batch once it is stored
 func (k Keeper) pickUnbatchedTX(
 	ctx sdk.Context,
 	contractAddress types.EthAddress,
 	maxElements uint) ([]*types.InternalOutgoingTransferTx, error) {
+	selectedTx := k.selectUnbatchedTX(ctx, contractAddress, maxElements)
+	for _, tx := range selectedTx {
+		if tx == nil || tx.Erc20Fee == nil {
+			panic("tx and fee should never be nil!")
+		}
+		if err := k.removeUnbatchedTX(ctx, *tx.Erc20Fee, tx.Id); err != nil {
+			return selectedTx, err
+		}
+		oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, *tx.Erc20Fee, tx.Id)
+		if oldTx != nil || oldTxErr == nil {
+			panic("picked a duplicate transaction from the pool, duplicates should never exist!")
+		}
+	}
+	return selectedTx, nil
+}
+
+// selectUnbatchedTX returns the transactions the next batch of the token would contain in the order of the
+// batch selection policy, at most maxElements or the batch size limit of the token of them
+func (k Keeper) selectUnbatchedTX(
+	ctx sdk.Context,
+	contractAddress types.EthAddress,
+	maxElements uint) []*types.InternalOutgoingTransferTx {
+	if limit := k.GetBatchSizeLimit(ctx, contractAddress); limit < maxElements {
+		maxElements = limit
+	}
 	var selectedTx []*types.InternalOutgoingTransferTx
-	var err error
-	k.IterateUnbatchedTransactionsByContract(ctx, contractAddress, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
-		if tx != nil && tx.Erc20Fee != nil {
+	policy := k.GetBatchSelectionPolicy(ctx)
+	if policy == types.BATCH_SELECTION_POLICY_FEE_DESC {
+		// the pool is stored in fee order, so only the transactions which make it into the batch are read
+		k.IterateUnbatchedTransactionsByContract(ctx, contractAddress, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
 			selectedTx = append(selectedTx, tx)
-			err = k.removeUnbatchedTX(ctx, *tx.Erc20Fee, tx.Id)
-			oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, *tx.Erc20Fee, tx.Id)
-			if oldTx != nil || oldTxErr == nil {
-				panic("picked a duplicate transaction from the pool, duplicates should never exist!")
-			}
-			return err != nil || uint(len(selectedTx)) == maxElements
-		} else {
-			panic("tx and fee should never be nil!")
+			return uint(len(selectedTx)) == maxElements
+		})
+		return selectedTx
+	}
+
+	selectedTx = k.GetUnbatchedTransactionsByContract(ctx, contractAddress)
+	switch policy {
+	case types.BATCH_SELECTION_POLICY_FIFO:
+		// ids are handed out in the order transactions are sent
+		sort.SliceStable(selectedTx, func(i, j int) bool {
+			return selectedTx[i].Id < selectedTx[j].Id
+		})
+	case types.BATCH_SELECTION_POLICY_AGE_WEIGHTED:
+		weight := k.GetBatchAgeWeight(ctx)
+		scores := make(map[uint64]sdk.Dec, len(selectedTx))
+		for _, tx := range selectedTx {
+			scores[tx.Id] = k.transferScore(ctx, tx, weight)
 		}
-	})
-	return selectedTx, err
+		sort.SliceStable(selectedTx, func(i, j int) bool {
+			a, b := scores[selectedTx[i].Id], scores[selectedTx[j].Id]
+			if !a.Equal(b) {
+				return a.GT(b)
+			}
+			return selectedTx[i].Id < selectedTx[j].Id
+		})
+	}
+	if uint(len(selectedTx)) > maxElements {
+		selectedTx = selectedTx[:maxElements]
+	}
+	return selectedTx
+}
+
+// ageWeightedScore returns the sum of the age weighted fee scores of a batch of transactions
+func (k Keeper) ageWeightedScore(ctx sdk.Context, txs []*types.InternalOutgoingTransferTx) sdk.Dec {
+	weight := k.GetBatchAgeWeight(ctx)
+	score := sdk.ZeroDec()
+	for _, tx := range txs {
+		score = score.Add(k.transferScore(ctx, tx, weight))
+	}
+	return score
+}
+
+// transferScore returns the fee of a transaction times 1 + weight for every block since it was sent. A transaction
+// without a status was sent before statuses were tracked and the upgrade migration backfilled them, so it is
+// scored as the oldest possible
+func (k Keeper) transferScore(ctx sdk.Context, tx *types.InternalOutgoingTransferTx, weight sdk.Dec) sdk.Dec {
+	fee := tx.Erc20Fee.Amount.ToDec()
+	var createdHeight uint64
+	if status := k.GetTransferStatus(ctx, tx.Id); status != nil {
+		createdHeight = status.CreatedHeight
+	}
+	if uint64(ctx.BlockHeight()) <= createdHeight {
+		return fee
+	}
+	age := uint64(ctx.BlockHeight()) - createdHeight
+	return fee.Mul(sdk.OneDec().Add(weight.MulInt64(int64(age))))
 }
 
 // GetOutgoingTXBatch loads a batch object. Returns nil when not exists.
@@ -222,11 +373,13 @@ func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, tokenContract types.EthAd
 	if batch == nil {
 		return types.ErrUnknown
 	}
+	// adding the transactions back into the pool also points their id index back at the pool
 	for _, tx := range batch.Transactions {
 		err := k.addUnbatchedTX(ctx, tx)
 		if err != nil {
 			panic(sdkerrors.Wrapf(err, "unable to add batched transaction back into pool %v", tx))
 		}
+		k.updateTransferStatus(ctx, tx, types.TRANSFER_STATE_POOLED, 0)
 	}
 
 	// Delete batch since it is finished
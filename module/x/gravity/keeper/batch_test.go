@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -688,3 +689,149 @@ func TestBatchesNotCreatedWhenBridgePaused(t *testing.T) {
 	gotFirstBatch = input.GravityKeeper.GetOutgoingTXBatch(ctx, firstBatch.TokenContract, firstBatch.BatchNonce)
 	require.NotNil(t, gotFirstBatch)
 }
+
+// tests that batch timeouts are projected with the average block times measured from the observed Ethereum heights
+func TestBatchTimeoutCalibration(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	start := time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC)
+
+	// a single observation can not be measured, the params are used as is
+	ctx := input.Context.WithBlockHeight(100).WithBlockTime(start)
+	k.SetLastObservedEthereumBlockHeight(ctx, 500)
+	averageBlockTime, averageEthereumBlockTime := k.GetAverageBlockTimes(ctx)
+	assert.Equal(t, uint64(5000), averageBlockTime)
+	assert.Equal(t, uint64(15000), averageEthereumBlockTime)
+
+	// 100 blocks of 2 seconds on Cosmos, 20 blocks of 10 seconds on Ethereum
+	ctx = ctx.WithBlockHeight(200).WithBlockTime(start.Add(200 * time.Second))
+	k.SetLastObservedEthereumBlockHeight(ctx, 520)
+	averageBlockTime, averageEthereumBlockTime = k.GetAverageBlockTimes(ctx)
+	assert.Equal(t, uint64(2000), averageBlockTime)
+	assert.Equal(t, uint64(10000), averageEthereumBlockTime)
+
+	// 100 blocks later Ethereum is estimated 20 blocks ahead, the timeout adds 60001 / 10000 blocks
+	ctx = ctx.WithBlockHeight(300)
+	assert.Equal(t, uint64(540), k.GetEstimatedEthereumHeight(ctx))
+	assert.Equal(t, uint64(546), k.getBatchTimeoutHeight(ctx))
+	res, err := k.EstimatedEthereumHeight(sdk.WrapSDKContext(ctx), &types.QueryEstimatedEthereumHeightRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, &types.QueryEstimatedEthereumHeightResponse{
+		EstimatedEthereumHeight: 540,
+		LastObservedEthereumHeight: types.LastObservedEthereumBlockHeight{
+			CosmosBlockHeight:   200,
+			EthereumBlockHeight: 520,
+		},
+		AverageBlockTime:         2000,
+		AverageEthereumBlockTime: 10000,
+	}, res)
+
+	// the calibrated averages are clamped to the governance set bounds
+	params := k.GetParams(ctx)
+	params.MinAverageBlockTime = 3000
+	params.MaxAverageEthereumBlockTime = 8000
+	k.SetParams(ctx, params)
+	averageBlockTime, averageEthereumBlockTime = k.GetAverageBlockTimes(ctx)
+	assert.Equal(t, uint64(3000), averageBlockTime)
+	assert.Equal(t, uint64(8000), averageEthereumBlockTime)
+
+	// once the first observation leaves the window there is nothing left to measure
+	ctx = ctx.WithBlockHeight(int64(100 + params.EthereumHeightHistoryBlocks + 1))
+	k.PruneEthereumHeightSamples(ctx)
+	assert.Equal(t, []types.EthereumHeightSample{{
+		CosmosBlockHeight:   200,
+		CosmosBlockTime:     uint64(start.Add(200*time.Second).UnixNano() / int64(time.Millisecond)),
+		EthereumBlockHeight: 520,
+	}}, k.GetEthereumHeightSamples(ctx))
+	averageBlockTime, averageEthereumBlockTime = k.GetAverageBlockTimes(ctx)
+	assert.Equal(t, uint64(5000), averageBlockTime)
+	assert.Equal(t, uint64(15000), averageEthereumBlockTime)
+
+	// a window of zero disables the calibration and drops the samples
+	params.EthereumHeightHistoryBlocks = 0
+	k.SetParams(ctx, params)
+	k.PruneEthereumHeightSamples(ctx)
+	assert.Empty(t, k.GetEthereumHeightSamples(ctx))
+}
+
+// tests that batches take transactions from the pool in the order of the batch selection policy
+func TestBatchSelectionPolicies(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context.WithBlockHeight(1)
+	var (
+		mySender, _            = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, _          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, _ = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, err             = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress())
+		allVouchers            = sdk.NewCoins(token.GravityCoin())
+	)
+	require.NoError(t, err)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	// id 1 with fee 1 is sent 1000 blocks before id 2 with fee 3 and id 3 with fee 2
+	for i, v := range []uint64{1, 3, 2} {
+		if i == 1 {
+			ctx = ctx.WithBlockHeight(1001)
+		}
+		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr.GetAddress())
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr.GetAddress())
+		require.NoError(t, err)
+		_, err = k.AddToOutgoingPool(ctx, mySender, *myReceiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+		require.NoError(t, err)
+	}
+	selectedIds := func() (ids []uint64) {
+		for _, tx := range k.selectUnbatchedTX(ctx, *myTokenContractAddr, 2) {
+			ids = append(ids, tx.Id)
+		}
+		return ids
+	}
+
+	// fee descending takes the highest fees
+	assert.Equal(t, []uint64{2, 3}, selectedIds())
+	assert.Equal(t, sdk.NewInt(5), k.GetBatchFeeByTokenType(ctx, *myTokenContractAddr, 2).TotalFees)
+
+	// FIFO takes the oldest transactions
+	params := k.GetParams(ctx)
+	params.BatchSelectionPolicy = types.BATCH_SELECTION_POLICY_FIFO
+	k.SetParams(ctx, params)
+	assert.Equal(t, []uint64{1, 2}, selectedIds())
+	assert.Equal(t, sdk.NewInt(4), k.GetBatchFeeByTokenType(ctx, *myTokenContractAddr, 2).TotalFees)
+
+	// age weighted scores id 1 with 1 * (1 + 1000 * 0.01) = 11, above the fees of the others
+	params.BatchSelectionPolicy = types.BATCH_SELECTION_POLICY_AGE_WEIGHTED
+	params.BatchAgeWeight = sdk.NewDecWithPrec(1, 2)
+	k.SetParams(ctx, params)
+	assert.Equal(t, []uint64{1, 2}, selectedIds())
+
+	// the batch size limit of the token caps every batch and the batch fees, whatever the case of the token
+	params.BatchSizeLimits = []types.BatchSizeLimit{{Token: strings.ToLower(myTokenContractAddr.GetAddress()), MaxBatchSize: 1}}
+	k.SetParams(ctx, params)
+	assert.Equal(t, []uint64{1}, selectedIds())
+	assert.Equal(t, []types.BatchFees{{Token: myTokenContractAddr.GetAddress(), TotalFees: sdk.NewInt(1)}}, k.GetAllBatchFees(ctx))
+
+	batch, err := k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+	assert.Equal(t, uint64(1), batch.Transactions[0].Id)
+
+	// id 2 scores 3 against the 11 of the last batch
+	_, err = k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.Error(t, err)
+
+	// 401 blocks later id 2 scores 3 * 5.01 = 15.03 against 1 * 15.01
+	ctx = ctx.WithBlockHeight(1402)
+	batch, err = k.BuildOutgoingTXBatch(ctx, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+	assert.Equal(t, uint64(2), batch.Transactions[0].Id)
+
+	// id 3 without a status was sent before statuses were tracked and scores as sent at height 0
+	ctx.KVStore(k.storeKey).Delete([]byte(types.GetTransferStatusKey(3)))
+	tx, err := k.GetUnbatchedTxById(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(2).Mul(sdk.OneDec().Add(params.BatchAgeWeight.MulInt64(1402))), k.transferScore(ctx, tx, params.BatchAgeWeight))
+}
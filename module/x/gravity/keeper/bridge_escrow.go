@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// GetBridgeEscrowByDenom returns the supply of a cosmos originated denom which is locked in the module
+// account because it has been sent to Ethereum
+func (k Keeper) GetBridgeEscrowByDenom(ctx sdk.Context, denom string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetBridgeEscrowKey(denom)))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+// IterateBridgeEscrow iterates through the locked supply of every cosmos originated denom
+func (k Keeper) IterateBridgeEscrow(ctx sdk.Context, cb func([]byte, sdk.Coin) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.BridgeEscrowKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			panic(err)
+		}
+		// cb returns true to stop early
+		if cb(iter.Key(), sdk.NewCoin(string(iter.Key()), amount)) {
+			break
+		}
+	}
+}
+
+// GetBridgeEscrow returns the locked supply of every cosmos originated denom
+func (k Keeper) GetBridgeEscrow(ctx sdk.Context) sdk.Coins {
+	escrow := sdk.NewCoins()
+	k.IterateBridgeEscrow(ctx, func(_ []byte, coin sdk.Coin) bool {
+		escrow = escrow.Add(coin)
+		return false
+	})
+	return escrow
+}
+
+// setBridgeEscrow stores the locked supply of a cosmos originated denom, nothing is stored for a zero supply
+// WARNING: Do not make this function public
+func (k Keeper) setBridgeEscrow(ctx sdk.Context, coin sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	key := []byte(types.GetBridgeEscrowKey(coin.Denom))
+	if coin.IsZero() {
+		store.Delete(key)
+		return
+	}
+	bz, err := coin.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// lockBridgeEscrow records cosmos originated coins held by the module account which now exist on Ethereum
+// WARNING: Do not make this function public
+func (k Keeper) lockBridgeEscrow(ctx sdk.Context, coin sdk.Coin) {
+	k.setBridgeEscrow(ctx, k.GetBridgeEscrowByDenom(ctx, coin.Denom).Add(coin))
+}
+
+// unlockBridgeEscrow records cosmos originated coins which came back from Ethereum and are released by the module.
+// The locked supply can not go below zero, more coins coming back than were locked can only be supply which was
+// sent to Ethereum before the locked supply was tracked
+// WARNING: Do not make this function public
+func (k Keeper) unlockBridgeEscrow(ctx sdk.Context, coin sdk.Coin) {
+	locked := k.GetBridgeEscrowByDenom(ctx, coin.Denom)
+	if locked.IsLT(coin) {
+		k.logger(ctx).Error("Unlocking more than the locked supply of cosmos originated denom",
+			"denom", coin.Denom,
+			"locked", locked.Amount.String(),
+			"unlocked", coin.Amount.String(),
+		)
+		k.setBridgeEscrow(ctx, sdk.NewCoin(coin.Denom, sdk.ZeroInt()))
+		return
+	}
+	k.setBridgeEscrow(ctx, locked.Sub(coin))
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// GetConfirmSigningInfo returns the confirmations a validator has missed in its signing window
+func (k Keeper) GetConfirmSigningInfo(ctx sdk.Context, validator sdk.ValAddress) (types.ConfirmSigningInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetConfirmSigningInfoKey(validator)))
+	if len(bz) == 0 {
+		return types.ConfirmSigningInfo{}, false
+	}
+	var info types.ConfirmSigningInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	return info, true
+}
+
+// SetConfirmSigningInfo stores the confirmations a validator has missed in its signing window
+func (k Keeper) SetConfirmSigningInfo(ctx sdk.Context, info types.ConfirmSigningInfo) {
+	val, err := sdk.ValAddressFromBech32(info.Validator)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid validator in confirm signing info: %s", info.Validator))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetConfirmSigningInfoKey(val)), k.cdc.MustMarshal(&info))
+}
+
+// IterateConfirmSigningInfos iterates through the signing info of every validator
+func (k Keeper) IterateConfirmSigningInfos(ctx sdk.Context, cb func([]byte, types.ConfirmSigningInfo) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ConfirmSigningInfoKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.ConfirmSigningInfo
+		k.cdc.MustUnmarshal(iter.Value(), &info)
+		// cb returns true to stop early
+		if cb(iter.Key(), info) {
+			break
+		}
+	}
+}
+
+// GetConfirmSigningInfos returns the signing info of every validator
+func (k Keeper) GetConfirmSigningInfos(ctx sdk.Context) (out []types.ConfirmSigningInfo) {
+	k.IterateConfirmSigningInfos(ctx, func(_ []byte, info types.ConfirmSigningInfo) bool {
+		out = append(out, info)
+		return false
+	})
+	return
+}
+
+// HandleConfirmSignature counts a signing request the validator was required to confirm in its signing window
+// and returns true if the validator should be slashed, which is when it has signed less than MinSignedPerWindow
+// of a full window. The window of a slashed validator starts over. When the ConfirmSigningWindow param is zero
+// nothing is counted and every missed confirmation is slashed
+func (k Keeper) HandleConfirmSignature(ctx sdk.Context, validator sdk.ValAddress, signed bool) bool {
+	var window uint64
+	k.paramSpace.Get(ctx, types.ParamStoreConfirmSigningWindow, &window)
+	if window == 0 {
+		return !signed
+	}
+	var minSigned sdk.Dec
+	k.paramSpace.Get(ctx, types.ParamStoreMinSignedPerWindow, &minSigned)
+
+	// the window also starts over when governance changes its size
+	info, found := k.GetConfirmSigningInfo(ctx, validator)
+	if !found || len(info.MissedConfirms) != missedConfirmsLen(window) {
+		info = newConfirmSigningInfo(validator, window)
+	}
+
+	index := info.IndexOffset % window
+	info.IndexOffset++
+	previous := getMissedConfirm(info.MissedConfirms, index)
+	switch {
+	case !previous && !signed:
+		setMissedConfirm(info.MissedConfirms, index, true)
+		info.MissedConfirmsCounter++
+	case previous && signed:
+		setMissedConfirm(info.MissedConfirms, index, false)
+		info.MissedConfirmsCounter--
+	}
+
+	maxMissed := window - uint64(minSigned.MulInt64(int64(window)).RoundInt64())
+	if info.IndexOffset >= window && info.MissedConfirmsCounter > maxMissed {
+		k.SetConfirmSigningInfo(ctx, newConfirmSigningInfo(validator, window))
+		return true
+	}
+	k.SetConfirmSigningInfo(ctx, info)
+	return false
+}
+
+func newConfirmSigningInfo(validator sdk.ValAddress, window uint64) types.ConfirmSigningInfo {
+	return types.ConfirmSigningInfo{
+		Validator:             validator.String(),
+		IndexOffset:           0,
+		MissedConfirmsCounter: 0,
+		MissedConfirms:        make([]byte, missedConfirmsLen(window)),
+	}
+}
+
+// missedConfirmsLen returns the number of bytes needed for a bit per signing request in the window
+func missedConfirmsLen(window uint64) int {
+	return int((window + 7) / 8)
+}
+
+func getMissedConfirm(missed []byte, index uint64) bool {
+	return missed[index/8]&(1<<(index%8)) != 0
+}
+
+func setMissedConfirm(missed []byte, index uint64, value bool) {
+	if value {
+		missed[index/8] |= 1 << (index % 8)
+	} else {
+		missed[index/8] &^= 1 << (index % 8)
+	}
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// SetConflictingClaim stores the record of a validator which voted for an attestation that lost
+func (k Keeper) SetConflictingClaim(ctx sdk.Context, conflict types.ConflictingClaim) {
+	val, err := sdk.ValAddressFromBech32(conflict.Validator)
+	if err != nil {
+		panic(fmt.Sprintf("invalid validator in conflicting claim %d: %s", conflict.EventNonce, conflict.Validator))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetConflictingClaimKey(conflict.EventNonce, val)), k.cdc.MustMarshal(&conflict))
+}
+
+// HasConflictingClaim returns true if the validator has been recorded voting for a losing attestation at the event nonce
+func (k Keeper) HasConflictingClaim(ctx sdk.Context, eventNonce uint64, validator sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has([]byte(types.GetConflictingClaimKey(eventNonce, validator)))
+}
+
+// IterateConflictingClaims iterates through all conflicting claims in ASC event nonce order
+func (k Keeper) IterateConflictingClaims(ctx sdk.Context, cb func([]byte, types.ConflictingClaim) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ConflictingClaimKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var conflict types.ConflictingClaim
+		k.cdc.MustUnmarshal(iter.Value(), &conflict)
+		// cb returns true to stop early
+		if cb(iter.Key(), conflict) {
+			break
+		}
+	}
+}
+
+// GetConflictingClaims returns all the conflicting claims in state
+func (k Keeper) GetConflictingClaims(ctx sdk.Context) (out []types.ConflictingClaim) {
+	k.IterateConflictingClaims(ctx, func(_ []byte, conflict types.ConflictingClaim) bool {
+		out = append(out, conflict)
+		return false
+	})
+	return
+}
+
+// GetConflictingClaimsByValidator returns the conflicting claims of a validator
+func (k Keeper) GetConflictingClaimsByValidator(ctx sdk.Context, validator sdk.ValAddress) (out []types.ConflictingClaim) {
+	k.IterateConflictingClaims(ctx, func(_ []byte, conflict types.ConflictingClaim) bool {
+		if conflict.Validator == validator.String() {
+			out = append(out, conflict)
+		}
+		return false
+	})
+	return
+}
+
+// punishConflictingClaims records and slashes the voters of the attestations at an event nonce which
+// lost to the observed attestation at that nonce. Voters which have already been punished for the
+// nonce are skipped, so this is called both when the nonce is observed and for every later vote at it
+// WARNING: Do not make this function public
+func (k Keeper) punishConflictingClaims(ctx sdk.Context, eventNonce uint64) {
+	atts := k.GetAttestationsByNonce(ctx, eventNonce)
+	var observedHash []byte
+	for i := range atts {
+		if atts[i].Observed {
+			observedHash = k.attestationClaimHash(&atts[i])
+		}
+	}
+	if observedHash == nil {
+		return
+	}
+
+	slashFraction := k.GetParams(ctx).SlashFractionConflictingClaim
+	for i := range atts {
+		if atts[i].Observed {
+			continue
+		}
+		claim, err := k.UnpackAttestationClaim(&atts[i])
+		if err != nil {
+			panic("could not cast to claim")
+		}
+		hash := k.attestationClaimHash(&atts[i])
+		for _, vote := range atts[i].Votes {
+			val, err := sdk.ValAddressFromBech32(vote)
+			if err != nil {
+				panic(err)
+			}
+			if k.HasConflictingClaim(ctx, eventNonce, val) {
+				continue
+			}
+			k.SetConflictingClaim(ctx, types.ConflictingClaim{
+				Validator:         vote,
+				EventNonce:        eventNonce,
+				ClaimType:         claim.GetType(),
+				ClaimHash:         hex.EncodeToString(hash),
+				ObservedClaimHash: hex.EncodeToString(observedHash),
+				BlockHeight:       uint64(ctx.BlockHeight()),
+			})
+			k.slashConflictingClaim(ctx, val, eventNonce, slashFraction)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeConflictingClaim,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeKeyValidator, vote),
+					sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(eventNonce)),
+					sdk.NewAttribute(types.AttributeKeyAttestationType, claim.GetType().String()),
+					sdk.NewAttribute(types.AttributeKeyClaimHash, hex.EncodeToString(hash)),
+					sdk.NewAttribute(types.AttributeKeyObservedClaimHash, hex.EncodeToString(observedHash)),
+				),
+			)
+		}
+	}
+}
+
+// slashConflictingClaim slashes a validator for a conflicting claim at eventNonce without jailing it,
+// validators which have already unbonded can no longer be slashed
+func (k Keeper) slashConflictingClaim(ctx sdk.Context, val sdk.ValAddress, eventNonce uint64, slashFraction sdk.Dec) {
+	validator, found := k.StakingKeeper.GetValidator(ctx, val)
+	if !found || validator.IsUnbonded() {
+		return
+	}
+	cons, err := validator.GetConsAddr()
+	if err != nil {
+		panic(err)
+	}
+	k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), validator.ConsensusPower(sdk.DefaultPowerReduction), slashFraction)
+	k.RecordSlash(ctx, val, types.SLASH_REASON_CONFLICTING_CLAIM, eventNonce, "", slashFraction, false)
+}
+
+func (k Keeper) attestationClaimHash(att *types.Attestation) []byte {
+	claim, err := k.UnpackAttestationClaim(att)
+	if err != nil {
+		panic("could not cast to claim")
+	}
+	hash, err := claim.ClaimHash()
+	if err != nil {
+		panic("unable to compute claim hash")
+	}
+	return hash
+}
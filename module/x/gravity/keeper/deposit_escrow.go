@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// SetDepositEscrow stores the escrowed tokens of a deposit which could not be credited to its receiver,
+// the tokens themselves must already be held by the gravity module account
+func (k Keeper) SetDepositEscrow(ctx sdk.Context, escrow types.DepositEscrow) {
+	receiver, err := types.IBCAddressFromBech32(escrow.Receiver)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid receiver in deposit escrow %d", escrow.EventNonce))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetDepositEscrowKey(receiver, escrow.EventNonce)), k.cdc.MustMarshal(&escrow))
+}
+
+// IterateDepositEscrowsByReceiver iterates through the escrowed deposits of a receiver in ASC event nonce order
+func (k Keeper) IterateDepositEscrowsByReceiver(ctx sdk.Context, receiver sdk.AccAddress, cb func([]byte, types.DepositEscrow) bool) {
+	k.iterateDepositEscrows(ctx, []byte(types.GetDepositEscrowPrefix(receiver)), cb)
+}
+
+// IterateDepositEscrows iterates through all escrowed deposits ordered by receiver
+func (k Keeper) IterateDepositEscrows(ctx sdk.Context, cb func([]byte, types.DepositEscrow) bool) {
+	k.iterateDepositEscrows(ctx, []byte(types.DepositEscrowKey), cb)
+}
+
+func (k Keeper) iterateDepositEscrows(ctx sdk.Context, prefixKey []byte, cb func([]byte, types.DepositEscrow) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var escrow types.DepositEscrow
+		k.cdc.MustUnmarshal(iter.Value(), &escrow)
+		// cb returns true to stop early
+		if cb(iter.Key(), escrow) {
+			break
+		}
+	}
+}
+
+// GetDepositEscrowsByReceiver returns the escrowed deposits of a receiver
+func (k Keeper) GetDepositEscrowsByReceiver(ctx sdk.Context, receiver sdk.AccAddress) (out []types.DepositEscrow) {
+	k.IterateDepositEscrowsByReceiver(ctx, receiver, func(_ []byte, escrow types.DepositEscrow) bool {
+		out = append(out, escrow)
+		return false
+	})
+	return
+}
+
+// GetDepositEscrows returns all the escrowed deposits in state
+func (k Keeper) GetDepositEscrows(ctx sdk.Context) (out []types.DepositEscrow) {
+	k.IterateDepositEscrows(ctx, func(_ []byte, escrow types.DepositEscrow) bool {
+		out = append(out, escrow)
+		return false
+	})
+	return
+}
+
+// ReleaseDepositEscrows sends all the escrowed deposits of receiver to recipient, the receiver claims its own
+// deposits while governance may release them to any recipient. Nothing is released if the transfer fails
+func (k Keeper) ReleaseDepositEscrows(ctx sdk.Context, receiver sdk.AccAddress, recipient sdk.AccAddress) (sdk.Coins, error) {
+	escrows := k.GetDepositEscrowsByReceiver(ctx, receiver)
+	if len(escrows) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrEmpty, "no escrowed deposits for %s", receiver)
+	}
+
+	total := sdk.NewCoins()
+	for _, escrow := range escrows {
+		total = total.Add(escrow.Amount)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, total); err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to release escrowed deposits")
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, escrow := range escrows {
+		store.Delete([]byte(types.GetDepositEscrowKey(receiver, escrow.EventNonce)))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDepositEscrowReleased,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, total.String()),
+		),
+	)
+	return total, nil
+}
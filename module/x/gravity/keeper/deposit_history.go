@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// SetDepositRecord stores the record of an observed deposit from Ethereum and indexes it by
+// cosmos receiver, ethereum sender and token contract. A receiver which is not a valid address
+// is not indexed, those deposits are sent to the community pool
+func (k Keeper) SetDepositRecord(ctx sdk.Context, record types.DepositRecord) {
+	store := ctx.KVStore(k.storeKey)
+	nonce := types.UInt64Bytes(record.EventNonce)
+	store.Set([]byte(types.GetDepositRecordKey(record.EventNonce)), k.cdc.MustMarshal(&record))
+
+	if receiver, err := types.IBCAddressFromBech32(record.CosmosReceiver); err == nil {
+		store.Set([]byte(types.GetDepositByReceiverKey(receiver, record.EventNonce)), nonce)
+	}
+	if sender, err := types.NewEthAddress(record.EthereumSender); err == nil {
+		store.Set([]byte(types.GetDepositBySenderKey(*sender, record.EventNonce)), nonce)
+	}
+	token, err := types.NewEthAddress(record.TokenContract)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid token contract in deposit record %d", record.EventNonce))
+	}
+	store.Set([]byte(types.GetDepositByTokenKey(*token, record.EventNonce)), nonce)
+}
+
+// GetDepositRecord returns the record of the deposit observed with the given event nonce
+func (k Keeper) GetDepositRecord(ctx sdk.Context, eventNonce uint64) *types.DepositRecord {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetDepositRecordKey(eventNonce)))
+	if bz == nil {
+		return nil
+	}
+	var record types.DepositRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record
+}
+
+// IterateDepositRecords iterates through all deposit records in ASC event nonce order
+func (k Keeper) IterateDepositRecords(ctx sdk.Context, cb func([]byte, types.DepositRecord) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.DepositRecordKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.DepositRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		// cb returns true to stop early
+		if cb(iter.Key(), record) {
+			break
+		}
+	}
+}
+
+// GetDepositRecords returns all the deposit records in state
+func (k Keeper) GetDepositRecords(ctx sdk.Context) (out []types.DepositRecord) {
+	k.IterateDepositRecords(ctx, func(_ []byte, record types.DepositRecord) bool {
+		out = append(out, record)
+		return false
+	})
+	return
+}
@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// GetEthereumHeightVote returns the latest Ethereum block height claimed by a validator
+func (k Keeper) GetEthereumHeightVote(ctx sdk.Context, validator sdk.ValAddress) (types.EthereumHeightVote, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetEthereumHeightVoteKey(validator)))
+	if len(bz) == 0 {
+		return types.EthereumHeightVote{}, false
+	}
+	var vote types.EthereumHeightVote
+	k.cdc.MustUnmarshal(bz, &vote)
+	return vote, true
+}
+
+// SetEthereumHeightVote stores the latest Ethereum block height claimed by a validator, replacing its previous vote
+func (k Keeper) SetEthereumHeightVote(ctx sdk.Context, vote types.EthereumHeightVote) {
+	val, err := sdk.ValAddressFromBech32(vote.Validator)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid validator in ethereum height vote: %s", vote.Validator))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetEthereumHeightVoteKey(val)), k.cdc.MustMarshal(&vote))
+}
+
+// IterateEthereumHeightVotes iterates through the latest Ethereum height vote of every validator
+func (k Keeper) IterateEthereumHeightVotes(ctx sdk.Context, cb func([]byte, types.EthereumHeightVote) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.EthereumHeightVoteKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var vote types.EthereumHeightVote
+		k.cdc.MustUnmarshal(iter.Value(), &vote)
+		// cb returns true to stop early
+		if cb(iter.Key(), vote) {
+			break
+		}
+	}
+}
+
+// GetEthereumHeightVotes returns the latest Ethereum height vote of every validator
+func (k Keeper) GetEthereumHeightVotes(ctx sdk.Context) (out []types.EthereumHeightVote) {
+	k.IterateEthereumHeightVotes(ctx, func(_ []byte, vote types.EthereumHeightVote) bool {
+		out = append(out, vote)
+		return false
+	})
+	return
+}
+
+// TallyEthereumHeightVotes raises the last observed Ethereum block height to the highest height that validators
+// holding the CLAIM_TYPE_ETHEREUM_HEIGHT attestation threshold of the current power have claimed to have reached.
+// Since the threshold is above one half a minority can neither push the height ahead nor hold it back. Votes of
+// validators which are no longer bonded carry no power, and the observed height never goes down
+func (k Keeper) TallyEthereumHeightVotes(ctx sdk.Context) {
+	totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
+	if !totalPower.IsPositive() {
+		return
+	}
+	requiredPower := k.GetAttestationThreshold(ctx, types.CLAIM_TYPE_ETHEREUM_HEIGHT).MulInt(totalPower).TruncateInt()
+
+	votes := k.GetEthereumHeightVotes(ctx)
+	sort.SliceStable(votes, func(i, j int) bool {
+		return votes[i].EthereumBlockHeight > votes[j].EthereumBlockHeight
+	})
+	votedPower := sdk.ZeroInt()
+	for _, vote := range votes {
+		val, err := sdk.ValAddressFromBech32(vote.Validator)
+		if err != nil {
+			panic(err)
+		}
+		votedPower = votedPower.Add(sdk.NewInt(k.StakingKeeper.GetLastValidatorPower(ctx, val)))
+		if votedPower.IsPositive() && votedPower.GTE(requiredPower) {
+			if vote.EthereumBlockHeight > k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight {
+				k.SetLastObservedEthereumBlockHeight(ctx, vote.EthereumBlockHeight)
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeEthereumHeightObserved,
+						sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+						sdk.NewAttribute(types.AttributeKeyEthereumHeight, fmt.Sprint(vote.EthereumBlockHeight)),
+					),
+				)
+			}
+			return
+		}
+	}
+}
+
+// recordEthereumHeightSample samples a newly observed Ethereum height together with the current Cosmos block
+// height and time, nothing is sampled while the calibration window is zero
+func (k Keeper) recordEthereumHeightSample(ctx sdk.Context, ethereumHeight uint64) {
+	if k.GetParams(ctx).EthereumHeightHistoryBlocks == 0 {
+		return
+	}
+	blockTime := ctx.BlockTime().UnixNano() / int64(time.Millisecond)
+	if blockTime <= 0 {
+		return
+	}
+	k.SetEthereumHeightSample(ctx, types.EthereumHeightSample{
+		CosmosBlockHeight:   uint64(ctx.BlockHeight()),
+		CosmosBlockTime:     uint64(blockTime),
+		EthereumBlockHeight: ethereumHeight,
+	})
+}
+
+// SetEthereumHeightSample stores an Ethereum height sample, replacing any sample taken at the same Cosmos height
+func (k Keeper) SetEthereumHeightSample(ctx sdk.Context, sample types.EthereumHeightSample) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetEthereumHeightSampleKey(sample.CosmosBlockHeight)), k.cdc.MustMarshal(&sample))
+}
+
+// IterateEthereumHeightSamples iterates through the Ethereum height samples from the oldest to the newest
+func (k Keeper) IterateEthereumHeightSamples(ctx sdk.Context, cb func([]byte, types.EthereumHeightSample) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.EthereumHeightSampleKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var sample types.EthereumHeightSample
+		k.cdc.MustUnmarshal(iter.Value(), &sample)
+		// cb returns true to stop early
+		if cb(iter.Key(), sample) {
+			break
+		}
+	}
+}
+
+// GetEthereumHeightSamples returns the Ethereum height samples from the oldest to the newest
+func (k Keeper) GetEthereumHeightSamples(ctx sdk.Context) (out []types.EthereumHeightSample) {
+	k.IterateEthereumHeightSamples(ctx, func(_ []byte, sample types.EthereumHeightSample) bool {
+		out = append(out, sample)
+		return false
+	})
+	return
+}
+
+// getNewestEthereumHeightSample returns the Ethereum height sample taken at the highest Cosmos block height
+func (k Keeper) getNewestEthereumHeightSample(ctx sdk.Context) (types.EthereumHeightSample, bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.EthereumHeightSampleKey))
+	iter := prefixStore.ReverseIterator(nil, nil)
+	defer iter.Close()
+	if !iter.Valid() {
+		return types.EthereumHeightSample{}, false
+	}
+	var sample types.EthereumHeightSample
+	k.cdc.MustUnmarshal(iter.Value(), &sample)
+	return sample, true
+}
+
+// PruneEthereumHeightSamples deletes the Ethereum height samples taken more than ethereum_height_history_blocks
+// Cosmos blocks ago, every sample is deleted once the calibration window is set to zero
+func (k Keeper) PruneEthereumHeightSamples(ctx sdk.Context) {
+	window := k.GetParams(ctx).EthereumHeightHistoryBlocks
+	currentHeight := uint64(ctx.BlockHeight())
+	store := ctx.KVStore(k.storeKey)
+	var stale []uint64
+	k.IterateEthereumHeightSamples(ctx, func(_ []byte, sample types.EthereumHeightSample) bool {
+		if window != 0 && sample.CosmosBlockHeight+window >= currentHeight {
+			return true
+		}
+		stale = append(stale, sample.CosmosBlockHeight)
+		return false
+	})
+	for _, height := range stale {
+		store.Delete([]byte(types.GetEthereumHeightSampleKey(height)))
+	}
+}
+
+// GetAverageBlockTimes returns the average Cosmos and Ethereum block times in milliseconds used to project the
+// current Ethereum height. They are measured between the oldest and the newest Ethereum height sample and
+// clamped to the governance set bounds, until the samples span both Cosmos and Ethereum blocks and some time
+// the average_block_time and average_ethereum_block_time params are used as is
+func (k Keeper) GetAverageBlockTimes(ctx sdk.Context) (averageBlockTime uint64, averageEthereumBlockTime uint64) {
+	params := k.GetParams(ctx)
+	averageBlockTime, averageEthereumBlockTime = params.AverageBlockTime, params.AverageEthereumBlockTime
+	if params.EthereumHeightHistoryBlocks == 0 {
+		return averageBlockTime, averageEthereumBlockTime
+	}
+
+	var oldest types.EthereumHeightSample
+	found := false
+	k.IterateEthereumHeightSamples(ctx, func(_ []byte, sample types.EthereumHeightSample) bool {
+		oldest, found = sample, true
+		return true
+	})
+	newest, _ := k.getNewestEthereumHeightSample(ctx)
+	if !found ||
+		newest.CosmosBlockHeight <= oldest.CosmosBlockHeight ||
+		newest.CosmosBlockTime <= oldest.CosmosBlockTime ||
+		newest.EthereumBlockHeight <= oldest.EthereumBlockHeight {
+		return averageBlockTime, averageEthereumBlockTime
+	}
+
+	elapsedMillis := newest.CosmosBlockTime - oldest.CosmosBlockTime
+	averageBlockTime = clampUint64(
+		elapsedMillis/(newest.CosmosBlockHeight-oldest.CosmosBlockHeight),
+		params.MinAverageBlockTime,
+		params.MaxAverageBlockTime,
+	)
+	averageEthereumBlockTime = clampUint64(
+		elapsedMillis/(newest.EthereumBlockHeight-oldest.EthereumBlockHeight),
+		params.MinAverageEthereumBlockTime,
+		params.MaxAverageEthereumBlockTime,
+	)
+	return averageBlockTime, averageEthereumBlockTime
+}
+
+// GetEstimatedEthereumHeight projects the current Ethereum block height from the last observed Ethereum height
+// using the average block times, zero is returned while no Ethereum height has been observed
+func (k Keeper) GetEstimatedEthereumHeight(ctx sdk.Context) uint64 {
+	heights := k.GetLastObservedEthereumBlockHeight(ctx)
+	if heights.CosmosBlockHeight == 0 || heights.EthereumBlockHeight == 0 {
+		return 0
+	}
+	averageBlockTime, averageEthereumBlockTime := k.GetAverageBlockTimes(ctx)
+	return projectEthereumHeight(ctx, heights, averageBlockTime, averageEthereumBlockTime)
+}
+
+// projectEthereumHeight projects the Ethereum height at the current Cosmos block height from an observed pair
+// of heights
+func projectEthereumHeight(
+	ctx sdk.Context,
+	heights types.LastObservedEthereumBlockHeight,
+	averageBlockTime uint64,
+	averageEthereumBlockTime uint64,
+) uint64 {
+	// we project how long it has been in milliseconds since the last Ethereum block height was observed
+	projectedMillis := (uint64(ctx.BlockHeight()) - heights.CosmosBlockHeight) * averageBlockTime
+	// we convert that projection into the current Ethereum height using the average Ethereum block time in millis
+	return (projectedMillis / averageEthereumBlockTime) + heights.EthereumBlockHeight
+}
+
+func clampUint64(v, min, max uint64) uint64 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
@@ -4,9 +4,13 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
@@ -24,18 +28,18 @@ func (k Keeper) CheckBadSignatureEvidence(
 
 	switch subject := subject.(type) {
 	case *types.OutgoingTxBatch:
-		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
+		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature, msg.Sender)
 	case *types.Valset:
-		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
+		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature, msg.Sender)
 	case *types.OutgoingLogicCall:
-		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
+		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature, msg.Sender)
 
 	default:
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Bad signature must be over a batch, valset, or logic call got %s", subject))
 	}
 }
 
-func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types.EthereumSigned, signature string) error {
+func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types.EthereumSigned, signature string, sender string) error {
 	// Get checkpoint of the supposed bad signature (fake valset, batch, or logic call submitted to eth)
 	gravityID := k.GetGravityID(ctx)
 	checkpoint := subject.GetCheckpoint(gravityID)
@@ -46,27 +50,143 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 		return sdkerrors.Wrap(types.ErrInvalid, "Checkpoint exists, cannot slash")
 	}
 
+	// Get eth address of the offending validator using the checkpoint and the signature
+	ethAddress, err := ethAddressFromHexSignature(checkpoint, signature)
+	if err != nil {
+		return err
+	}
+
+	// The same evidence may only slash once. It is identified by the checkpoint and the signer rather than
+	// the signature itself, since an ECDSA signature can be re-encoded into a different valid signature
+	evidenceHash := tmhash.Sum(append(append([]byte{}, checkpoint...), []byte(ethAddress.GetAddress())...))
+	return k.slashBadEthSignature(ctx, *ethAddress, evidenceHash, sender, types.SLASH_REASON_BAD_ETH_SIGNATURE, subject)
+}
+
+// CheckDoubleSignEvidence slashes the validator whose Ethereum key signed two different checkpoints for the same
+// valset nonce, batch nonce of a token, or logic call invalidation nonce
+func (k Keeper) CheckDoubleSignEvidence(ctx sdk.Context, msg *types.MsgSubmitDoubleSignEvidence) error {
+	var subjectA, subjectB types.EthereumSigned
+	if err := k.cdc.UnpackAny(msg.SubjectA, &subjectA); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Invalid Any encoded evidence %s", err))
+	}
+	if err := k.cdc.UnpackAny(msg.SubjectB, &subjectB); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Invalid Any encoded evidence %s", err))
+	}
+
+	// Both subjects must claim the same nonce, this is what makes signing both of them equivocation
+	slotA, err := doubleSignSlot(subjectA)
+	if err != nil {
+		return err
+	}
+	slotB, err := doubleSignSlot(subjectB)
+	if err != nil {
+		return err
+	}
+	if slotA != slotB {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("subjects are for different nonces %s and %s", slotA, slotB))
+	}
+
+	gravityID := k.GetGravityID(ctx)
+	checkpointA := subjectA.GetCheckpoint(gravityID)
+	checkpointB := subjectB.GetCheckpoint(gravityID)
+	if bytes.Equal(checkpointA, checkpointB) {
+		return sdkerrors.Wrap(types.ErrInvalid, "subjects have the same checkpoint")
+	}
+
+	// Both signatures must come from the same Ethereum key
+	ethAddressA, err := ethAddressFromHexSignature(checkpointA, msg.SignatureA)
+	if err != nil {
+		return err
+	}
+	ethAddressB, err := ethAddressFromHexSignature(checkpointB, msg.SignatureB)
+	if err != nil {
+		return err
+	}
+	if ethAddressA.GetAddress() != ethAddressB.GetAddress() {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signatures are from different eth addresses %s and %s", ethAddressA, ethAddressB))
+	}
+
+	// A key equivocating on a nonce may only be slashed once for it, no matter how many checkpoints it signed
+	evidenceHash := tmhash.Sum([]byte(slotA + "/" + ethAddressA.GetAddress()))
+	return k.slashBadEthSignature(ctx, *ethAddressA, evidenceHash, msg.Sender, types.SLASH_REASON_DOUBLE_SIGN, subjectA)
+}
+
+// doubleSignSlot returns the nonce a valset, batch, or logic call claims on Ethereum, no two different
+// checkpoints should ever be signed for it
+func doubleSignSlot(subject types.EthereumSigned) (string, error) {
+	switch subject := subject.(type) {
+	case *types.OutgoingTxBatch:
+		// the contract is checksummed so that a re-cased address names the same token
+		return fmt.Sprintf("batch/%s/%d", gethcommon.HexToAddress(subject.TokenContract).Hex(), subject.BatchNonce), nil
+	case *types.Valset:
+		return fmt.Sprintf("valset/%d", subject.Nonce), nil
+	case *types.OutgoingLogicCall:
+		return fmt.Sprintf("logic_call/%s/%d", hex.EncodeToString(subject.InvalidationId), subject.InvalidationNonce), nil
+
+	default:
+		return "", sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Double signed subject must be a batch, valset, or logic call got %s", subject))
+	}
+}
+
+// slashSubject returns the nonce and token a valset, batch, or logic call is recorded under when a validator
+// is slashed for signing it, the token is the token contract of a batch or the hex encoded invalidation id
+// of a logic call
+func slashSubject(subject types.EthereumSigned) (uint64, string) {
+	switch subject := subject.(type) {
+	case *types.OutgoingTxBatch:
+		return subject.BatchNonce, subject.TokenContract
+	case *types.Valset:
+		return subject.Nonce, ""
+	case *types.OutgoingLogicCall:
+		return subject.InvalidationNonce, hex.EncodeToString(subject.InvalidationId)
+
+	default:
+		return 0, ""
+	}
+}
+
+// ethAddressFromHexSignature returns the eth address which made the hex encoded signature over checkpoint
+func ethAddressFromHexSignature(checkpoint []byte, signature string) (*types.EthAddress, error) {
 	// Decode Eth signature to bytes
 
 	// strip 0x prefix if needed
-	if signature[:2] == "0x" {
-		signature = signature[2:]
-	}
-	sigBytes, err := hex.DecodeString(signature)
+	hexSignature := strings.TrimPrefix(signature, "0x")
+	sigBytes, err := hex.DecodeString(hexSignature)
 	if err != nil {
-		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature decoding %s", signature))
+		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature decoding %s", hexSignature))
 	}
 
-	// Get eth address of the offending validator using the checkpoint and the signature
 	ethAddress, err := types.EthAddressFromSignature(checkpoint, sigBytes)
 	if err != nil {
-		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature to eth address failed with checkpoint %s and signature %s", hex.EncodeToString(checkpoint), signature))
+		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature to eth address failed with checkpoint %s and signature %s", hex.EncodeToString(checkpoint), hexSignature))
+	}
+	return ethAddress, nil
+}
+
+// slashBadEthSignature slashes and jails the validator of ethAddress with SlashFractionBadEthSignature for signing
+// subject, records the evidence so it can not slash again and pays the bounty to the sender of the evidence
+func (k Keeper) slashBadEthSignature(
+	ctx sdk.Context,
+	ethAddress types.EthAddress,
+	evidenceHash []byte,
+	sender string,
+	reason types.SlashReason,
+	subject types.EthereumSigned,
+) error {
+	if k.HasBadSignatureEvidence(ctx, evidenceHash) {
+		return sdkerrors.Wrap(types.ErrDuplicate, fmt.Sprintf("bad signature evidence %s already submitted", hex.EncodeToString(evidenceHash)))
 	}
 
-	// Find the offending validator by eth address
-	val, found := k.GetValidatorByEthAddress(ctx, *ethAddress)
+	// Find the offending validator by eth address, signatures of a rotated out key are attributed to
+	// the validator which held it
+	val, found := k.GetValidatorByEthAddress(ctx, ethAddress)
+	if !found {
+		if valAddr, foundPast := k.GetPastValidatorByEthAddress(ctx, ethAddress); foundPast {
+			val, found = k.StakingKeeper.GetValidator(ctx, valAddr)
+		}
+	}
 	if !found {
-		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Did not find validator for eth address %s from signature %s with checkpoint %s and GravityID %s", ethAddress, signature, hex.EncodeToString(checkpoint), gravityID))
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Did not find validator for eth address %s", ethAddress.GetAddress()))
 	}
 
 	// Slash the offending validator
@@ -76,14 +196,90 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, subject types
 	}
 
 	params := k.GetParams(ctx)
+	// the slash burns stake from the validator as well as its unbonding delegations and redelegations,
+	// so the amount it burned is measured by the drop in the bond denom supply
+	bondDenom := k.StakingKeeper.GetParams(ctx).BondDenom
+	supply := k.bankKeeper.GetSupply(ctx, bondDenom).Amount
 	k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBadEthSignature)
+	subjectNonce, subjectToken := slashSubject(subject)
+	k.RecordSlash(ctx, val.GetOperator(), reason, subjectNonce, subjectToken, params.SlashFractionBadEthSignature, !val.IsJailed())
 	if !val.IsJailed() {
 		k.StakingKeeper.Jail(ctx, cons)
 	}
+	k.SetBadSignatureEvidence(ctx, evidenceHash)
+
+	burned := supply.Sub(k.bankKeeper.GetSupply(ctx, bondDenom).Amount)
+	return k.payBadSignatureEvidenceBounty(ctx, params, sender, burned)
+}
+
+// payBadSignatureEvidenceBounty pays the sender of bad signature evidence its share of the slashed stake and the
+// fixed bounty, both from the community pool so that the total supply only drops by the burned stake. Each part is
+// skipped if the community pool can not afford it, since the evidence must slash regardless
+func (k Keeper) payBadSignatureEvidenceBounty(ctx sdk.Context, params types.Params, senderAddr string, burned sdk.Int) error {
+	sender, err := sdk.AccAddressFromBech32(senderAddr)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, senderAddr)
+	}
+	bounty := sdk.NewCoins()
 
+	share := params.BadSignatureEvidenceBountyFraction.MulInt(burned).TruncateInt()
+	if share.IsPositive() {
+		coin := sdk.NewCoin(k.StakingKeeper.GetParams(ctx).BondDenom, share)
+		if err := k.distKeeper.DistributeFromFeePool(ctx, sdk.NewCoins(coin), sender); err != nil {
+			k.logger(ctx).Info("Community pool can not pay bad signature evidence bounty share", "share", coin.String(), "error", err.Error())
+		} else {
+			bounty = bounty.Add(coin)
+		}
+	}
+
+	fixed := params.BadSignatureEvidenceBounty
+	if fixed.IsValid() && !fixed.IsZero() {
+		if err := k.distKeeper.DistributeFromFeePool(ctx, sdk.NewCoins(fixed), sender); err != nil {
+			k.logger(ctx).Info("Community pool can not pay bad signature evidence bounty", "bounty", fixed.String(), "error", err.Error())
+		} else {
+			bounty = bounty.Add(fixed)
+		}
+	}
+
+	if !bounty.IsZero() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBadSignatureEvidenceBounty,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyRecipient, sender.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, bounty.String()),
+			),
+		)
+	}
 	return nil
 }
 
+// SetBadSignatureEvidence records the hash of bad signature evidence which has slashed a validator
+func (k Keeper) SetBadSignatureEvidence(ctx sdk.Context, hash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetBadSignatureEvidenceKey(hash)), []byte{0x1})
+}
+
+// HasBadSignatureEvidence tells you whether bad signature evidence with the given hash has already slashed a validator
+func (k Keeper) HasBadSignatureEvidence(ctx sdk.Context, hash []byte) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has([]byte(types.GetBadSignatureEvidenceKey(hash)))
+}
+
+// IterateBadSignatureEvidence iterates through the hashes of all the bad signature evidence which has slashed a validator
+func (k Keeper) IterateBadSignatureEvidence(ctx sdk.Context, cb func(hash []byte) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.BadSignatureEvidenceKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(iter.Key()) {
+			break
+		}
+	}
+}
+
 // SetPastEthSignatureCheckpoint puts the checkpoint of a valset, batch, or logic call into a set
 // in order to prove later that it existed at one point.
 func (k Keeper) SetPastEthSignatureCheckpoint(ctx sdk.Context, checkpoint []byte) {
@@ -100,3 +296,22 @@ func (k Keeper) GetPastEthSignatureCheckpoint(ctx sdk.Context, checkpoint []byte
 		return false
 	}
 }
+
+// IteratePastEthSignatureCheckpoints iterates through all the checkpoints that have ever existed
+func (k Keeper) IteratePastEthSignatureCheckpoints(ctx sdk.Context, cb func(key []byte, checkpoint []byte) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.PastEthSignatureCheckpointKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// the checkpoint bytes are stored as one utf8 encoded rune each, see GetPastEthSignatureCheckpointKey
+		var checkpoint []byte
+		for _, r := range string(iter.Key()) {
+			checkpoint = append(checkpoint, byte(r))
+		}
+		// cb returns true to stop early
+		if cb(iter.Key(), checkpoint) {
+			break
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"crypto/ecdsa"
 	"encoding/hex"
+	"strings"
 	"testing"
 	"time"
 
@@ -136,14 +138,221 @@ func TestSubmitBadSignatureEvidenceSlash(t *testing.T) {
 	ethSignature, err := types.NewEthereumSignature(checkpoint, privKey)
 	require.NoError(t, err)
 
+	sender, _ := sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
 	msg := types.MsgSubmitBadSignatureEvidence{
 		Subject:   any,
 		Signature: hex.EncodeToString(ethSignature),
+		Sender:    sender.String(),
 	}
 
+	// the community pool pays the sender's share of the slashed stake
+	fundCommunityPool(t, input, ctx, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000000))))
+
+	supply := input.BankKeeper.GetSupply(ctx, "stake").Amount
+	communityPool := input.DistKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("stake")
+	tokens := input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens()
 	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
 	require.NoError(t, err)
 
 	val := input.StakingKeeper.Validator(ctx, ValAddrs[0])
 	require.True(t, val.IsJailed())
+
+	// the total supply drops by exactly the slashed stake, as the sender's share of it is not minted
+	params := input.GravityKeeper.GetParams(ctx)
+	burned := tokens.Sub(val.GetTokens())
+	require.Equal(t, supply.Sub(burned), input.BankKeeper.GetSupply(ctx, "stake").Amount)
+	share := input.BankKeeper.GetAllBalances(ctx, sender).AmountOf("stake")
+	require.Equal(t, params.BadSignatureEvidenceBountyFraction.MulInt(burned).TruncateInt(), share)
+	require.True(t, share.IsPositive())
+	require.Equal(t, communityPool.Sub(share.ToDec()), input.DistKeeper.GetFeePoolCommunityCoins(ctx).AmountOf("stake"))
+
+	// the same evidence can not slash again, even with a differently encoded signature
+	tokens = val.GetTokens()
+	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrDuplicate)
+	msg.Signature = "0x" + msg.Signature
+	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrDuplicate)
+	require.Equal(t, tokens, input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens())
+}
+
+//nolint: exhaustivestruct
+func TestSubmitBadSignatureEvidenceCommunityPoolBounty(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	// only the fixed bounty is paid, from a community pool which can afford it once
+	bounty := sdk.NewCoin("stake", sdk.NewInt(500))
+	params := k.GetParams(ctx)
+	params.BadSignatureEvidenceBountyFraction = sdk.ZeroDec()
+	params.BadSignatureEvidenceBounty = bounty
+	k.SetParams(ctx, params)
+
+	fundCommunityPool(t, input, ctx, sdk.NewCoins(bounty))
+
+	sender, _ := sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+	for i, valAddr := range ValAddrs[:2] {
+		batch := types.OutgoingTxBatch{
+			TokenContract: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+			BatchTimeout:  420,
+		}
+		any, err := codectypes.NewAnyWithValue(&batch)
+		require.NoError(t, err)
+
+		privKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		ethAddress, err := types.NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).String())
+		require.NoError(t, err)
+		k.SetEthAddressForValidator(ctx, valAddr, *ethAddress)
+		ethSignature, err := types.NewEthereumSignature(batch.GetCheckpoint(k.GetGravityID(ctx)), privKey)
+		require.NoError(t, err)
+
+		msg := types.MsgSubmitBadSignatureEvidence{
+			Subject:   any,
+			Signature: hex.EncodeToString(ethSignature),
+			Sender:    sender.String(),
+		}
+		// the evidence slashes even once the community pool is empty
+		supply := input.BankKeeper.GetSupply(ctx, "stake").Amount
+		tokens := input.StakingKeeper.Validator(ctx, valAddr).GetTokens()
+		require.NoError(t, k.CheckBadSignatureEvidence(ctx, &msg), "evidence %d", i)
+		val := input.StakingKeeper.Validator(ctx, valAddr)
+		require.True(t, val.IsJailed())
+		// and the total supply drops by exactly the slashed stake
+		require.Equal(t, supply.Sub(tokens.Sub(val.GetTokens())), input.BankKeeper.GetSupply(ctx, "stake").Amount)
+		require.Equal(t, sdk.NewCoins(bounty), input.BankKeeper.GetAllBalances(ctx, sender))
+	}
+}
+
+//nolint: exhaustivestruct
+func TestSubmitDoubleSignEvidence(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+	gravityID := k.GetGravityID(ctx)
+	sender, _ := sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress, err := types.NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).String())
+	require.NoError(t, err)
+	k.SetEthAddressForValidator(ctx, ValAddrs[0], *ethAddress)
+
+	// two batches claiming the same nonce for the token, both signed by the validator
+	batchA := types.OutgoingTxBatch{
+		BatchNonce:    7,
+		TokenContract: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		BatchTimeout:  420,
+	}
+	batchB := batchA
+	batchB.BatchTimeout = 421
+	sign := func(batch types.OutgoingTxBatch, key *ecdsa.PrivateKey) (*codectypes.Any, string) {
+		any, err := codectypes.NewAnyWithValue(&batch)
+		require.NoError(t, err)
+		sig, err := types.NewEthereumSignature(batch.GetCheckpoint(gravityID), key)
+		require.NoError(t, err)
+		return any, hex.EncodeToString(sig)
+	}
+	subjectA, sigA := sign(batchA, privKey)
+	subjectB, sigB := sign(batchB, privKey)
+
+	// signatures from different keys are not equivocation
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	_, otherSig := sign(batchB, otherKey)
+	msg := types.MsgSubmitDoubleSignEvidence{
+		SubjectA:   subjectA,
+		SignatureA: sigA,
+		SubjectB:   subjectB,
+		SignatureB: otherSig,
+		Sender:     sender.String(),
+	}
+	require.ErrorIs(t, k.CheckDoubleSignEvidence(ctx, &msg), types.ErrInvalid)
+
+	// neither are signatures over different nonces
+	batchC := batchA
+	batchC.BatchNonce = 8
+	subjectC, sigC := sign(batchC, privKey)
+	msg.SubjectB, msg.SignatureB = subjectC, sigC
+	require.ErrorIs(t, k.CheckDoubleSignEvidence(ctx, &msg), types.ErrInvalid)
+
+	// nor the same checkpoint signed twice
+	msg.SubjectB, msg.SignatureB = subjectA, sigA
+	require.ErrorIs(t, k.CheckDoubleSignEvidence(ctx, &msg), types.ErrInvalid)
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	// two checkpoints for the same nonce slash and jail the validator
+	fundCommunityPool(t, input, ctx, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000000))))
+	msg.SubjectB, msg.SignatureB = subjectB, sigB
+	tokens := input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens()
+	require.NoError(t, k.CheckDoubleSignEvidence(ctx, &msg))
+	val := input.StakingKeeper.Validator(ctx, ValAddrs[0])
+	require.True(t, val.IsJailed())
+	require.True(t, val.GetTokens().LT(tokens))
+	require.False(t, input.BankKeeper.GetAllBalances(ctx, sender).IsZero())
+	records := k.GetSlashRecords(ctx)
+	require.Len(t, records, 1)
+	require.Equal(t, types.SLASH_REASON_DOUBLE_SIGN, records[0].Reason)
+	require.Equal(t, ValAddrs[0].String(), records[0].Validator)
+	require.Equal(t, batchA.BatchNonce, records[0].SubjectNonce)
+	require.Equal(t, batchA.TokenContract, records[0].SubjectToken)
+	require.True(t, records[0].Jailed)
+
+	// and only once for the nonce, even with the subjects swapped
+	msg.SubjectA, msg.SignatureA, msg.SubjectB, msg.SignatureB = subjectB, sigB, subjectA, sigA
+	require.ErrorIs(t, k.CheckDoubleSignEvidence(ctx, &msg), types.ErrDuplicate)
+
+	// or with the token contract of a new checkpoint written in lower case
+	batchD := batchA
+	batchD.TokenContract = strings.ToLower(batchA.TokenContract)
+	batchD.BatchTimeout = 422
+	subjectD, sigD := sign(batchD, privKey)
+	msg.SubjectA, msg.SignatureA, msg.SubjectB, msg.SignatureB = subjectD, sigD, subjectB, sigB
+	require.ErrorIs(t, k.CheckDoubleSignEvidence(ctx, &msg), types.ErrDuplicate)
+	require.Len(t, k.GetSlashRecords(ctx), 1)
+}
+
+//nolint: exhaustivestruct
+func TestSubmitBadSignatureEvidenceRotatedKey(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	oldKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	oldEth, err := types.NewEthAddress(crypto.PubkeyToAddress(oldKey.PublicKey).String())
+	require.NoError(t, err)
+	newKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	newEth, err := types.NewEthAddress(crypto.PubkeyToAddress(newKey.PublicKey).String())
+	require.NoError(t, err)
+	k.SetEthAddressForValidator(ctx, ValAddrs[0], *oldEth)
+	k.RotateEthAddressForValidator(ctx, ValAddrs[0], *oldEth, *newEth)
+
+	// a bad signature of the rotated out key is attributed to the validator which held it
+	batch := types.OutgoingTxBatch{
+		TokenContract: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		BatchTimeout:  420,
+	}
+	any, err := codectypes.NewAnyWithValue(&batch)
+	require.NoError(t, err)
+	ethSignature, err := types.NewEthereumSignature(batch.GetCheckpoint(k.GetGravityID(ctx)), oldKey)
+	require.NoError(t, err)
+	sender, _ := sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+	msg := types.MsgSubmitBadSignatureEvidence{
+		Subject:   any,
+		Signature: hex.EncodeToString(ethSignature),
+		Sender:    sender.String(),
+	}
+	require.NoError(t, k.CheckBadSignatureEvidence(ctx, &msg))
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+	records := k.GetSlashRecords(ctx)
+	require.Len(t, records, 1)
+	require.Equal(t, ValAddrs[0].String(), records[0].Validator)
+}
+
+// fundCommunityPool mints coins into the community pool, which pays the bad signature evidence bounties
+func fundCommunityPool(t *testing.T, input TestInput, ctx sdk.Context, coins sdk.Coins) {
+	funder, _ := sdk.AccAddressFromBech32("gravity16ahjkfqxpp6lvfy9fpfnfjg39xr96qet0l08hu")
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, coins))
+	require.NoError(t, input.DistKeeper.FundCommunityPool(ctx, coins, funder))
 }
@@ -86,15 +86,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		if err != nil {
 			panic("couldn't cast to claim")
 		}
-		// reconstruct the latest event nonce for every validator
-		// if somehow this genesis state is saved when all attestations
-		// have been cleaned up GetLastEventNonceByValidator handles that case
-		//
-		// if we where to save and load the last event nonce for every validator
-		// then we would need to carry that state forever across all chain restarts
-		// but since we've already had to handle the edge case of new validators joining
-		// while all attestations have already been cleaned up we can do this instead and
-		// not carry around every validators event nonce counter forever.
+		// reconstruct the latest event nonce for every validator, this is
+		// only relevant for genesis files that predate LastEventNonces which
+		// are applied below. If somehow this genesis state is saved when all
+		// attestations have been cleaned up GetLastEventNonceByValidator handles that case
 		for _, vote := range att.Votes {
 			val, err := sdk.ValAddressFromBech32(vote)
 			if err != nil {
@@ -107,9 +102,113 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		}
 	}
 
+	// reset the last event nonce of every validator, these take precedence over the
+	// values reconstructed above since the attestations may have been pruned
+	for _, item := range data.LastEventNonces {
+		val, err := sdk.ValAddressFromBech32(item.Validator)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid validator in LastEventNonces: %s", item.Validator))
+		}
+		k.SetLastEventNonceByValidator(ctx, val, item.EventNonce)
+	}
+
+	// reset the event nonce every validator was last slashed for not claiming
+	for _, item := range data.OracleLivenessSlashes {
+		val, err := sdk.ValAddressFromBech32(item.Validator)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid validator in OracleLivenessSlashes: %s", item.Validator))
+		}
+		k.SetOracleLivenessSlash(ctx, val, item.EventNonce)
+	}
+
+	// reset the records of validators punished for claims which lost to the observed attestation
+	for _, conflict := range data.ConflictingClaims {
+		k.SetConflictingClaim(ctx, conflict)
+	}
+
+	// reset the confirmations every validator has missed in its signing window
+	for _, info := range data.ConfirmSigningInfos {
+		k.SetConfirmSigningInfo(ctx, info)
+	}
+
+	// reset the latest Ethereum height claimed by every validator
+	for _, vote := range data.EthereumHeightVotes {
+		k.SetEthereumHeightVote(ctx, vote)
+	}
+
+	// reset the Ethereum heights sampled to calibrate the average block times
+	for _, sample := range data.EthereumHeightSamples {
+		k.SetEthereumHeightSample(ctx, sample)
+	}
+
+	// reset the smallest amount and bridge fee of transfers to Ethereum
+	for _, minimum := range data.TransferMinimums {
+		k.SetTransferMinimum(ctx, minimum)
+	}
+
+	// reset the history of gravity specific slashes, new records are numbered after the last imported one
+	var lastSlashRecordID uint64
+	for _, record := range data.SlashRecords {
+		k.SetSlashRecord(ctx, record)
+		if record.Id > lastSlashRecordID {
+			lastSlashRecordID = record.Id
+		}
+	}
+	if lastSlashRecordID != 0 {
+		k.setLastID(ctx, []byte(types.KeyLastSlashRecordID), lastSlashRecordID)
+	}
+
+	// reset the last observed Ethereum state, a zero height means nothing was ever observed
+	if data.LastObservedEthereumHeight.EthereumBlockHeight != 0 {
+		k.setLastObservedEthereumBlockHeight(ctx, data.LastObservedEthereumHeight)
+	}
+	if data.LastObservedValset != nil {
+		k.SetLastObservedValset(ctx, *data.LastObservedValset)
+	}
+
+	// reset the checkpoints of every valset, batch and logic call which has existed
+	for _, checkpoint := range data.PastEthSignatureCheckpoints {
+		k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
+	}
+
+	// reset the hashes of the bad signature evidence which has already slashed a validator
+	for _, hash := range data.BadSignatureEvidence {
+		k.SetBadSignatureEvidence(ctx, hash)
+	}
+
+	// reset valset hijack incidents
+	for _, incident := range data.ValsetHijackIncidents {
+		k.SetValsetHijackIncident(ctx, incident)
+	}
+
+	// reset the counters and slashing cursors, zero values are never written so that
+	// importing an empty genesis leaves the same store as a fresh chain
+	nonces := data.GravityNonces
+	if nonces.LatestValsetNonce != 0 {
+		k.SetLatestValsetNonce(ctx, nonces.LatestValsetNonce)
+	}
+	if nonces.LastSlashedValsetNonce != 0 {
+		k.SetLastSlashedValsetNonce(ctx, nonces.LastSlashedValsetNonce)
+	}
+	if nonces.LastSlashedBatchBlock != 0 {
+		k.SetLastSlashedBatchBlock(ctx, nonces.LastSlashedBatchBlock)
+	}
+	if nonces.LastSlashedLogicCallBlock != 0 {
+		k.SetLastSlashedLogicCallBlock(ctx, nonces.LastSlashedLogicCallBlock)
+	}
+	if nonces.LastUnbondingBlockHeight != 0 {
+		k.SetLastUnBondingBlockHeight(ctx, nonces.LastUnbondingBlockHeight)
+	}
+	if nonces.LastTxPoolId != 0 {
+		k.setLastID(ctx, []byte(types.KeyLastTXPoolID), nonces.LastTxPoolId)
+	}
+	if nonces.LastBatchId != 0 {
+		k.setLastID(ctx, []byte(types.KeyLastOutgoingBatchID), nonces.LastBatchId)
+	}
+
 	// reset delegate keys in state
 	for _, keys := range data.DelegateKeys {
-		err := keys.ValidateBasic()
+		err := keys.ValidateDelegateKeys()
 		if err != nil {
 			panic("Invalid delegate key in Genesis!")
 		}
@@ -117,7 +216,7 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
-		ethAddr, _ := types.NewEthAddress(keys.EthAddress) // already validated in keys.ValidateBasic()
+		ethAddr, _ := types.NewEthAddress(keys.EthAddress) // already validated in keys.ValidateDelegateKeys()
 
 		orch, err := sdk.AccAddressFromBech32(keys.Orchestrator)
 		if err != nil {
@@ -130,6 +229,48 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		k.SetEthAddressForValidator(ctx, val, *ethAddr)
 	}
 
+	// reset delegate keys which have been rotated out
+	for _, key := range data.PastDelegateKeys {
+		val, err := sdk.ValAddressFromBech32(key.Validator)
+		if err != nil {
+			panic(err)
+		}
+		if key.Orchestrator != "" {
+			orch, err := sdk.AccAddressFromBech32(key.Orchestrator)
+			if err != nil {
+				panic(err)
+			}
+			k.SetPastOrchestratorValidator(ctx, val, orch)
+		}
+		if key.EthAddress != "" {
+			ethAddr, err := types.NewEthAddress(key.EthAddress)
+			if err != nil {
+				panic(sdkerrors.Wrap(err, "invalid past delegate eth address"))
+			}
+			k.SetPastValidatorByEthAddress(ctx, val, *ethAddr)
+		}
+	}
+
+	// reset the status of outgoing transfers
+	for _, status := range data.TransferStatuses {
+		k.SetTransferStatus(ctx, status)
+	}
+
+	// reset the records of observed deposits
+	for _, record := range data.DepositRecords {
+		k.SetDepositRecord(ctx, record)
+	}
+
+	// reset the deposits held in escrow for their receivers
+	for _, escrow := range data.DepositEscrows {
+		k.SetDepositEscrow(ctx, escrow)
+	}
+
+	// reset the supply of cosmos originated tokens locked while it exists on Ethereum
+	for _, locked := range data.BridgeEscrow {
+		k.setBridgeEscrow(ctx, locked)
+	}
+
 	// populate state with cosmos originated denom-erc20 mapping
 	for i, item := range data.Erc20ToDenoms {
 		ethAddr, err := types.NewEthAddress(item.Erc20)
@@ -165,7 +306,6 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		calls              = k.GetOutgoingLogicCalls(ctx)
 		batches            = k.GetOutgoingTxBatches(ctx)
 		valsets            = k.GetValsets(ctx)
-		attmap             = k.GetAttestationMapping(ctx)
 		vsconfs            = []types.MsgValsetConfirm{}
 		batchconfs         = []types.MsgConfirmBatch{}
 		callconfs          = []types.MsgConfirmLogicCall{}
@@ -174,6 +314,10 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		lastobserved       = k.GetLastObservedEventNonce(ctx)
 		erc20ToDenoms      = []types.ERC20ToDenom{}
 		unbatchedTransfers = k.GetUnbatchedTransactions(ctx)
+		lastEventNonces    = []types.LastEventNonceByValidator{}
+		livenessSlashes    = []types.LastEventNonceByValidator{}
+		checkpoints        = [][]byte{}
+		evidence           = [][]byte{}
 	)
 
 	// export valset confirmations from state
@@ -199,10 +343,11 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	}
 
 	// export attestations from state
-	for _, atts := range attmap {
+	k.IterateAttestaions(ctx, func(_ []byte, att types.Attestation) bool {
 		// TODO: set height = 0?
-		attestations = append(attestations, atts...)
-	}
+		attestations = append(attestations, att)
+		return false
+	})
 
 	// export erc20 to denom relations
 	k.IterateERC20ToDenom(ctx, func(key []byte, erc20ToDenom *types.ERC20ToDenom) bool {
@@ -210,6 +355,36 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		return false
 	})
 
+	// export the last event nonce of every validator
+	k.IterateLastEventNonceByValidator(ctx, func(validator sdk.ValAddress, nonce uint64) bool {
+		lastEventNonces = append(lastEventNonces, types.LastEventNonceByValidator{
+			Validator:  validator.String(),
+			EventNonce: nonce,
+		})
+		return false
+	})
+
+	// export the event nonce every validator was last slashed for not claiming
+	k.IterateOracleLivenessSlashes(ctx, func(validator sdk.ValAddress, nonce uint64) bool {
+		livenessSlashes = append(livenessSlashes, types.LastEventNonceByValidator{
+			Validator:  validator.String(),
+			EventNonce: nonce,
+		})
+		return false
+	})
+
+	// export the checkpoints of every valset, batch and logic call which has existed
+	k.IteratePastEthSignatureCheckpoints(ctx, func(_ []byte, checkpoint []byte) bool {
+		checkpoints = append(checkpoints, checkpoint)
+		return false
+	})
+
+	// export the hashes of the bad signature evidence which has already slashed a validator
+	k.IterateBadSignatureEvidence(ctx, func(hash []byte) bool {
+		evidence = append(evidence, hash)
+		return false
+	})
+
 	unbatchedTxs := make([]types.OutgoingTransferTx, len(unbatchedTransfers))
 	for i, v := range unbatchedTransfers {
 		unbatchedTxs[i] = v.ToExternal()
@@ -228,5 +403,32 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		DelegateKeys:       delegates,
 		Erc20ToDenoms:      erc20ToDenoms,
 		UnbatchedTransfers: unbatchedTxs,
+		GravityNonces: types.GravityNonces{
+			LatestValsetNonce:         k.GetLatestValsetNonce(ctx),
+			LastSlashedValsetNonce:    k.GetLastSlashedValsetNonce(ctx),
+			LastSlashedBatchBlock:     k.GetLastSlashedBatchBlock(ctx),
+			LastSlashedLogicCallBlock: k.GetLastSlashedLogicCallBlock(ctx),
+			LastUnbondingBlockHeight:  k.GetLastUnBondingBlockHeight(ctx),
+			LastTxPoolId:              k.getLastID(ctx, []byte(types.KeyLastTXPoolID)),
+			LastBatchId:               k.getLastID(ctx, []byte(types.KeyLastOutgoingBatchID)),
+		},
+		LastEventNonces:             lastEventNonces,
+		LastObservedEthereumHeight:  k.GetLastObservedEthereumBlockHeight(ctx),
+		LastObservedValset:          k.GetLastObservedValset(ctx),
+		PastEthSignatureCheckpoints: checkpoints,
+		ValsetHijackIncidents:       k.GetValsetHijackIncidents(ctx),
+		PastDelegateKeys:            k.GetPastDelegateKeys(ctx),
+		TransferStatuses:            k.GetTransferStatuses(ctx),
+		DepositRecords:              k.GetDepositRecords(ctx),
+		DepositEscrows:              k.GetDepositEscrows(ctx),
+		BridgeEscrow:                k.GetBridgeEscrow(ctx),
+		OracleLivenessSlashes:       livenessSlashes,
+		ConflictingClaims:           k.GetConflictingClaims(ctx),
+		ConfirmSigningInfos:         k.GetConfirmSigningInfos(ctx),
+		BadSignatureEvidence:        evidence,
+		SlashRecords:                k.GetSlashRecords(ctx),
+		EthereumHeightVotes:         k.GetEthereumHeightVotes(ctx),
+		EthereumHeightSamples:       k.GetEthereumHeightSamples(ctx),
+		TransferMinimums:            k.GetTransferMinimums(ctx),
 	}
 }
@@ -174,3 +174,143 @@ func exportImport(t *testing.T, input *TestInput) {
 	require.Empty(t, batches)
 	InitGenesis(input.Context, input.GravityKeeper, genesisState)
 }
+
+// Tests that an export followed by an import reproduces the gravity store exactly
+func TestGenesisRoundTrip(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	k := input.GravityKeeper
+
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		token, _            = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
+		allVouchers         = sdk.NewCoins(token.GravityCoin())
+	)
+
+	// valsets and their checkpoints
+	valset := k.SetValsetRequest(ctx)
+
+	// pool transactions and a batch, advancing both id sequences
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+	for i := int64(1); i <= 3; i++ {
+		amount, err := types.NewInternalERC20Token(sdk.NewInt(100+i), myTokenContractAddr)
+		require.NoError(t, err)
+		fee, err := types.NewInternalERC20Token(sdk.NewInt(i), myTokenContractAddr)
+		require.NoError(t, err)
+		_, err = k.AddToOutgoingPool(ctx, mySender, *myReceiver, amount.GravityCoin(), fee.GravityCoin())
+		require.NoError(t, err)
+	}
+	_, err := k.BuildOutgoingTXBatch(ctx, token.Contract, 2)
+	require.NoError(t, err)
+	// a refunded transfer whose status is waiting to be pruned
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, 1, mySender))
+
+	// logic calls and their checkpoints
+	k.SetOutgoingLogicCall(ctx, types.OutgoingLogicCall{
+		Transfers:            []types.ERC20Token{token.ToExternal()},
+		Fees:                 []types.ERC20Token{token.ToExternal()},
+		LogicContractAddress: "0x510ab76899430424d209a6c9a5b9951fb8a6f47d",
+		Payload:              []byte("fake bytes"),
+		Timeout:              10000,
+		InvalidationId:       []byte("GravityTesting"),
+		InvalidationNonce:    1,
+	})
+
+	// oracle state, slashing cursors and incidents
+	k.SetLastEventNonceByValidator(ctx, ValAddrs[0], 3)
+	k.SetLastEventNonceByValidator(ctx, ValAddrs[1], 2)
+	k.setLastObservedEventNonce(ctx, 3)
+	k.SetLastObservedEthereumBlockHeight(ctx, 1234)
+	k.SetLastObservedValset(ctx, valset)
+	k.SetLastSlashedValsetNonce(ctx, 1)
+	k.SetLastSlashedBatchBlock(ctx, 2)
+	k.SetLastSlashedLogicCallBlock(ctx, 3)
+	k.SetLastUnBondingBlockHeight(ctx, 4)
+	k.SetOracleLivenessSlash(ctx, ValAddrs[1], 3)
+	k.SetConflictingClaim(ctx, types.ConflictingClaim{
+		Validator:         ValAddrs[2].String(),
+		EventNonce:        1,
+		ClaimType:         types.CLAIM_TYPE_SEND_TO_COSMOS,
+		ClaimHash:         "aa",
+		ObservedClaimHash: "bb",
+		BlockHeight:       1,
+	})
+	k.SetBadSignatureEvidence(ctx, []byte{0x1, 0x2, 0x3})
+	k.SetConfirmSigningInfo(ctx, types.ConfirmSigningInfo{
+		Validator:             ValAddrs[3].String(),
+		IndexOffset:           3,
+		MissedConfirmsCounter: 1,
+		MissedConfirms:        []byte{0x2},
+	})
+	k.SetEthereumHeightVote(ctx, types.EthereumHeightVote{
+		Validator:           ValAddrs[4].String(),
+		EthereumBlockHeight: 1240,
+		CosmosBlockHeight:   2,
+	})
+	k.SetEthereumHeightSample(ctx, types.EthereumHeightSample{
+		CosmosBlockHeight:   2,
+		CosmosBlockTime:     1587556800000,
+		EthereumBlockHeight: 1234,
+	})
+	k.RecordSlash(ctx, ValAddrs[2], types.SLASH_REASON_CONFLICTING_CLAIM, 1, "", sdk.NewDecWithPrec(1, 3), false)
+	k.RecordSlash(ctx, ValAddrs[1], types.SLASH_REASON_BATCH_SIGNATURE, 2, myTokenContractAddr, sdk.NewDecWithPrec(1, 3), true)
+	k.SetValsetHijackIncident(ctx, types.ValsetHijackIncident{
+		EventNonce:    2,
+		BlockHeight:   1,
+		ClaimedValset: valset,
+		Reason:        "testing",
+	})
+
+	// deposit history
+	k.SetDepositRecord(ctx, types.DepositRecord{
+		EventNonce:     1,
+		EthBlockHeight: 1000,
+		TokenContract:  myTokenContractAddr,
+		Amount:         sdk.NewInt(10),
+		EthereumSender: myReceiver.GetAddress(),
+		CosmosReceiver: mySender.String(),
+		Outcome:        types.DEPOSIT_OUTCOME_CREDITED,
+	})
+	k.SetDepositEscrow(ctx, types.DepositEscrow{
+		EventNonce: 2,
+		Receiver:   mySender.String(),
+		Amount:     sdk.NewCoin("stake", sdk.NewInt(10)),
+	})
+
+	// cosmos originated supply locked while on Ethereum
+	k.setBridgeEscrow(ctx, sdk.NewCoin("stake", sdk.NewInt(20)))
+
+	// governance set transfer minimums
+	k.SetTransferMinimum(ctx, types.TransferMinimum{
+		Denom:     "stake",
+		MinAmount: sdk.NewInt(100),
+		MinFee:    sdk.NewInt(5),
+	})
+
+	// delegate keys which have been rotated out
+	oldEthAddr, found := k.GetEthAddressByValidator(ctx, ValAddrs[0])
+	require.True(t, found)
+	newEthAddr, _ := types.NewEthAddress("0x26126048c706fB45a5a6De8432F428e794d0b952")
+	k.RotateOrchestratorValidator(ctx, ValAddrs[0], OrchAddrs[0], mySender)
+	k.RotateEthAddressForValidator(ctx, ValAddrs[0], *oldEthAddr, *newEthAddr)
+
+	genesisState := ExportGenesis(ctx, k)
+	newInput := CreateTestEnv(t)
+	InitGenesis(newInput.Context, newInput.GravityKeeper, genesisState)
+
+	require.Equal(t, dumpStore(ctx, k), dumpStore(newInput.Context, newInput.GravityKeeper))
+}
+
+// dumpStore returns every key value pair in the gravity store
+func dumpStore(ctx sdk.Context, k Keeper) map[string][]byte {
+	out := make(map[string][]byte)
+	iter := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		out[string(iter.Key())] = iter.Value()
+	}
+	return out
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// NewGravityProposalHandler returns the governance proposal handler for the gravity module
+func NewGravityProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.DepositEscrowReleaseProposal:
+			return k.HandleDepositEscrowReleaseProposal(ctx, c)
+
+		case *types.TransferMinimumProposal:
+			return k.HandleTransferMinimumProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized gravity proposal content type: %T", c)
+		}
+	}
+}
+
+// HandleDepositEscrowReleaseProposal releases the escrowed deposits of the receiver to the recipient
+func (k Keeper) HandleDepositEscrowReleaseProposal(ctx sdk.Context, p *types.DepositEscrowReleaseProposal) error {
+	receiver, err := types.IBCAddressFromBech32(p.Receiver)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, p.Receiver)
+	}
+	recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, p.Recipient)
+	}
+	released, err := k.ReleaseDepositEscrows(ctx, receiver, recipient)
+	if err != nil {
+		return err
+	}
+	k.logger(ctx).Info("Gov vote passed: Released escrowed deposits", "receiver", p.Receiver, "recipient", p.Recipient, "amount", released.String())
+	return nil
+}
+
+// HandleTransferMinimumProposal sets the smallest amount and bridge fee a transfer of the denom to Ethereum may carry
+func (k Keeper) HandleTransferMinimumProposal(ctx sdk.Context, p *types.TransferMinimumProposal) error {
+	if err := p.Minimum.ValidateBasic(); err != nil {
+		return err
+	}
+	k.SetTransferMinimum(ctx, p.Minimum)
+	k.logger(ctx).Info("Gov vote passed: Set transfer minimum", "denom", p.Minimum.Denom,
+		"min amount", p.Minimum.MinAmount.String(), "min fee", p.Minimum.MinFee.String())
+	return nil
+}
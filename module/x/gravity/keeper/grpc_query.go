@@ -5,8 +5,10 @@ import (
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
@@ -117,7 +119,7 @@ func (k Keeper) LastPendingValsetRequestByAddr(
 func (k Keeper) BatchFees(
 	c context.Context,
 	req *types.QueryBatchFeeRequest) (*types.QueryBatchFeeResponse, error) {
-	return &types.QueryBatchFeeResponse{BatchFees: k.GetAllBatchFees(sdk.UnwrapSDKContext(c), OutgoingTxBatchSize)}, nil
+	return &types.QueryBatchFeeResponse{BatchFees: k.GetAllBatchFees(sdk.UnwrapSDKContext(c))}, nil
 }
 
 // LastPendingBatchRequestByAddr queries the LastPendingBatchRequestByAddr of the gravity module
@@ -385,25 +387,329 @@ func (k Keeper) GetPendingSendToEth(
 	c context.Context,
 	req *types.QueryPendingSendToEth) (*types.QueryPendingSendToEthResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	batches := k.GetOutgoingTxBatches(ctx)
-	unbatched_tx := k.GetUnbatchedTransactions(ctx)
-	sender_address := req.GetSenderAddress()
+	sender, err := sdk.AccAddressFromBech32(req.GetSenderAddress())
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.GetSenderAddress())
+	}
 	res := types.QueryPendingSendToEthResponse{
 		TransfersInBatches: []types.OutgoingTransferTx{},
 		UnbatchedTransfers: []types.OutgoingTransferTx{},
 	}
-	for _, batch := range batches {
-		for _, tx := range batch.Transactions {
-			if tx.Sender.String() == sender_address {
-				res.TransfersInBatches = append(res.TransfersInBatches, tx.ToExternal())
-			}
+	k.IteratePendingTransfersBySender(ctx, sender, func(tx *types.InternalOutgoingTransferTx, batchNonce uint64) bool {
+		if batchNonce != 0 {
+			res.TransfersInBatches = append(res.TransfersInBatches, tx.ToExternal())
+		} else {
+			res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx.ToExternal())
 		}
+		return false
+	})
+
+	return &res, nil
+}
+
+// GetTransfersBySender returns a page of the transfers of a sender that have not been executed on Ethereum
+func (k Keeper) GetTransfersBySender(
+	c context.Context,
+	req *types.QueryTransfersBySenderRequest) (*types.QueryTransfersBySenderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(req.SenderAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.SenderAddress)
 	}
-	for _, tx := range unbatched_tx {
-		if tx.Sender.String() == sender_address {
-			res.UnbatchedTransfers = append(res.UnbatchedTransfers, tx.ToExternal())
+	transfers, pageRes, err := k.paginatePendingTransfers(ctx, []byte(types.GetOutgoingTxBySenderPrefix(sender)), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTransfersBySenderResponse{Transfers: transfers, Pagination: pageRes}, nil
+}
+
+// GetTransfersByDestination returns a page of the transfers to an ethereum address that have not been executed on Ethereum
+func (k Keeper) GetTransfersByDestination(
+	c context.Context,
+	req *types.QueryTransfersByDestinationRequest) (*types.QueryTransfersByDestinationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	destination, err := types.NewEthAddress(req.EthAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid eth address")
+	}
+	transfers, pageRes, err := k.paginatePendingTransfers(ctx, []byte(types.GetOutgoingTxByDestinationPrefix(*destination)), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTransfersByDestinationResponse{Transfers: transfers, Pagination: pageRes}, nil
+}
+
+// TransferStatus returns the lifecycle status of an outgoing transfer
+func (k Keeper) TransferStatus(
+	c context.Context,
+	req *types.QueryTransferStatusRequest) (*types.QueryTransferStatusResponse, error) {
+	status := k.GetTransferStatus(sdk.UnwrapSDKContext(c), req.TxId)
+	if status == nil {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "no status for transfer %d, it never existed or its status has been pruned", req.TxId)
+	}
+	return &types.QueryTransferStatusResponse{Status: *status}, nil
+}
+
+// paginatePendingTransfers resolves a page of a pending transfer index
+func (k Keeper) paginatePendingTransfers(ctx sdk.Context, prefixKey []byte, pageReq *query.PageRequest) ([]types.PendingTransfer, *query.PageResponse, error) {
+	transfers := []types.PendingTransfer{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	pageRes, err := query.Paginate(prefixStore, pageReq, func(_ []byte, value []byte) error {
+		txID := types.UInt64FromBytes(value)
+		tx, batchNonce, found := k.GetPendingTransferById(ctx, txID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrUnknown, "pending transfer %d", txID)
 		}
+		transfers = append(transfers, types.PendingTransfer{
+			Transfer:   tx.ToExternal(),
+			BatchNonce: batchNonce,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrInvalid, err.Error())
 	}
+	return transfers, pageRes, nil
+}
 
-	return &res, nil
+// DepositsByReceiver returns a page of the deposits from Ethereum to a cosmos address
+func (k Keeper) DepositsByReceiver(
+	c context.Context,
+	req *types.QueryDepositsByReceiverRequest) (*types.QueryDepositsByReceiverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	receiver, err := types.IBCAddressFromBech32(req.ReceiverAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.ReceiverAddress)
+	}
+	deposits, pageRes, err := k.paginateDepositRecords(ctx, []byte(types.GetDepositByReceiverPrefix(receiver)), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDepositsByReceiverResponse{Deposits: deposits, Pagination: pageRes}, nil
+}
+
+// DepositsBySender returns a page of the deposits from an ethereum address
+func (k Keeper) DepositsBySender(
+	c context.Context,
+	req *types.QueryDepositsBySenderRequest) (*types.QueryDepositsBySenderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := types.NewEthAddress(req.EthAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid eth address")
+	}
+	deposits, pageRes, err := k.paginateDepositRecords(ctx, []byte(types.GetDepositBySenderPrefix(*sender)), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDepositsBySenderResponse{Deposits: deposits, Pagination: pageRes}, nil
+}
+
+// DepositsByToken returns a page of the deposits of an ERC20 token
+func (k Keeper) DepositsByToken(
+	c context.Context,
+	req *types.QueryDepositsByTokenRequest) (*types.QueryDepositsByTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	token, err := types.NewEthAddress(req.TokenContract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid token contract")
+	}
+	deposits, pageRes, err := k.paginateDepositRecords(ctx, []byte(types.GetDepositByTokenPrefix(*token)), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDepositsByTokenResponse{Deposits: deposits, Pagination: pageRes}, nil
+}
+
+// paginateDepositRecords resolves a page of a deposit record index
+func (k Keeper) paginateDepositRecords(ctx sdk.Context, prefixKey []byte, pageReq *query.PageRequest) ([]types.DepositRecord, *query.PageResponse, error) {
+	records := []types.DepositRecord{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	pageRes, err := query.Paginate(prefixStore, pageReq, func(_ []byte, value []byte) error {
+		eventNonce := types.UInt64FromBytes(value)
+		record := k.GetDepositRecord(ctx, eventNonce)
+		if record == nil {
+			return sdkerrors.Wrapf(types.ErrUnknown, "deposit record %d", eventNonce)
+		}
+		records = append(records, *record)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrInvalid, err.Error())
+	}
+	return records, pageRes, nil
+}
+
+// DepositEscrows returns the escrowed deposits of a cosmos address, or every escrowed deposit when no address is given
+func (k Keeper) DepositEscrows(
+	c context.Context,
+	req *types.QueryDepositEscrowsRequest) (*types.QueryDepositEscrowsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	escrows := []types.DepositEscrow{}
+	if req.ReceiverAddress == "" {
+		escrows = append(escrows, k.GetDepositEscrows(ctx)...)
+		return &types.QueryDepositEscrowsResponse{Escrows: escrows}, nil
+	}
+	receiver, err := types.IBCAddressFromBech32(req.ReceiverAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.ReceiverAddress)
+	}
+	escrows = append(escrows, k.GetDepositEscrowsByReceiver(ctx, receiver)...)
+	return &types.QueryDepositEscrowsResponse{Escrows: escrows}, nil
+}
+
+// BridgeEscrow returns the supply of a cosmos originated denom which is locked in the module while it exists on Ethereum,
+// or the locked supply of every cosmos originated denom when no denom is given
+func (k Keeper) BridgeEscrow(
+	c context.Context,
+	req *types.QueryBridgeEscrowRequest) (*types.QueryBridgeEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Denom == "" {
+		return &types.QueryBridgeEscrowResponse{Escrow: k.GetBridgeEscrow(ctx)}, nil
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return &types.QueryBridgeEscrowResponse{Escrow: sdk.NewCoins(k.GetBridgeEscrowByDenom(ctx, req.Denom))}, nil
+}
+
+// AttestationVoteWeights queries the weight of the votes on the attestations at an event nonce
+func (k Keeper) AttestationVoteWeights(
+	c context.Context,
+	req *types.QueryAttestationVoteWeightsRequest) (*types.QueryAttestationVoteWeightsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryAttestationVoteWeightsResponse{
+		Attestations:      k.GetAttestationVoteWeights(ctx, req.EventNonce),
+		CurrentTotalPower: k.StakingKeeper.GetLastTotalPower(ctx),
+		Policy:            k.GetAttestationPowerPolicy(ctx),
+	}, nil
+}
+
+// ConflictingClaims returns the claims of a validator which lost to the observed attestation at their event nonce,
+// or every such claim when no validator is given
+func (k Keeper) ConflictingClaims(
+	c context.Context,
+	req *types.QueryConflictingClaimsRequest) (*types.QueryConflictingClaimsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	conflicts := []types.ConflictingClaim{}
+	if req.ValidatorAddress == "" {
+		conflicts = append(conflicts, k.GetConflictingClaims(ctx)...)
+		return &types.QueryConflictingClaimsResponse{ConflictingClaims: conflicts}, nil
+	}
+	val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.ValidatorAddress)
+	}
+	conflicts = append(conflicts, k.GetConflictingClaimsByValidator(ctx, val)...)
+	return &types.QueryConflictingClaimsResponse{ConflictingClaims: conflicts}, nil
+}
+
+// ConfirmSigningInfos returns the confirmations a validator has missed in its signing window, or the signing info of
+// every validator when no validator is given
+func (k Keeper) ConfirmSigningInfos(
+	c context.Context,
+	req *types.QueryConfirmSigningInfosRequest) (*types.QueryConfirmSigningInfosResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	infos := []types.ConfirmSigningInfo{}
+	if req.ValidatorAddress == "" {
+		infos = append(infos, k.GetConfirmSigningInfos(ctx)...)
+	} else {
+		val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.ValidatorAddress)
+		}
+		if info, found := k.GetConfirmSigningInfo(ctx, val); found {
+			infos = append(infos, info)
+		}
+	}
+	return &types.QueryConfirmSigningInfosResponse{
+		Infos:                infos,
+		ConfirmSigningWindow: params.ConfirmSigningWindow,
+		MinSignedPerWindow:   params.MinSignedPerWindow,
+	}, nil
+}
+
+// SlashingHistoryByValidator returns a page of the gravity specific slashes of a validator
+func (k Keeper) SlashingHistoryByValidator(
+	c context.Context,
+	req *types.QuerySlashingHistoryByValidatorRequest) (*types.QuerySlashingHistoryByValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	val, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.ValidatorAddress)
+	}
+	slashes, pageRes, err := k.paginateSlashRecords(ctx, []byte(types.GetSlashRecordByValidatorPrefix(val)), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySlashingHistoryByValidatorResponse{Slashes: slashes, Pagination: pageRes}, nil
+}
+
+// SlashingHistoryByReason returns a page of the gravity specific slashes for a reason
+func (k Keeper) SlashingHistoryByReason(
+	c context.Context,
+	req *types.QuerySlashingHistoryByReasonRequest) (*types.QuerySlashingHistoryByReasonResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, ok := types.SlashReason_name[int32(req.Reason)]; !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "unknown slash reason %d", req.Reason)
+	}
+	slashes, pageRes, err := k.paginateSlashRecords(ctx, []byte(types.GetSlashRecordByReasonPrefix(req.Reason)), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySlashingHistoryByReasonResponse{Slashes: slashes, Pagination: pageRes}, nil
+}
+
+// EstimatedEthereumHeight returns the current Ethereum block height projected from the last observed Ethereum
+// height along with the average block times the projection and batch timeouts use
+func (k Keeper) EstimatedEthereumHeight(
+	c context.Context,
+	req *types.QueryEstimatedEthereumHeightRequest) (*types.QueryEstimatedEthereumHeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	averageBlockTime, averageEthereumBlockTime := k.GetAverageBlockTimes(ctx)
+	return &types.QueryEstimatedEthereumHeightResponse{
+		EstimatedEthereumHeight:    k.GetEstimatedEthereumHeight(ctx),
+		LastObservedEthereumHeight: k.GetLastObservedEthereumBlockHeight(ctx),
+		AverageBlockTime:           averageBlockTime,
+		AverageEthereumBlockTime:   averageEthereumBlockTime,
+	}, nil
+}
+
+// TransferMinimums returns the smallest amount and bridge fee a MsgSendToEth of a denom may carry, or the
+// minimums of every denom when no denom is given
+func (k Keeper) TransferMinimums(
+	c context.Context,
+	req *types.QueryTransferMinimumsRequest) (*types.QueryTransferMinimumsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minimums := []types.TransferMinimum{}
+	if req.Denom == "" {
+		minimums = append(minimums, k.GetTransferMinimums(ctx)...)
+	} else {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+		if minimum, found := k.GetTransferMinimum(ctx, req.Denom); found {
+			minimums = append(minimums, minimum)
+		}
+	}
+	return &types.QueryTransferMinimumsResponse{Minimums: minimums}, nil
+}
+
+// paginateSlashRecords resolves a page of a slash record index
+func (k Keeper) paginateSlashRecords(ctx sdk.Context, prefixKey []byte, pageReq *query.PageRequest) ([]types.SlashRecord, *query.PageResponse, error) {
+	records := []types.SlashRecord{}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	pageRes, err := query.Paginate(prefixStore, pageReq, func(_ []byte, value []byte) error {
+		id := types.UInt64FromBytes(value)
+		record := k.GetSlashRecord(ctx, id)
+		if record == nil {
+			return sdkerrors.Wrapf(types.ErrUnknown, "slash record %d", id)
+		}
+		records = append(records, *record)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrInvalid, err.Error())
+	}
+	return records, pageRes, nil
 }
@@ -23,7 +23,8 @@ func AllInvariants(k Keeper) sdk.Invariant {
 	}
 }
 
-// Checks that the module account's balance is equal to the balance of unbatched transactions and unobserved batches
+// Checks that the module account's balance is equal to the balance of unbatched transactions, unobserved batches,
+// unexecuted logic calls, escrowed deposits and the cosmos originated supply locked while it exists on Ethereum
 // Note that the returned bool should be true if there is an error, e.g. an unexpected module balance
 func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
@@ -62,6 +63,58 @@ func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
 
 			return false // continue iterating
 		})
+		// The transfers and fees of logic calls which have not been executed are escrowed as well
+		var badLogicCall string
+		k.IterateOutgoingLogicCalls(ctx, func(_ []byte, call types.OutgoingLogicCall) bool {
+			escrow, err := k.logicCallCoins(ctx, call)
+			if err != nil {
+				badLogicCall = fmt.Sprint("Invalid ERC20 in logic call ", call.InvalidationNonce, ": ", err)
+				return true
+			}
+			for _, coin := range escrow {
+				expected, ok := expectedBals[coin.Denom]
+				if !ok {
+					badLogicCall = fmt.Sprint("Module does not hold any balance of escrowed logic call ", coin)
+					return true
+				}
+				*expected = expected.Add(coin.Amount)
+			}
+
+			return false // continue iterating
+		})
+		if badLogicCall != "" {
+			return badLogicCall, true
+		}
+		// Finally it holds the deposits which could not be credited to their receiver
+		var missingEscrow *sdk.Coin
+		k.IterateDepositEscrows(ctx, func(_ []byte, escrow types.DepositEscrow) bool {
+			expected, ok := expectedBals[escrow.Amount.Denom]
+			if !ok {
+				missingEscrow = &escrow.Amount
+				return true
+			}
+			*expected = expected.Add(escrow.Amount.Amount)
+
+			return false // continue iterating
+		})
+		if missingEscrow != nil {
+			return fmt.Sprint("Module does not hold any balance of escrowed deposit ", missingEscrow), true
+		}
+		// As well as the cosmos originated tokens which have been sent to Ethereum
+		var missingLocked *sdk.Coin
+		k.IterateBridgeEscrow(ctx, func(_ []byte, locked sdk.Coin) bool {
+			expected, ok := expectedBals[locked.Denom]
+			if !ok {
+				missingLocked = &locked
+				return true
+			}
+			*expected = expected.Add(locked.Amount)
+
+			return false // continue iterating
+		})
+		if missingLocked != nil {
+			return fmt.Sprint("Module does not hold any balance of locked cosmos originated supply ", missingLocked), true
+		}
 
 		for _, actual := range actualBals {
 			if expected, ok := expectedBals[actual.GetDenom()]; !ok {
@@ -8,6 +8,7 @@ import (
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -184,3 +185,137 @@ func checkImbalancedModule(t *testing.T, ctx sdk.Context, gravityKeeper Keeper,
 	bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, coins)
 
 }
+
+// Tests that the gravity module's balance is accounted for with cosmos originated tokens locked while they are on Ethereum
+func TestModuleBalanceCosmosOriginated(t *testing.T) {
+	////////////////// SETUP //////////////////
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		now                 = time.Now().UTC()
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		denom               = "ucosmos"
+	)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	k.setCosmosOriginatedDenomToERC20(ctx, denom, *tokenContract)
+	// give the sender some cosmos originated tokens
+	allTokens := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1000)))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allTokens))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allTokens))
+
+	////////////////// EXECUTE //////////////////
+	checkInvariant(t, ctx, k, true)
+
+	// send some of the tokens to Ethereum and observe the batch
+	for i, v := range []int64{2, 3, 1} {
+		amount := sdk.NewCoin(denom, sdk.NewInt(int64(i+100)))
+		fee := sdk.NewCoin(denom, sdk.NewInt(v))
+		_, err := k.AddToOutgoingPool(ctx, mySender, *myReceiver, amount, fee)
+		require.NoError(t, err)
+	}
+	ctx = ctx.WithBlockTime(now)
+	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+	checkInvariant(t, ctx, k, true)
+	k.OutgoingTxBatchExecuted(ctx, batch.TokenContract, batch.BatchNonce)
+	// tx 2 (101 + 3) and tx 1 (100 + 2) are on Ethereum now, tx 3 is still in the pool
+	assert.Equal(t, sdk.NewCoin(denom, sdk.NewInt(206)), k.GetBridgeEscrowByDenom(ctx, denom))
+	checkInvariant(t, ctx, k, true)
+	checkImbalancedModule(t, ctx, k, input.BankKeeper, mySender, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1))))
+
+	// the valset relaying reward is minted and locked
+	err = k.AttestationHandler.Handle(ctx, types.Attestation{}, &types.MsgValsetUpdatedClaim{
+		EventNonce:   1,
+		ValsetNonce:  0,
+		BlockHeight:  1,
+		Members:      []types.BridgeValidator{},
+		RewardAmount: sdk.NewInt(10),
+		RewardToken:  myTokenContractAddr,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoin(denom, sdk.NewInt(216)), k.GetBridgeEscrowByDenom(ctx, denom))
+	checkInvariant(t, ctx, k, true)
+
+	// some of the tokens come back from Ethereum
+	err = k.AttestationHandler.Handle(ctx, types.Attestation{}, &types.MsgSendToCosmosClaim{
+		EventNonce:     2,
+		BlockHeight:    2,
+		TokenContract:  myTokenContractAddr,
+		Amount:         sdk.NewInt(50),
+		EthereumSender: myReceiver.GetAddress(),
+		CosmosReceiver: mySender.String(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoin(denom, sdk.NewInt(166)), k.GetBridgeEscrowByDenom(ctx, denom))
+	checkInvariant(t, ctx, k, true)
+
+	res, err := k.BridgeEscrow(sdk.WrapSDKContext(ctx), &types.QueryBridgeEscrowRequest{Denom: denom})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(166))), res.Escrow)
+	res, err = k.BridgeEscrow(sdk.WrapSDKContext(ctx), &types.QueryBridgeEscrowRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(166))), res.Escrow)
+}
+
+// Tests that the gravity module's balance is accounted for with the transfers and fees escrowed for logic calls
+func TestModuleBalanceLogicCalls(t *testing.T) {
+	////////////////// SETUP //////////////////
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		denom               = "ucosmos"
+		startingBalance     = sdk.NewCoin(denom, sdk.NewInt(1000))
+	)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	k.setCosmosOriginatedDenomToERC20(ctx, denom, *tokenContract)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(startingBalance)))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, sdk.NewCoins(startingBalance)))
+	call := func(nonce uint64) types.OutgoingLogicCall {
+		return types.OutgoingLogicCall{
+			Transfers:            []types.ERC20Token{{Contract: myTokenContractAddr, Amount: sdk.NewInt(100)}},
+			Fees:                 []types.ERC20Token{{Contract: myTokenContractAddr, Amount: sdk.NewInt(10)}},
+			LogicContractAddress: "0x510ab76899430424d209a6c9a5b9951fb8a6f47d",
+			Payload:              []byte("fake bytes"),
+			Timeout:              10000,
+			InvalidationId:       []byte("GravityTesting"),
+			InvalidationNonce:    nonce,
+		}
+	}
+
+	////////////////// EXECUTE //////////////////
+	// the transfers and fees are escrowed when the call is stored
+	require.NoError(t, k.AddOutgoingLogicCall(ctx, mySender, call(1)))
+	assert.Equal(t, sdk.NewInt(890), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+	assert.Equal(t, mySender.String(), k.GetOutgoingLogicCall(ctx, call(1).InvalidationId, 1).Sender)
+	checkInvariant(t, ctx, k, true)
+	require.ErrorIs(t, k.AddOutgoingLogicCall(ctx, mySender, call(1)), types.ErrDuplicate)
+
+	// a canceled call gives the escrow back to the sender
+	require.NoError(t, k.CancelOutgoingLogicCall(ctx, call(1).InvalidationId, 1))
+	assert.Equal(t, startingBalance, input.BankKeeper.GetBalance(ctx, mySender, denom))
+	checkInvariant(t, ctx, k, true)
+
+	// an executed call locks the cosmos originated tokens while they are on Ethereum
+	require.NoError(t, k.AddOutgoingLogicCall(ctx, mySender, call(2)))
+	require.NoError(t, k.OutgoingLogicCallExecuted(ctx, call(2).InvalidationId, 2))
+	assert.Equal(t, sdk.NewInt(890), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+	assert.Equal(t, sdk.NewCoin(denom, sdk.NewInt(110)), k.GetBridgeEscrowByDenom(ctx, denom))
+	checkInvariant(t, ctx, k, true)
+
+	// a call the sender can not pay for is not stored
+	huge := call(3)
+	huge.Transfers[0].Amount = sdk.NewInt(1000)
+	require.Error(t, k.AddOutgoingLogicCall(ctx, mySender, huge))
+	assert.Nil(t, k.GetOutgoingLogicCall(ctx, huge.InvalidationId, 3))
+	checkInvariant(t, ctx, k, true)
+}
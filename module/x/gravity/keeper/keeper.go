@@ -4,6 +4,7 @@ import (
 	"fmt"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"sort"
+	"strings"
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
@@ -26,6 +27,7 @@ type Keeper struct {
 
 	cdc            codec.BinaryCodec // The wire codec for binary encoding/decoding.
 	bankKeeper     bankkeeper.BaseKeeper
+	distKeeper     types.DistributionKeeper
 	SlashingKeeper types.SlashingKeeper
 	accountKeeper  authkeeper.AccountKeeper
 
@@ -56,6 +58,7 @@ func NewKeeper(
 		paramSpace:         paramSpace,
 		cdc:                cdc,
 		bankKeeper:         bankKeeper,
+		distKeeper:         distKeeper,
 		SlashingKeeper:     slashingKeeper,
 		accountKeeper:		accKeeper,
 		AttestationHandler: nil,
@@ -118,6 +121,76 @@ func (k Keeper) GetGravityID(ctx sdk.Context) string {
 	return a
 }
 
+// GetAttestationPowerPolicy returns whether attestations are tallied with the power of the voters
+// when they voted or with their current power, the current power unless governance opted in
+func (k Keeper) GetAttestationPowerPolicy(ctx sdk.Context) types.AttestationPowerPolicy {
+	policy := types.ATTESTATION_POWER_POLICY_CURRENT
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreAttestationPowerPolicy, &policy)
+	return policy
+}
+
+// GetAttestationThreshold returns the share of the voting power which must vote for an attestation
+// of the claim type before it is observed
+func (k Keeper) GetAttestationThreshold(ctx sdk.Context, claimType types.ClaimType) sdk.Dec {
+	var thresholds []types.ClaimTypeThreshold
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreAttestationThresholds, &thresholds)
+	for _, t := range thresholds {
+		if t.ClaimType == claimType {
+			return t.Threshold
+		}
+	}
+	return types.DefaultAttestationThreshold()
+}
+
+// GetBatchSizeLimit returns the largest number of transactions a batch of the token may contain
+func (k Keeper) GetBatchSizeLimit(ctx sdk.Context, tokenContract types.EthAddress) uint {
+	var limits []types.BatchSizeLimit
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreBatchSizeLimits, &limits)
+	for _, l := range limits {
+		token, err := types.NewEthAddress(l.Token)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid token in batch size limits: %s", l.Token))
+		}
+		// governance may write the token in any case, an Ethereum address is case insensitive
+		if strings.EqualFold(token.GetAddress(), tokenContract.GetAddress()) {
+			return uint(l.MaxBatchSize)
+		}
+	}
+	return OutgoingTxBatchSize
+}
+
+// GetAutoBatchFeeThreshold returns the total fee the pooled transactions of the token must pay before a batch
+// of them is built automatically, false if the token has no threshold
+func (k Keeper) GetAutoBatchFeeThreshold(ctx sdk.Context, tokenContract types.EthAddress) (sdk.Int, bool) {
+	var thresholds []types.AutoBatchFeeThreshold
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreAutoBatchFeeThresholds, &thresholds)
+	for _, t := range thresholds {
+		token, err := types.NewEthAddress(t.Token)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid token in auto batch fee thresholds: %s", t.Token))
+		}
+		// governance may write the token in any case, an Ethereum address is case insensitive
+		if strings.EqualFold(token.GetAddress(), tokenContract.GetAddress()) {
+			return t.MinTotalFee, true
+		}
+	}
+	return sdk.Int{}, false
+}
+
+// GetBatchSelectionPolicy returns the order transactions are taken from the pool in when a batch is built
+func (k Keeper) GetBatchSelectionPolicy(ctx sdk.Context) types.BatchSelectionPolicy {
+	var policy types.BatchSelectionPolicy
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreBatchSelectionPolicy, &policy)
+	return policy
+}
+
+// GetBatchAgeWeight returns the fee score bonus per block waited of the age weighted batch selection policy
+func (k Keeper) GetBatchAgeWeight(ctx sdk.Context) sdk.Dec {
+	weight := sdk.ZeroDec()
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreBatchAgeWeight, &weight)
+	return weight
+}
+
 // Set GravityID sets the GravityID the GravityID is essentially a salt value
 // for bridge signatures, provided each chain running Gravity has a unique ID
 // it won't be possible to play back signatures from one bridge onto another
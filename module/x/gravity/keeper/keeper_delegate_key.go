@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"bytes"
+	"strings"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -217,3 +220,135 @@ func (k Keeper) GetValidatorByEthAddress(ctx sdk.Context, ethAddr types.EthAddre
 
 	return validator, true
 }
+
+/////////////////////////////
+//   DELEGATE KEY ROTATION  //
+/////////////////////////////
+
+// GetOrchestratorByValidator returns the current orchestrator key of a validator
+func (k Keeper) GetOrchestratorByValidator(ctx sdk.Context, val sdk.ValAddress) (orch sdk.AccAddress, found bool) {
+	if err := sdk.VerifyAddressFormat(val); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid val address"))
+	}
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.KeyOrchestratorAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if bytes.Equal(iter.Value(), val.Bytes()) {
+			return sdk.AccAddress(iter.Key()), true
+		}
+	}
+	return nil, false
+}
+
+// RotateOrchestratorValidator replaces the orchestrator key of a validator, the old key is
+// moved to the historical index so that confirms it signed can still be attributed
+func (k Keeper) RotateOrchestratorValidator(ctx sdk.Context, val sdk.ValAddress, oldOrch sdk.AccAddress, newOrch sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(types.GetOrchestratorAddressKey(oldOrch)))
+	k.SetPastOrchestratorValidator(ctx, val, oldOrch)
+	k.SetOrchestratorValidator(ctx, val, newOrch)
+}
+
+// SetPastOrchestratorValidator records an orchestrator key which a validator no longer uses
+func (k Keeper) SetPastOrchestratorValidator(ctx sdk.Context, val sdk.ValAddress, orch sdk.AccAddress) {
+	if err := sdk.VerifyAddressFormat(val); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid val address"))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetPastOrchestratorAddressKey(orch)), val.Bytes())
+}
+
+// GetPastOrchestratorValidator returns the validator that a replaced orchestrator key belonged to
+func (k Keeper) GetPastOrchestratorValidator(ctx sdk.Context, orch sdk.AccAddress) (val sdk.ValAddress, found bool) {
+	if err := sdk.VerifyAddressFormat(orch); err != nil {
+		return nil, false
+	}
+	store := ctx.KVStore(k.storeKey)
+	valAddr := store.Get([]byte(types.GetPastOrchestratorAddressKey(orch)))
+	if valAddr == nil {
+		return nil, false
+	}
+	return sdk.ValAddress(valAddr), true
+}
+
+// GetValidatorAddressByOrchestrator resolves the validator of an orchestrator key, falling back to
+// the keys replaced by a rotation, this is used to attribute confirms signed before the rotation
+func (k Keeper) GetValidatorAddressByOrchestrator(ctx sdk.Context, orch sdk.AccAddress) (val sdk.ValAddress, found bool) {
+	validator, found := k.GetOrchestratorValidator(ctx, orch)
+	if found {
+		return validator.GetOperator(), true
+	}
+	return k.GetPastOrchestratorValidator(ctx, orch)
+}
+
+// RotateEthAddressForValidator replaces the ethereum address of a validator, the old address
+// is moved to the historical index so that it can not be claimed by another validator
+func (k Keeper) RotateEthAddressForValidator(ctx sdk.Context, val sdk.ValAddress, oldEthAddr types.EthAddress, newEthAddr types.EthAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(types.GetValidatorByEthAddressKey(oldEthAddr)))
+	k.SetPastValidatorByEthAddress(ctx, val, oldEthAddr)
+	k.SetEthAddressForValidator(ctx, val, newEthAddr)
+}
+
+// SetPastValidatorByEthAddress records an ethereum address which a validator no longer uses
+func (k Keeper) SetPastValidatorByEthAddress(ctx sdk.Context, val sdk.ValAddress, ethAddr types.EthAddress) {
+	if err := sdk.VerifyAddressFormat(val); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid val address"))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetPastValidatorByEthAddressKey(ethAddr)), val.Bytes())
+}
+
+// GetPastValidatorByEthAddress returns the validator that a replaced ethereum address belonged to
+func (k Keeper) GetPastValidatorByEthAddress(ctx sdk.Context, ethAddr types.EthAddress) (val sdk.ValAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	valAddr := store.Get([]byte(types.GetPastValidatorByEthAddressKey(ethAddr)))
+	if valAddr == nil {
+		return nil, false
+	}
+	return sdk.ValAddress(valAddr), true
+}
+
+// ethAddressRotationPending tells whether the bridge contract may still hold the rotated out ethereum addresses
+// of a validator, which is the case until a valset containing its current address is observed
+func (k Keeper) ethAddressRotationPending(ctx sdk.Context, current types.EthAddress) bool {
+	observed := k.GetLastObservedValset(ctx)
+	if observed == nil {
+		return true
+	}
+	for _, member := range observed.Members {
+		if strings.EqualFold(member.EthereumAddress, current.GetAddress()) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetPastDelegateKeys returns every delegate key replaced by a rotation
+func (k Keeper) GetPastDelegateKeys(ctx sdk.Context) []types.PastDelegateKey {
+	var result []types.PastDelegateKey
+	store := ctx.KVStore(k.storeKey)
+
+	orchStore := prefix.NewStore(store, []byte(types.PastOrchestratorAddressKey))
+	iter := orchStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		result = append(result, types.PastDelegateKey{
+			Validator:    sdk.ValAddress(iter.Value()).String(),
+			Orchestrator: sdk.AccAddress(iter.Key()).String(),
+		})
+	}
+
+	ethStore := prefix.NewStore(store, []byte(types.PastValidatorByEthAddressKey))
+	ethIter := ethStore.Iterator(nil, nil)
+	defer ethIter.Close()
+	for ; ethIter.Valid(); ethIter.Next() {
+		result = append(result, types.PastDelegateKey{
+			Validator:  sdk.ValAddress(ethIter.Value()).String(),
+			EthAddress: string(ethIter.Key()),
+		})
+	}
+	return result
+}
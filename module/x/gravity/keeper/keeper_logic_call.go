@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
@@ -17,6 +19,10 @@ import (
 // GetOutgoingLogicCall gets an outgoing logic call
 func (k Keeper) GetOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) *types.OutgoingLogicCall {
 	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce)))
+	if bz == nil {
+		return nil
+	}
 	call := types.OutgoingLogicCall{
 		Transfers:            []types.ERC20Token{},
 		Fees:                 []types.ERC20Token{},
@@ -27,10 +33,47 @@ func (k Keeper) GetOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, inv
 		InvalidationNonce:    invalidationNonce,
 		Block:                0,
 	}
-	k.cdc.MustUnmarshal(store.Get([]byte(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))), &call)
+	k.cdc.MustUnmarshal(bz, &call)
 	return &call
 }
 
+// AddOutgoingLogicCall escrows the transfers and fees of a logic call from sender in the gravity module account
+// and stores the call so it can be signed and relayed to Ethereum. The escrow is returned to sender if the call
+// times out or is invalidated, and released to Ethereum once the call is executed
+func (k Keeper) AddOutgoingLogicCall(ctx sdk.Context, sender sdk.AccAddress, call types.OutgoingLogicCall) error {
+	if k.GetOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce) != nil {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "logic call %s %d", hex.EncodeToString(call.InvalidationId), call.InvalidationNonce)
+	}
+	escrow, err := k.logicCallCoins(ctx, call)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid ERC20 in logic call")
+	}
+	if !escrow.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, escrow); err != nil {
+			return sdkerrors.Wrapf(err, "escrow logic call transfers and fees %s", escrow)
+		}
+	}
+	call.Sender = sender.String()
+	k.SetOutgoingLogicCall(ctx, call)
+	return nil
+}
+
+// logicCallCoins returns the coins escrowed in the gravity module account for the transfers and fees of a logic call
+func (k Keeper) logicCallCoins(ctx sdk.Context, call types.OutgoingLogicCall) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	for _, tokens := range [][]types.ERC20Token{call.Transfers, call.Fees} {
+		for _, token := range tokens {
+			erc20, err := token.ToInternal()
+			if err != nil {
+				return nil, err
+			}
+			_, denom := k.ERC20ToDenomLookup(ctx, erc20.Contract)
+			coins = coins.Add(sdk.NewCoin(denom, erc20.Amount))
+		}
+	}
+	return coins, nil
+}
+
 // SetOutogingLogicCall sets an outgoing logic call
 func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, call types.OutgoingLogicCall) {
 	store := ctx.KVStore(k.storeKey)
@@ -43,9 +86,10 @@ func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, call types.OutgoingLogicCa
 		k.cdc.MustMarshal(&call))
 }
 
-// DeleteOutgoingLogicCall deletes outgoing logic calls
+// DeleteOutgoingLogicCall deletes outgoing logic calls together with their confirms
 func (k Keeper) DeleteOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
 	ctx.KVStore(k.storeKey).Delete([]byte(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce)))
+	k.pruneLogicCallConfirms(ctx, invalidationID, invalidationNonce)
 }
 
 // IterateOutgoingLogicCalls iterates over outgoing logic calls
@@ -72,12 +116,25 @@ func (k Keeper) GetOutgoingLogicCalls(ctx sdk.Context) (out []types.OutgoingLogi
 	return
 }
 
-// CancelOutgoingLogicCalls releases all TX in the batch and deletes the batch
+// CancelOutgoingLogicCalls returns the escrowed transfers and fees of the call to its sender and deletes the call
 func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, invalidationId []byte, invalidationNonce uint64) error {
 	call := k.GetOutgoingLogicCall(ctx, invalidationId, invalidationNonce)
 	if call == nil {
 		return types.ErrUnknown
 	}
+	escrow, err := k.logicCallCoins(ctx, *call)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid ERC20 in canceled logic call")
+	}
+	if !escrow.IsZero() {
+		sender, err := sdk.AccAddressFromBech32(call.Sender)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid sender of canceled logic call: %s", call.Sender)
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, escrow); err != nil {
+			return sdkerrors.Wrapf(err, "refund logic call transfers and fees %s", escrow)
+		}
+	}
 	// Delete batch since it is finished
 	k.DeleteOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce)
 
@@ -92,6 +149,62 @@ func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, invalidationId []byte,
 	return nil
 }
 
+// OutgoingLogicCallExecuted is run when the Cosmos chain detects that a logic call has been executed on Ethereum.
+// It burns the Ethereum originated vouchers escrowed for the transfers and fees and locks the Cosmos originated
+// ones in the bridge escrow, invalidates every call with the same invalidation id and a lower nonce, and deletes
+// the executed call together with its confirms
+func (k Keeper) OutgoingLogicCallExecuted(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) error {
+	call := k.GetOutgoingLogicCall(ctx, invalidationID, invalidationNonce)
+	if call == nil {
+		return sdkerrors.Wrapf(types.ErrUnknown, "logic call %s %d", hex.EncodeToString(invalidationID), invalidationNonce)
+	}
+
+	// Cosmos originated tokens stay locked in the module, they now back the ERC20 representation on Ethereum.
+	// Ethereum originated vouchers are burned since the underlying ERC20 has been released on Ethereum
+	escrow, err := k.logicCallCoins(ctx, *call)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid ERC20 in executed logic call")
+	}
+	burnVouchers := sdk.NewCoins()
+	for _, coin := range escrow {
+		if _, isCosmosOriginated := k.GetCosmosOriginatedERC20(ctx, coin.Denom); isCosmosOriginated {
+			k.lockBridgeEscrow(ctx, coin)
+		} else {
+			burnVouchers = burnVouchers.Add(coin)
+		}
+	}
+	if !burnVouchers.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnVouchers); err != nil {
+			return sdkerrors.Wrapf(err, "burn logic call vouchers %s", burnVouchers)
+		}
+	}
+
+	// Executing a call on Ethereum invalidates all calls with the same invalidation id and a lower nonce
+	var invalidated []types.OutgoingLogicCall
+	k.IterateOutgoingLogicCalls(ctx, func(_ []byte, iterCall types.OutgoingLogicCall) bool {
+		if bytes.Equal(iterCall.InvalidationId, invalidationID) && iterCall.InvalidationNonce < invalidationNonce {
+			invalidated = append(invalidated, iterCall)
+		}
+		return false
+	})
+	for _, iterCall := range invalidated {
+		if err := k.CancelOutgoingLogicCall(ctx, iterCall.InvalidationId, iterCall.InvalidationNonce); err != nil {
+			return sdkerrors.Wrapf(err, "failed to invalidate logic call %d", iterCall.InvalidationNonce)
+		}
+	}
+
+	// Delete the call since it is finished
+	k.DeleteOutgoingLogicCall(ctx, invalidationID, invalidationNonce)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOutgoingLogicCallExecuted,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyInvalidationID, hex.EncodeToString(invalidationID)),
+		sdk.NewAttribute(types.AttributeKeyInvalidationNonce, fmt.Sprint(invalidationNonce)),
+	))
+	return nil
+}
+
 /////////////////////////////
 //       LOGICCONFIRMS     //
 /////////////////////////////
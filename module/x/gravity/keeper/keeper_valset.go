@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
 )
@@ -67,9 +68,10 @@ func (k Keeper) HasValsetRequest(ctx sdk.Context, nonce uint64) bool {
 	return store.Has([]byte(types.GetValsetKey(nonce)))
 }
 
-// DeleteValset deletes the valset at a given nonce from state
+// DeleteValset deletes the valset at a given nonce from state together with its confirms
 func (k Keeper) DeleteValset(ctx sdk.Context, nonce uint64) {
 	ctx.KVStore(k.storeKey).Delete([]byte(types.GetValsetKey(nonce)))
+	k.pruneValsetConfirms(ctx, nonce)
 }
 
 // GetLatestValsetNonce returns the latest valset nonce
@@ -383,3 +385,95 @@ func (k Keeper) IterateValsetConfirmByNonce(ctx sdk.Context, nonce uint64, cb fu
 		}
 	}
 }
+
+/////////////////////////////
+//    VALSET HIJACKING     //
+/////////////////////////////
+
+// GetValsetHijackReason compares a validator set observed on Ethereum against the validator set
+// created on Cosmos with the same nonce, an empty string is returned if they match, otherwise
+// a description of the difference
+func (k Keeper) GetValsetHijackReason(ctx sdk.Context, observed types.Valset) string {
+	stored := k.GetValset(ctx, observed.Nonce)
+	if stored == nil {
+		return fmt.Sprintf("valset nonce %d was never created", observed.Nonce)
+	}
+	if len(stored.Members) != len(observed.Members) {
+		return fmt.Sprintf("expected %d members got %d", len(stored.Members), len(observed.Members))
+	}
+	for i, member := range stored.Members {
+		// compare parsed addresses, the observed ones are not guaranteed to share our checksum casing
+		if gethcommon.HexToAddress(member.EthereumAddress) != gethcommon.HexToAddress(observed.Members[i].EthereumAddress) {
+			return fmt.Sprintf("expected member %d to be %s got %s", i, member.EthereumAddress, observed.Members[i].EthereumAddress)
+		}
+		if member.Power != observed.Members[i].Power {
+			return fmt.Sprintf("expected member %s to have power %d got %d", member.EthereumAddress, member.Power, observed.Members[i].Power)
+		}
+	}
+	if !stored.RewardAmount.Equal(observed.RewardAmount) {
+		return fmt.Sprintf("expected reward amount %s got %s", stored.RewardAmount, observed.RewardAmount)
+	}
+	if gethcommon.HexToAddress(stored.RewardToken) != gethcommon.HexToAddress(observed.RewardToken) {
+		return fmt.Sprintf("expected reward token %s got %s", stored.RewardToken, observed.RewardToken)
+	}
+	return ""
+}
+
+// HandleValsetHijack records the incident, alerts any watchers and halts the bridge so that
+// no further attestations are processed until governance has intervened
+func (k Keeper) HandleValsetHijack(ctx sdk.Context, eventNonce uint64, observed types.Valset, reason string) {
+	k.logger(ctx).Error("Validator set hijacking detected, halting the bridge",
+		"event nonce", eventNonce,
+		"valset nonce", observed.Nonce,
+		"reason", reason,
+	)
+	k.SetValsetHijackIncident(ctx, types.ValsetHijackIncident{
+		EventNonce:    eventNonce,
+		BlockHeight:   uint64(ctx.BlockHeight()),
+		ClaimedValset: observed,
+		Reason:        reason,
+	})
+	k.paramSpace.Set(ctx, types.ParamStoreBridgeActive, false)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValsetHijackDetected,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx).GetAddress()),
+			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(eventNonce)),
+			sdk.NewAttribute(types.AttributeKeyValsetNonce, fmt.Sprint(observed.Nonce)),
+			sdk.NewAttribute(types.AttributeKeyHijackReason, reason),
+		),
+	)
+}
+
+// SetValsetHijackIncident stores a validator set hijack incident by event nonce
+func (k Keeper) SetValsetHijackIncident(ctx sdk.Context, incident types.ValsetHijackIncident) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetValsetHijackIncidentKey(incident.EventNonce)), k.cdc.MustMarshal(&incident))
+}
+
+// IterateValsetHijackIncidents iterates through all validator set hijack incidents in ASC event nonce order
+func (k Keeper) IterateValsetHijackIncidents(ctx sdk.Context, cb func([]byte, types.ValsetHijackIncident) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.ValsetHijackIncidentKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var incident types.ValsetHijackIncident
+		k.cdc.MustUnmarshal(iter.Value(), &incident)
+		// cb returns true to stop early
+		if cb(iter.Key(), incident) {
+			break
+		}
+	}
+}
+
+// GetValsetHijackIncidents returns all the validator set hijack incidents in state
+func (k Keeper) GetValsetHijackIncidents(ctx sdk.Context) (out []types.ValsetHijackIncident) {
+	k.IterateValsetHijackIncidents(ctx, func(_ []byte, incident types.ValsetHijackIncident) bool {
+		out = append(out, incident)
+		return false
+	})
+	return
+}
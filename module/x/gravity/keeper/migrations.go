@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. Params added since version 1 are set to their
+// defaults, since several of them are read with Get which panics on a missing value, the
+// pending transfer indexes are built for the transactions already in the pool or in batches
+// and those transactions are given a transfer status created at the upgrade height. Finally the
+// locked supply of the cosmos originated denoms already sent to Ethereum is seeded
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !m.keeper.paramSpace.Has(ctx, pair.Key) {
+			m.keeper.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	for _, tx := range m.keeper.GetUnbatchedTransactions(ctx) {
+		m.keeper.setPendingTransferIndexes(ctx, tx, []byte(types.GetOutgoingTxPoolKey(*tx.Erc20Fee, tx.Id)))
+	}
+	for _, batch := range m.keeper.GetOutgoingTxBatches(ctx) {
+		m.keeper.indexBatchTransactions(ctx, batch, []byte(types.GetOutgoingTxBatchKey(batch.TokenContract, batch.BatchNonce)))
+	}
+	m.backfillTransferStatuses(ctx)
+	m.seedBridgeEscrow(ctx)
+	return nil
+}
+
+// backfillTransferStatuses records a status for the transactions which entered the pool before
+// statuses were tracked, so that their age counts from the upgrade height instead of being unknown
+func (m Migrator) backfillTransferStatuses(ctx sdk.Context) {
+	for _, tx := range m.keeper.GetUnbatchedTransactions(ctx) {
+		if m.keeper.GetTransferStatus(ctx, tx.Id) == nil {
+			m.keeper.updateTransferStatus(ctx, tx, types.TRANSFER_STATE_POOLED, 0)
+		}
+	}
+	for _, batch := range m.keeper.GetOutgoingTxBatches(ctx) {
+		for _, tx := range batch.Transactions {
+			if m.keeper.GetTransferStatus(ctx, tx.Id) == nil {
+				m.keeper.updateTransferStatus(ctx, tx, types.TRANSFER_STATE_BATCHED, batch.BatchNonce)
+			}
+		}
+	}
+}
+
+// seedBridgeEscrow locks the balance the module holds of each cosmos originated denom which is not escrowed
+// for a pending transfer, logic call or deposit, since that balance backs the supply already sent to Ethereum
+func (m Migrator) seedBridgeEscrow(ctx sdk.Context) {
+	escrowed := sdk.NewCoins()
+	for _, tx := range m.keeper.GetUnbatchedTransactions(ctx) {
+		_, denom := m.keeper.ERC20ToDenomLookup(ctx, tx.Erc20Token.Contract)
+		escrowed = escrowed.Add(sdk.NewCoin(denom, tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount)))
+	}
+	for _, batch := range m.keeper.GetOutgoingTxBatches(ctx) {
+		_, denom := m.keeper.ERC20ToDenomLookup(ctx, batch.TokenContract)
+		for _, tx := range batch.Transactions {
+			escrowed = escrowed.Add(sdk.NewCoin(denom, tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount)))
+		}
+	}
+	for _, call := range m.keeper.GetOutgoingLogicCalls(ctx) {
+		if coins, err := m.keeper.logicCallCoins(ctx, call); err == nil {
+			escrowed = escrowed.Add(coins...)
+		}
+	}
+	for _, escrow := range m.keeper.GetDepositEscrows(ctx) {
+		escrowed = escrowed.Add(escrow.Amount)
+	}
+
+	var denoms []string
+	m.keeper.IterateERC20ToDenom(ctx, func(_ []byte, erc20ToDenom *types.ERC20ToDenom) bool {
+		denoms = append(denoms, erc20ToDenom.Denom)
+		return false
+	})
+	modAcc := m.keeper.accountKeeper.GetModuleAddress(types.ModuleName)
+	for _, denom := range denoms {
+		locked := m.keeper.bankKeeper.GetBalance(ctx, modAcc, denom).Amount.Sub(escrowed.AmountOf(denom))
+		if locked.IsPositive() && m.keeper.GetBridgeEscrowByDenom(ctx, denom).IsZero() {
+			m.keeper.setBridgeEscrow(ctx, sdk.NewCoin(denom, locked))
+		}
+	}
+}
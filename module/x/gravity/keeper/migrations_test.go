@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// Tests that the v1 to v2 migration rebuilds the pending transfer indexes, backfills the transfer statuses
+// and keeps params that are already set
+func TestMigrate1to2(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	myReceiver, err := types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+
+	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	ids := make([]uint64, 3)
+	for i, v := range []uint64{3, 2, 1} {
+		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
+		require.NoError(t, err)
+		ids[i], err = k.AddToOutgoingPool(ctx, mySender, *myReceiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+		require.NoError(t, err)
+	}
+	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, 1)
+	require.NoError(t, err)
+
+	// a version 1 store has none of the pending transfer indexes nor transfer statuses
+	store := ctx.KVStore(k.storeKey)
+	for _, prefixKey := range []string{types.OutgoingTxByIDKey, types.OutgoingTxBySenderKey, types.OutgoingTxByDestinationKey, types.TransferStatusKey} {
+		var keys [][]byte
+		iter := store.Iterator(prefixRange([]byte(prefixKey)))
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	_, err = k.GetUnbatchedTxById(ctx, ids[1])
+	require.Error(t, err)
+	require.Nil(t, k.GetTransferStatus(ctx, ids[0]))
+
+	params := k.GetParams(ctx)
+	params.AutoBatchMaxTxAge = 42
+	k.SetParams(ctx, params)
+
+	upgradeHeight := ctx.BlockHeight() + 100
+	ctx = ctx.WithBlockHeight(upgradeHeight)
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	tx, batchNonce, found := k.GetPendingTransferById(ctx, ids[0])
+	require.True(t, found)
+	assert.Equal(t, batch.BatchNonce, batchNonce)
+	assert.Equal(t, ids[0], tx.Id)
+	for _, id := range ids[1:] {
+		tx, err := k.GetUnbatchedTxById(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id, tx.Id)
+	}
+	res, err := k.GetTransfersBySender(sdk.WrapSDKContext(ctx), &types.QueryTransfersBySenderRequest{
+		SenderAddress: mySender.String(),
+		Pagination:    &query.PageRequest{Limit: 10},
+	})
+	require.NoError(t, err)
+	assert.Len(t, res.Transfers, 3)
+	destRes, err := k.GetTransfersByDestination(sdk.WrapSDKContext(ctx), &types.QueryTransfersByDestinationRequest{
+		EthAddress: myReceiver.GetAddress(),
+	})
+	require.NoError(t, err)
+	assert.Len(t, destRes.Transfers, 3)
+
+	status := k.GetTransferStatus(ctx, ids[0])
+	require.NotNil(t, status)
+	assert.Equal(t, types.TRANSFER_STATE_BATCHED, status.State)
+	assert.Equal(t, batch.BatchNonce, status.BatchNonce)
+	assert.Equal(t, uint64(upgradeHeight), status.CreatedHeight)
+	for _, id := range ids[1:] {
+		status := k.GetTransferStatus(ctx, id)
+		require.NotNil(t, status)
+		assert.Equal(t, types.TRANSFER_STATE_POOLED, status.State)
+		assert.Equal(t, uint64(upgradeHeight), status.CreatedHeight)
+	}
+
+	assert.Equal(t, uint64(42), k.GetParams(ctx).AutoBatchMaxTxAge)
+}
+
+// Tests that the v1 to v2 migration locks the module balance of cosmos originated denoms which is not
+// escrowed for a pending transfer, since it backs the supply already sent to Ethereum
+func TestMigrate1to2BridgeEscrow(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, _       = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		denom               = "ucosmos"
+	)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	k.setCosmosOriginatedDenomToERC20(ctx, denom, *tokenContract)
+	allTokens := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1000)))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allTokens))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allTokens))
+
+	for i, v := range []int64{2, 3, 1} {
+		amount := sdk.NewCoin(denom, sdk.NewInt(int64(i+100)))
+		fee := sdk.NewCoin(denom, sdk.NewInt(v))
+		_, err := k.AddToOutgoingPool(ctx, mySender, *myReceiver, amount, fee)
+		require.NoError(t, err)
+	}
+	_, err = k.BuildOutgoingTXBatch(ctx, *tokenContract, 1)
+	require.NoError(t, err)
+	// tokens which were sent to Ethereum by a version 1 chain are in the module without a locked supply
+	sentToEth := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500)))
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(ctx, mySender, types.ModuleName, sentToEth))
+	require.True(t, k.GetBridgeEscrowByDenom(ctx, denom).IsZero())
+	_, broken := ModuleBalanceInvariant(k)(ctx)
+	require.True(t, broken)
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	assert.Equal(t, sentToEth[0], k.GetBridgeEscrowByDenom(ctx, denom))
+	msg, broken := ModuleBalanceInvariant(k)(ctx)
+	assert.False(t, broken, msg)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"strings"
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
@@ -60,6 +61,21 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 		return nil, sdkerrors.Wrap(types.ErrResetDelegateKeys, val.String())
 	}
 
+	// a key may never be reused, whether it is in use by another validator or has been rotated out
+	if _, foundPast := k.GetPastOrchestratorValidator(ctx, orch); foundPast {
+		return nil, sdkerrors.Wrap(types.ErrDuplicate, "orchestrator address already used")
+	}
+	_, foundCurrentEthAddress := k.GetValidatorByEthAddress(ctx, *addr)
+	_, foundPastEthAddress := k.GetPastValidatorByEthAddress(ctx, *addr)
+	if foundCurrentEthAddress || foundPastEthAddress {
+		return nil, sdkerrors.Wrap(types.ErrDuplicate, "ethereum address already used")
+	}
+
+	// ensure that the validator controls the ethereum key
+	if err := k.verifyDelegateKeysSignature(ctx, msg.Validator, msg.Orchestrator, *addr, msg.EthSignature); err != nil {
+		return nil, err
+	}
+
 	// set the orchestrator address
 	k.SetOrchestratorValidator(ctx, val, orch)
 	// set the ethereum address
@@ -77,6 +93,91 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 
 }
 
+// RotateDelegateKeys handles MsgRotateDelegateKeys
+func (k msgServer) RotateDelegateKeys(c context.Context, msg *types.MsgRotateDelegateKeys) (*types.MsgRotateDelegateKeysResponse, error) {
+	// ensure that this passes validation, checks the key validity
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Key not valid")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	val, _ := sdk.ValAddressFromBech32(msg.Validator)
+
+	// ensure that the validator exists and has delegate keys to rotate
+	if k.Keeper.StakingKeeper.Validator(ctx, val) == nil {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, val.String())
+	}
+	oldOrch, foundOrch := k.GetOrchestratorByValidator(ctx, val)
+	oldEthAddr, foundEthAddr := k.GetEthAddressByValidator(ctx, val)
+	if !foundOrch || !foundEthAddr {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "no delegate keys set for validator")
+	}
+
+	// a key may never be reused, whether it is in use or has been rotated out
+	var newOrch sdk.AccAddress
+	if msg.NewOrchestrator != "" {
+		newOrch, _ = sdk.AccAddressFromBech32(msg.NewOrchestrator)
+		_, foundCurrent := k.GetOrchestratorValidator(ctx, newOrch)
+		_, foundPast := k.GetPastOrchestratorValidator(ctx, newOrch)
+		if foundCurrent || foundPast || newOrch.Equals(oldOrch) {
+			return nil, sdkerrors.Wrap(types.ErrDuplicate, "orchestrator address already used")
+		}
+	}
+	var newEthAddr *types.EthAddress
+	if msg.NewEthAddress != "" {
+		newEthAddr, _ = types.NewEthAddress(msg.NewEthAddress)
+		_, foundCurrent := k.GetValidatorByEthAddress(ctx, *newEthAddr)
+		_, foundPast := k.GetPastValidatorByEthAddress(ctx, *newEthAddr)
+		if foundCurrent || foundPast || newEthAddr.GetAddress() == oldEthAddr.GetAddress() {
+			return nil, sdkerrors.Wrap(types.ErrDuplicate, "ethereum address already used")
+		}
+
+		// the new ethereum key signs for the orchestrator in use after the rotation
+		orch := oldOrch
+		if newOrch != nil {
+			orch = newOrch
+		}
+		if err := k.verifyDelegateKeysSignature(ctx, msg.Validator, orch.String(), *newEthAddr, msg.EthSignature); err != nil {
+			return nil, err
+		}
+	}
+
+	if newOrch != nil {
+		k.RotateOrchestratorValidator(ctx, val, oldOrch, newOrch)
+	}
+	if newEthAddr != nil {
+		k.RotateEthAddressForValidator(ctx, val, *oldEthAddr, *newEthAddr)
+		// the bridge only learns the new address through a validator set update,
+		// request one now so that the validator's signatures remain useful
+		k.SetValsetRequest(ctx)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeySetOperatorAddr, msg.NewOrchestrator),
+		),
+	)
+
+	return &types.MsgRotateDelegateKeysResponse{}, nil
+}
+
+// verifyDelegateKeysSignature checks that the ethereum key being registered as a delegate key
+// has signed the validator and orchestrator it is registered for
+func (k msgServer) verifyDelegateKeysSignature(ctx sdk.Context, validator string, orchestrator string, ethAddress types.EthAddress, signature string) error {
+	sigBytes, err := hex.DecodeString(signature)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
+	}
+	hash := types.GetDelegateKeysSignHash(k.GetGravityID(ctx), validator, orchestrator)
+	if err := types.ValidateEthereumSignature(hash, sigBytes, ethAddress); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("delegate keys signature verification failed expected sig by %s found %s", ethAddress.GetAddress(), signature))
+	}
+	return nil
+}
+
 // ValsetConfirm handles MsgValsetConfirm
 func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm) (*types.MsgValsetConfirmResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -88,7 +189,7 @@ func (k msgServer) ValsetConfirm(c context.Context, msg *types.MsgValsetConfirm)
 	gravityID := k.GetGravityID(ctx)
 	checkpoint := valset.GetCheckpoint(gravityID)
 	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
-	err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
+	err := k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.EthAddress, msg.Signature, checkpoint)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Could not confirm handler common")
 	}
@@ -148,7 +249,7 @@ func (k msgServer) RequestBatch(c context.Context, msg *types.MsgRequestBatch) (
 		return nil, sdkerrors.Wrap(err, "Could not look up erc 20 denominator")
 	}
 
-	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, OutgoingTxBatchSize)
+	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, k.GetBatchSizeLimit(ctx, *tokenContract))
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Could not build outgoing tx batch")
 	}
@@ -182,7 +283,7 @@ func (k msgServer) ConfirmBatch(c context.Context, msg *types.MsgConfirmBatch) (
 	gravityID := k.GetGravityID(ctx)
 	checkpoint := batch.GetCheckpoint(gravityID)
 	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
-	err = k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
+	err = k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.EthSigner, msg.Signature, checkpoint)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Could not confirm handler common")
 	}
@@ -221,7 +322,7 @@ func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogi
 	gravityID := k.GetGravityID(ctx)
 	checkpoint := logic.GetCheckpoint(gravityID)
 	orchaddr, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
-	err = k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.Signature, checkpoint)
+	err = k.confirmHandlerCommon(ctx, msg.Orchestrator, msg.EthSigner, msg.Signature, checkpoint)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Could not confirm Handler")
 	}
@@ -288,7 +389,7 @@ func (k msgServer) claimHandlerCommon(ctx sdk.Context, msgAny *codectypes.Any, m
 }
 
 // confirmHandlerCommon is an internal function that provides common code for processing claim messages
-func (k msgServer) confirmHandlerCommon(ctx sdk.Context, orchestrator string, signature string, checkpoint []byte) error {
+func (k msgServer) confirmHandlerCommon(ctx sdk.Context, orchestrator string, ethSigner string, signature string, checkpoint []byte) error {
 	sigBytes, err := hex.DecodeString(signature)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
@@ -308,6 +409,15 @@ func (k msgServer) confirmHandlerCommon(ctx sdk.Context, orchestrator string, si
 		return sdkerrors.Wrap(types.ErrEmpty, "eth address")
 	}
 
+	// after an ethereum key rotation the contract only verifies signatures of the old key until a valset
+	// containing the new one is relayed, so confirms signed by a rotated out key are accepted until then
+	if signer, err := types.NewEthAddress(ethSigner); err == nil && !strings.EqualFold(signer.GetAddress(), ethAddress.GetAddress()) {
+		pastVal, foundPast := k.GetPastValidatorByEthAddress(ctx, *signer)
+		if foundPast && pastVal.Equals(validator.GetOperator()) && k.ethAddressRotationPending(ctx, *ethAddress) {
+			ethAddress = signer
+		}
+	}
+
 	err = types.ValidateEthereumSignature(checkpoint, sigBytes, *ethAddress)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature verification failed expected sig by %s with checkpoint %s found %s", ethAddress, hex.EncodeToString(checkpoint), signature))
@@ -422,6 +532,33 @@ func (k msgServer) ValsetUpdateClaim(c context.Context, msg *types.MsgValsetUpda
 	return &types.MsgValsetUpdatedClaimResponse{}, nil
 }
 
+// EthereumHeightClaim records the latest Ethereum block height seen by the orchestrator of a validator, the heights
+// claimed by all the validators are tallied in the end blocker
+func (k msgServer) EthereumHeightClaim(c context.Context, msg *types.MsgEthereumHeightClaim) (*types.MsgEthereumHeightClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	err := k.checkOrchestratorValidatorInSet(ctx, msg.Orchestrator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Could not check orchestrator validator in set")
+	}
+	validator, _ := k.GetOrchestratorValidator(ctx, msg.GetClaimer())
+	k.SetEthereumHeightVote(ctx, types.EthereumHeightVote{
+		Validator:           validator.GetOperator().String(),
+		EthereumBlockHeight: msg.BlockHeight,
+		CosmosBlockHeight:   uint64(ctx.BlockHeight()),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyEthereumHeight, fmt.Sprint(msg.BlockHeight)),
+		),
+	)
+
+	return &types.MsgEthereumHeightClaimResponse{}, nil
+}
+
 func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendToEth) (*types.MsgCancelSendToEthResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -460,3 +597,49 @@ func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgS
 
 	return &types.MsgSubmitBadSignatureEvidenceResponse{}, err
 }
+
+// ClaimDepositEscrow handles MsgClaimDepositEscrow
+func (k msgServer) ClaimDepositEscrow(c context.Context, msg *types.MsgClaimDepositEscrow) (*types.MsgClaimDepositEscrowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Receiver)
+	}
+	claimed, err := k.ReleaseDepositEscrows(ctx, receiver, receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyAmount, claimed.String()),
+		),
+	)
+
+	return &types.MsgClaimDepositEscrowResponse{}, nil
+}
+
+// SubmitDoubleSignEvidence handles MsgSubmitDoubleSignEvidence
+func (k msgServer) SubmitDoubleSignEvidence(c context.Context, msg *types.MsgSubmitDoubleSignEvidence) (*types.MsgSubmitDoubleSignEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	err := k.CheckDoubleSignEvidence(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyBadEthSignature, fmt.Sprint(msg.SignatureA)),
+			sdk.NewAttribute(types.AttributeKeyBadEthSignatureSubject, fmt.Sprint(msg.SubjectA)),
+			sdk.NewAttribute(types.AttributeKeyBadEthSignature, fmt.Sprint(msg.SignatureB)),
+			sdk.NewAttribute(types.AttributeKeyBadEthSignatureSubject, fmt.Sprint(msg.SubjectB)),
+		),
+	)
+
+	return &types.MsgSubmitDoubleSignEvidenceResponse{}, nil
+}
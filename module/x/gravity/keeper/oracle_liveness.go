@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// GetOracleLivenessSlash returns the event nonce the validator was last slashed for not claiming,
+// zero if the validator has never been slashed for oracle liveness
+func (k Keeper) GetOracleLivenessSlash(ctx sdk.Context, validator sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetOracleLivenessSlashKey(validator)))
+	if len(bz) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytes(bz)
+}
+
+// SetOracleLivenessSlash records the event nonce the validator was slashed for not claiming
+func (k Keeper) SetOracleLivenessSlash(ctx sdk.Context, validator sdk.ValAddress, eventNonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetOracleLivenessSlashKey(validator)), types.UInt64Bytes(eventNonce))
+}
+
+// IterateOracleLivenessSlashes iterates through the event nonce every validator was last slashed for not claiming
+func (k Keeper) IterateOracleLivenessSlashes(ctx sdk.Context, cb func(validator sdk.ValAddress, nonce uint64) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.OracleLivenessSlashKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// cb returns true to stop early
+		if cb(sdk.ValAddress(iter.Key()), types.UInt64FromBytes(iter.Value())) {
+			break
+		}
+	}
+}
+
+// GetFirstMissedClaim returns the first observed attestation the validator has not submitted a claim for,
+// skipping the attestations created before startHeight since the validator could not have claimed them.
+// Returns nil if the validator has claimed every observed event it could have
+func (k Keeper) GetFirstMissedClaim(ctx sdk.Context, validator sdk.ValAddress, startHeight int64) *types.Attestation {
+	lastObserved := k.GetLastObservedEventNonce(ctx)
+	firstMissed := k.GetLastEventNonceByValidator(ctx, validator) + 1
+	if firstMissed > lastObserved {
+		return nil
+	}
+	var missed *types.Attestation
+	k.IterateAttestationsByNonce(ctx, firstMissed, lastObserved+1, func(_ []byte, att types.Attestation) bool {
+		if att.Observed && int64(att.Height) > startHeight {
+			missed = &att
+			return true
+		}
+		return false
+	})
+	return missed
+}
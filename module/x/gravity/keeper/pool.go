@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"sort"
 	"strconv"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -28,6 +30,10 @@ func (k Keeper) AddToOutgoingPool(
 		!amount.IsValid() || !fee.IsValid() || fee.Denom != amount.Denom {
 		return 0, sdkerrors.Wrap(types.ErrInvalid, "arguments")
 	}
+	// dust transfers and transfers without a sufficient fee are never relayed profitably
+	if err := k.checkTransferMinimum(ctx, amount, fee); err != nil {
+		return 0, err
+	}
 	totalAmount := amount.Add(fee)
 	totalInVouchers := sdk.Coins{totalAmount}
 
@@ -71,14 +77,12 @@ func (k Keeper) AddToOutgoingPool(
 		panic(sdkerrors.Wrap(err, "unable to create InternalOutgoingTransferTx"))
 	}
 
-	// add a second index with the fee
+	// add a second index with the fee, this also indexes the tx by id, sender and receiver
 	err = k.addUnbatchedTX(ctx, outgoing)
 	if err != nil {
 		panic(err)
 	}
-
-	// todo: add second index for sender so that we can easily query: give pending Tx by sender
-	// todo: what about a second index for receiver?
+	k.updateTransferStatus(ctx, outgoing, types.TRANSFER_STATE_POOLED, 0)
 
 	poolEvent := sdk.NewEvent(
 		types.EventTypeBridgeWithdrawalReceived,
@@ -129,6 +133,9 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, se
 	if oldTx != nil || oldTxErr == nil {
 		return sdkerrors.Wrapf(types.ErrInvalid, "tx with id %d was not fully removed from the pool, a duplicate must exist", txId)
 	}
+	// the tx is no longer pending
+	k.deletePendingTransferIndexes(ctx, tx)
+	k.updateTransferStatus(ctx, tx, types.TRANSFER_STATE_CANCELLED, 0)
 
 	// Calculate refund
 	totalToRefund := tx.Erc20Token.GravityCoin()
@@ -168,10 +175,12 @@ func (k Keeper) addUnbatchedTX(ctx sdk.Context, val *types.InternalOutgoingTrans
 	}
 
 	store.Set(idxKey, bz)
+	k.setPendingTransferIndexes(ctx, val, idxKey)
 	return err
 }
 
-// removeUnbatchedTXIndex removes the tx from the pool
+// removeUnbatchedTXIndex removes the tx from the pool, the sender and receiver indexes are kept
+// since the tx is either moving into a batch or deletePendingTransferIndexes is called after
 // WARNING: Do not make this function public
 func (k Keeper) removeUnbatchedTX(ctx sdk.Context, fee types.InternalERC20Token, txID uint64) error {
 	store := ctx.KVStore(k.storeKey)
@@ -180,9 +189,98 @@ func (k Keeper) removeUnbatchedTX(ctx sdk.Context, fee types.InternalERC20Token,
 		return sdkerrors.Wrap(types.ErrUnknown, "pool transaction")
 	}
 	store.Delete(idxKey)
+	store.Delete([]byte(types.GetOutgoingTxByIDKey(txID)))
 	return nil
 }
 
+// setPendingTransferIndexes points the id index at the store key of the pool entry or batch
+// holding the tx, and adds the tx to the sender and receiver indexes
+// WARNING: Do not make this function public
+func (k Keeper) setPendingTransferIndexes(ctx sdk.Context, tx *types.InternalOutgoingTransferTx, location []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetOutgoingTxByIDKey(tx.Id)), location)
+	store.Set([]byte(types.GetOutgoingTxBySenderKey(tx.Sender, tx.Id)), types.UInt64Bytes(tx.Id))
+	store.Set([]byte(types.GetOutgoingTxByDestinationKey(*tx.DestAddress, tx.Id)), types.UInt64Bytes(tx.Id))
+}
+
+// deletePendingTransferIndexes removes a tx that has been executed or refunded from all indexes
+// WARNING: Do not make this function public
+func (k Keeper) deletePendingTransferIndexes(ctx sdk.Context, tx *types.InternalOutgoingTransferTx) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(types.GetOutgoingTxByIDKey(tx.Id)))
+	store.Delete([]byte(types.GetOutgoingTxBySenderKey(tx.Sender, tx.Id)))
+	store.Delete([]byte(types.GetOutgoingTxByDestinationKey(*tx.DestAddress, tx.Id)))
+}
+
+// GetPendingTransferById returns a tx which has not been executed on Ethereum yet, along with the
+// nonce of the batch it is in, the batch nonce is zero while the tx is waiting in the pool
+func (k Keeper) GetPendingTransferById(ctx sdk.Context, txID uint64) (tx *types.InternalOutgoingTransferTx, batchNonce uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	location := store.Get([]byte(types.GetOutgoingTxByIDKey(txID)))
+	if location == nil {
+		return nil, 0, false
+	}
+	bz := store.Get(location)
+	if bz == nil {
+		panic(fmt.Sprintf("pending transfer index for id %d points at missing key %v", txID, location))
+	}
+
+	// the tx is waiting in the pool
+	if bytes.HasPrefix(location, []byte(types.OutgoingTXPoolKey)) {
+		var r types.OutgoingTransferTx
+		k.cdc.MustUnmarshal(bz, &r)
+		intR, err := r.ToInternal()
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid unbatched tx in store: %v", r))
+		}
+		return intR, 0, true
+	}
+
+	// the tx is in a batch
+	var batch types.OutgoingTxBatch
+	k.cdc.MustUnmarshal(bz, &batch)
+	for _, batchTx := range batch.Transactions {
+		if batchTx.Id != txID {
+			continue
+		}
+		batchTx.Erc20Token.Contract = batch.TokenContract
+		batchTx.Erc20Fee.Contract = batch.TokenContract
+		intTx, err := batchTx.ToInternal()
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid batched tx in store: %v", batchTx))
+		}
+		return intTx, batch.BatchNonce, true
+	}
+	panic(fmt.Sprintf("pending transfer index for id %d points at batch %d which does not contain it", txID, batch.BatchNonce))
+}
+
+// IteratePendingTransfersBySender iterates through the pending txs of a sender in ascending id order
+func (k Keeper) IteratePendingTransfersBySender(ctx sdk.Context, sender sdk.AccAddress, cb func(tx *types.InternalOutgoingTransferTx, batchNonce uint64) bool) {
+	k.iteratePendingTransferIndex(ctx, []byte(types.GetOutgoingTxBySenderPrefix(sender)), cb)
+}
+
+// IteratePendingTransfersByDestination iterates through the pending txs to an ethereum address in ascending id order
+func (k Keeper) IteratePendingTransfersByDestination(ctx sdk.Context, destination types.EthAddress, cb func(tx *types.InternalOutgoingTransferTx, batchNonce uint64) bool) {
+	k.iteratePendingTransferIndex(ctx, []byte(types.GetOutgoingTxByDestinationPrefix(destination)), cb)
+}
+
+func (k Keeper) iteratePendingTransferIndex(ctx sdk.Context, prefixKey []byte, cb func(tx *types.InternalOutgoingTransferTx, batchNonce uint64) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		txID := types.UInt64FromBytes(iter.Value())
+		tx, batchNonce, found := k.GetPendingTransferById(ctx, txID)
+		if !found {
+			panic(fmt.Sprintf("pending transfer index contains unknown id %d", txID))
+		}
+		// cb returns true to stop early
+		if cb(tx, batchNonce) {
+			break
+		}
+	}
+}
+
 // GetUnbatchedTxByFeeAndId grabs a tx from the pool given its fee and txID
 func (k Keeper) GetUnbatchedTxByFeeAndId(ctx sdk.Context, fee types.InternalERC20Token, txID uint64) (*types.InternalOutgoingTransferTx, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -200,18 +298,9 @@ func (k Keeper) GetUnbatchedTxByFeeAndId(ctx sdk.Context, fee types.InternalERC2
 }
 
 // GetUnbatchedTxById grabs a tx from the pool given only the txID
-// note that due to the way unbatched txs are indexed, the GetUnbatchedTxByFeeAndId method is much faster
 func (k Keeper) GetUnbatchedTxById(ctx sdk.Context, txID uint64) (*types.InternalOutgoingTransferTx, error) {
-	var r *types.InternalOutgoingTransferTx = nil
-	k.IterateUnbatchedTransactions(ctx, []byte(types.OutgoingTXPoolKey), func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
-		if tx.Id == txID {
-			r = tx
-			return true
-		}
-		return false // iterating DESC, exit early
-	})
-
-	if r == nil {
+	r, batchNonce, found := k.GetPendingTransferById(ctx, txID)
+	if !found || batchNonce != 0 {
 		// We have no return tx, it was either batched or never existed
 		return nil, sdkerrors.Wrap(types.ErrUnknown, "pool transaction")
 	}
@@ -263,30 +352,89 @@ func (k Keeper) IterateUnbatchedTransactions(ctx sdk.Context, prefixKey []byte,
 	}
 }
 
+// iteratePooledTokens iterates through the tokens which have transactions waiting in the pool in ASC order, only the
+// first pool entry of every token is read since the iteration seeks past the rest of the pool of the token
+func (k Keeper) iteratePooledTokens(ctx sdk.Context, cb func(token types.EthAddress) bool) {
+	store := ctx.KVStore(k.storeKey)
+	start, end := prefixRange([]byte(types.OutgoingTXPoolKey))
+	for start != nil {
+		iter := store.Iterator(start, end)
+		if !iter.Valid() {
+			iter.Close()
+			return
+		}
+		token := poolKeyToken(iter.Key())
+		iter.Close()
+		// cb returns true to stop early
+		if cb(token) {
+			return
+		}
+		_, start = prefixRange([]byte(types.GetOutgoingTxPoolContractPrefix(token)))
+	}
+}
+
+// GetAgedUnbatchedTokens returns the tokens with a transaction which has waited in the pool for more than maxAge
+// blocks. Pending transfers are read in ASC id order, the order they were sent in, so the scan stops at the first
+// pooled transfer which has not waited long enough and only passes over the transfers of unexecuted batches before it.
+// A pooled transfer without a status was sent before statuses were tracked, so it is always aged
+func (k Keeper) GetAgedUnbatchedTokens(ctx sdk.Context, maxAge uint64) (tokens []types.EthAddress) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.OutgoingTxByIDKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	height := uint64(ctx.BlockHeight())
+	seen := make(map[string]bool)
+	for ; iter.Valid(); iter.Next() {
+		location := iter.Value()
+		if !bytes.HasPrefix(location, []byte(types.OutgoingTXPoolKey)) {
+			continue
+		}
+		status := k.GetTransferStatus(ctx, types.UInt64FromBytes(iter.Key()))
+		if status != nil && height <= status.CreatedHeight+maxAge {
+			break
+		}
+		token := poolKeyToken(location)
+		if !seen[token.GetAddress()] {
+			seen[token.GetAddress()] = true
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// poolKeyToken returns the fee contract of a pool entry from its store key, see GetOutgoingTxPoolKey
+func poolKeyToken(key []byte) types.EthAddress {
+	contractStart := len(types.OutgoingTXPoolKey)
+	if len(key) < contractStart+types.ETHContractAddressLen {
+		panic(fmt.Sprintf("invalid pool key %v", key))
+	}
+	token, err := types.NewEthAddress(string(key[contractStart : contractStart+types.ETHContractAddressLen]))
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid token in pool key %v", key))
+	}
+	return *token
+}
+
 // GetBatchFeeByTokenType gets the fee the next batch of a given token type would
-// have if created right now. This info is both presented to relayers for the purpose of determining
-// when to request batches and also used by the batch creation process to decide not to create
-// a new batch (fees must be increasing)
+// have if created right now, with the transactions chosen by the batch selection policy. This info is both
+// presented to relayers for the purpose of determining when to request batches and also used by the batch
+// creation process to decide not to create a new batch (fees must be increasing)
 func (k Keeper) GetBatchFeeByTokenType(ctx sdk.Context, tokenContractAddr types.EthAddress, maxElements uint) *types.BatchFees {
 	batchFee := types.BatchFees{Token: tokenContractAddr.GetAddress(), TotalFees: sdk.NewInt(0)}
-	txCount := 0
 
-	k.IterateUnbatchedTransactions(ctx, []byte(types.GetOutgoingTxPoolContractPrefix(tokenContractAddr)), func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+	for _, tx := range k.selectUnbatchedTX(ctx, tokenContractAddr, maxElements) {
 		fee := tx.Erc20Fee
 		if fee.Contract.GetAddress() != tokenContractAddr.GetAddress() {
 			panic(fmt.Errorf("unexpected fee contract %s when getting batch fees for contract %s", fee.Contract, tokenContractAddr))
 		}
 		batchFee.TotalFees = batchFee.TotalFees.Add(fee.Amount)
-		txCount += 1
-		return txCount == int(maxElements)
-	})
+	}
 	return &batchFee
 }
 
-// GetAllBatchFees creates a fee entry for every batch type currently in the store
-// this can be used by relayers to determine what batch types are desireable to request
-func (k Keeper) GetAllBatchFees(ctx sdk.Context, maxElements uint) (batchFees []types.BatchFees) {
-	batchFeesMap := k.createBatchFees(ctx, maxElements)
+// GetAllBatchFees creates a fee entry for every batch type currently in the store, each batch holds at most the
+// batch size limit of its token. This can be used by relayers to determine what batch types are desireable to request
+func (k Keeper) GetAllBatchFees(ctx sdk.Context) (batchFees []types.BatchFees) {
+	batchFeesMap := k.createBatchFees(ctx)
 	// create array of batchFees
 	for _, batchFee := range batchFeesMap {
 		batchFees = append(batchFees, batchFee)
@@ -302,15 +450,14 @@ func (k Keeper) GetAllBatchFees(ctx sdk.Context, maxElements uint) (batchFees []
 }
 
 // createBatchFees iterates over the unbatched transaction pool and creates batch token fee map
-// Implicitly creates batches with the highest potential fee because the transaction keys enforce an order which goes
-// fee contract address -> fee amount -> transaction nonce
-func (k Keeper) createBatchFees(ctx sdk.Context, maxElements uint) map[string]types.BatchFees {
+// with the fees of the batch each token would have under the batch selection policy
+func (k Keeper) createBatchFees(ctx sdk.Context) map[string]types.BatchFees {
 	batchFeesMap := make(map[string]types.BatchFees)
-	txCountMap := make(map[string]int)
 
 	k.IterateUnbatchedTransactions(ctx, []byte(types.OutgoingTXPoolKey), func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
-		if txCountMap[tx.Erc20Fee.Contract.GetAddress()] < int(maxElements) {
-			addFeeToMap(tx.Erc20Fee, batchFeesMap, txCountMap)
+		token := tx.Erc20Fee.Contract
+		if _, ok := batchFeesMap[token.GetAddress()]; !ok {
+			batchFeesMap[token.GetAddress()] = *k.GetBatchFeeByTokenType(ctx, token, k.GetBatchSizeLimit(ctx, token))
 		}
 		return false
 	})
@@ -318,23 +465,6 @@ func (k Keeper) createBatchFees(ctx sdk.Context, maxElements uint) map[string]ty
 	return batchFeesMap
 }
 
-// Helper method for creating batch fees
-func addFeeToMap(fee *types.InternalERC20Token, batchFeesMap map[string]types.BatchFees, txCountMap map[string]int) {
-	feeAddrStr := fee.Contract.GetAddress()
-	txCountMap[feeAddrStr] = txCountMap[feeAddrStr] + 1
-
-	// add fee amount
-	if _, ok := batchFeesMap[feeAddrStr]; ok {
-		val := batchFeesMap[feeAddrStr]
-		val.TotalFees = batchFeesMap[feeAddrStr].TotalFees.Add(fee.Amount)
-		batchFeesMap[feeAddrStr] = val
-	} else {
-		batchFeesMap[feeAddrStr] = types.BatchFees{
-			Token:     feeAddrStr,
-			TotalFees: fee.Amount}
-	}
-}
-
 func (k Keeper) autoIncrementID(ctx sdk.Context, idKey []byte) uint64 {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(idKey)
@@ -346,3 +476,17 @@ func (k Keeper) autoIncrementID(ctx sdk.Context, idKey []byte) uint64 {
 	store.Set(idKey, bz)
 	return id
 }
+
+// getLastID returns the last id handed out by autoIncrementID for the given key
+func (k Keeper) getLastID(ctx sdk.Context, idKey []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(idKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz) - 1
+}
+
+// setLastID sets the last id handed out by autoIncrementID for the given key
+func (k Keeper) setLastID(ctx sdk.Context, idKey []byte, id uint64) {
+	ctx.KVStore(k.storeKey).Set(idKey, sdk.Uint64ToBigEndian(id+1))
+}
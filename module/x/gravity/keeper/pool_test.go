@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -249,7 +250,7 @@ func TestTotalBatchFeeInPool(t *testing.T) {
 		t.Logf("___ response: %#v", r)
 	}
 
-	batchFees := input.GravityKeeper.GetAllBatchFees(ctx, OutgoingTxBatchSize)
+	batchFees := input.GravityKeeper.GetAllBatchFees(ctx)
 	/*
 		tokenFeeMap should be
 		map[0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5:8 0x7D1AfA7B718fb893dB30A3aBc0Cfc608AaCfeBB0:500]
@@ -893,3 +894,290 @@ func TestAddToOutgoingPoolExportGenesis(t *testing.T) {
 		require.True(t, v)
 	}
 }
+
+// Tests that pending transfers are indexed by id, sender and destination while they move through the pool and batches
+func TestPendingTransferIndexes(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		otherSender, _      = sdk.AccAddressFromBech32("gravity1dg55rtevlfxh46w88yjpdd08sqhh5cc3z8yqu6")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	myReceiver, err := types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	require.NoError(t, err)
+	otherReceiver, err := types.NewEthAddress("0x2a24af0501a534fca004ee1bd667b783f205a546")
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+
+	// mint some voucher first and fund both senders
+	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	for _, sender := range []sdk.AccAddress{mySender, otherSender} {
+		err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
+		require.NoError(t, err)
+		input.AccountKeeper.NewAccountWithAddress(ctx, sender)
+		err = input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, allVouchers)
+		require.NoError(t, err)
+	}
+
+	// when
+	// 1: mySender -> myReceiver, fee 3
+	// 2: otherSender -> myReceiver, fee 1
+	// 3: mySender -> otherReceiver, fee 2
+	// 4: mySender -> myReceiver, fee 1
+	senders := []sdk.AccAddress{mySender, otherSender, mySender, mySender}
+	receivers := []*types.EthAddress{myReceiver, myReceiver, otherReceiver, myReceiver}
+	ids := make([]uint64, 4)
+	for i, v := range []uint64{3, 1, 2, 1} {
+		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
+		require.NoError(t, err)
+		ids[i], err = k.AddToOutgoingPool(ctx, senders[i], *receivers[i], amountToken.GravityCoin(), feeToken.GravityCoin())
+		require.NoError(t, err)
+	}
+
+	// then all txs are found by id in the pool
+	for _, id := range ids {
+		tx, batchNonce, found := k.GetPendingTransferById(ctx, id)
+		require.True(t, found)
+		assert.Equal(t, id, tx.Id)
+		assert.Equal(t, uint64(0), batchNonce)
+	}
+	_, _, found := k.GetPendingTransferById(ctx, 100)
+	assert.False(t, found)
+
+	// the two highest fee txs are moved into a batch
+	batch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+	tx, batchNonce, found := k.GetPendingTransferById(ctx, ids[0])
+	require.True(t, found)
+	assert.Equal(t, batch.BatchNonce, batchNonce)
+	assert.Equal(t, mySender, tx.Sender)
+	assert.Equal(t, *tokenContract, tx.Erc20Token.Contract)
+	_, err = k.GetUnbatchedTxById(ctx, ids[0])
+	assert.Error(t, err)
+	_, err = k.GetUnbatchedTxById(ctx, ids[1])
+	assert.NoError(t, err)
+
+	// the sender index is paginated in id order
+	context := sdk.WrapSDKContext(ctx)
+	res, err := k.GetTransfersBySender(context, &types.QueryTransfersBySenderRequest{
+		SenderAddress: mySender.String(),
+		Pagination:    &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Transfers, 2)
+	assert.Equal(t, ids[0], res.Transfers[0].Transfer.Id)
+	assert.Equal(t, batch.BatchNonce, res.Transfers[0].BatchNonce)
+	assert.Equal(t, ids[2], res.Transfers[1].Transfer.Id)
+	assert.Equal(t, batch.BatchNonce, res.Transfers[1].BatchNonce)
+	res, err = k.GetTransfersBySender(context, &types.QueryTransfersBySenderRequest{
+		SenderAddress: mySender.String(),
+		Pagination:    &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Transfers, 1)
+	assert.Equal(t, ids[3], res.Transfers[0].Transfer.Id)
+	assert.Equal(t, uint64(0), res.Transfers[0].BatchNonce)
+
+	destRes, err := k.GetTransfersByDestination(context, &types.QueryTransfersByDestinationRequest{
+		EthAddress: myReceiver.GetAddress(),
+	})
+	require.NoError(t, err)
+	require.Len(t, destRes.Transfers, 3)
+	assert.Equal(t, ids[0], destRes.Transfers[0].Transfer.Id)
+	assert.Equal(t, ids[1], destRes.Transfers[1].Transfer.Id)
+	assert.Equal(t, ids[3], destRes.Transfers[2].Transfer.Id)
+
+	// cancelling the batch points the txs back at the pool
+	err = k.CancelOutgoingTXBatch(ctx, *tokenContract, batch.BatchNonce)
+	require.NoError(t, err)
+	_, batchNonce, found = k.GetPendingTransferById(ctx, ids[0])
+	require.True(t, found)
+	assert.Equal(t, uint64(0), batchNonce)
+
+	// refunded and executed txs are removed from all indexes
+	err = k.RemoveFromOutgoingPoolAndRefund(ctx, ids[1], otherSender)
+	require.NoError(t, err)
+	_, _, found = k.GetPendingTransferById(ctx, ids[1])
+	assert.False(t, found)
+	destRes, err = k.GetTransfersByDestination(context, &types.QueryTransfersByDestinationRequest{
+		EthAddress: myReceiver.GetAddress(),
+	})
+	require.NoError(t, err)
+	assert.Len(t, destRes.Transfers, 2)
+
+	batch, err = k.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+	k.OutgoingTxBatchExecuted(ctx, *tokenContract, batch.BatchNonce)
+	for _, batchTx := range batch.Transactions {
+		_, _, found = k.GetPendingTransferById(ctx, batchTx.Id)
+		assert.False(t, found)
+	}
+	res, err = k.GetTransfersBySender(context, &types.QueryTransfersBySenderRequest{SenderAddress: mySender.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Transfers, 1)
+	assert.Equal(t, ids[3], res.Transfers[0].Transfer.Id)
+	res, err = k.GetTransfersBySender(context, &types.QueryTransfersBySenderRequest{SenderAddress: otherSender.String()})
+	require.NoError(t, err)
+	assert.Empty(t, res.Transfers)
+}
+
+// Tests that the status of a transfer follows it through the pool and batches and is pruned after the retention period
+func TestTransferStatusLifecycle(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		mySender, _         = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	receiver, err := types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	// mint some voucher first
+	allVouchersToken, err := types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr)
+	require.NoError(t, err)
+	allVouchers := sdk.Coins{allVouchersToken.GravityCoin()}
+	err = input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers)
+	require.NoError(t, err)
+
+	// set senders balance
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	err = input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers)
+	require.NoError(t, err)
+
+	// when
+	ctx = ctx.WithBlockHeight(100)
+	ids := make([]uint64, 3)
+	for i, v := range []uint64{3, 2, 1} {
+		amountToken, err := types.NewInternalERC20Token(sdk.NewInt(int64(i+100)), myTokenContractAddr)
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(v), myTokenContractAddr)
+		require.NoError(t, err)
+		ids[i], err = k.AddToOutgoingPool(ctx, mySender, *receiver, amountToken.GravityCoin(), feeToken.GravityCoin())
+		require.NoError(t, err)
+	}
+
+	// then
+	status := k.GetTransferStatus(ctx, ids[0])
+	require.NotNil(t, status)
+	assert.Equal(t, types.TransferStatus{
+		Id:            ids[0],
+		State:         types.TRANSFER_STATE_POOLED,
+		Sender:        mySender.String(),
+		DestAddress:   receiver.GetAddress(),
+		TokenContract: tokenContract.GetAddress(),
+		BatchNonce:    0,
+		TimesRequeued: 0,
+		CreatedHeight: 100,
+		UpdatedHeight: 100,
+	}, *status)
+	assert.Nil(t, k.GetTransferStatus(ctx, 100))
+
+	// the batch is built, cancelled and built again
+	ctx = ctx.WithBlockHeight(101)
+	firstBatch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+	status = k.GetTransferStatus(ctx, ids[0])
+	assert.Equal(t, types.TRANSFER_STATE_BATCHED, status.State)
+	assert.Equal(t, firstBatch.BatchNonce, status.BatchNonce)
+	assert.Equal(t, uint64(101), status.UpdatedHeight)
+
+	ctx = ctx.WithBlockHeight(102)
+	require.NoError(t, k.CancelOutgoingTXBatch(ctx, *tokenContract, firstBatch.BatchNonce))
+	status = k.GetTransferStatus(ctx, ids[0])
+	assert.Equal(t, types.TRANSFER_STATE_POOLED, status.State)
+	assert.Equal(t, firstBatch.BatchNonce, status.BatchNonce)
+	assert.Equal(t, uint64(1), status.TimesRequeued)
+
+	secondBatch, err := k.BuildOutgoingTXBatch(ctx, *tokenContract, 2)
+	require.NoError(t, err)
+
+	// one transfer is executed and the other refunded
+	ctx = ctx.WithBlockHeight(103)
+	k.OutgoingTxBatchExecuted(ctx, *tokenContract, secondBatch.BatchNonce)
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, ids[2], mySender))
+
+	res, err := k.TransferStatus(sdk.WrapSDKContext(ctx), &types.QueryTransferStatusRequest{TxId: ids[0]})
+	require.NoError(t, err)
+	assert.Equal(t, types.TRANSFER_STATE_EXECUTED, res.Status.State)
+	assert.Equal(t, secondBatch.BatchNonce, res.Status.BatchNonce)
+	assert.Equal(t, uint64(1), res.Status.TimesRequeued)
+	assert.Equal(t, uint64(100), res.Status.CreatedHeight)
+	assert.Equal(t, uint64(103), res.Status.UpdatedHeight)
+	status = k.GetTransferStatus(ctx, ids[2])
+	assert.Equal(t, types.TRANSFER_STATE_CANCELLED, status.State)
+	_, err = k.TransferStatus(sdk.WrapSDKContext(ctx), &types.QueryTransferStatusRequest{TxId: 100})
+	assert.Error(t, err)
+
+	// statuses are kept until the retention period has passed
+	k.PruneTransferStatuses(ctx.WithBlockHeight(113), 10)
+	assert.NotNil(t, k.GetTransferStatus(ctx, ids[0]))
+	k.PruneTransferStatuses(ctx.WithBlockHeight(114), 0)
+	assert.NotNil(t, k.GetTransferStatus(ctx, ids[0]))
+	k.PruneTransferStatuses(ctx.WithBlockHeight(114), 10)
+	assert.Nil(t, k.GetTransferStatus(ctx, ids[0]))
+	assert.Nil(t, k.GetTransferStatus(ctx, ids[1]))
+	assert.Nil(t, k.GetTransferStatus(ctx, ids[2]))
+	assert.Empty(t, k.GetTransferStatuses(ctx))
+}
+
+// Tests that the tokens of the pool are found by seeking past the pool of every token, and that the aged tokens
+// are found in the order their transfers were sent in
+func TestPooledAndAgedTokens(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+	k := input.GravityKeeper
+	mySender, _ := sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+	receiver, err := types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	require.NoError(t, err)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	var tokens []types.EthAddress
+	for _, addr := range []string{"0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5", "0x2a24af0501a534fca004ee1bd667b783f205a546"} {
+		token, err := types.NewEthAddress(addr)
+		require.NoError(t, err)
+		tokens = append(tokens, *token)
+		vouchers, err := types.NewInternalERC20Token(sdk.NewInt(99999), addr)
+		require.NoError(t, err)
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.Coins{vouchers.GravityCoin()}))
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, sdk.Coins{vouchers.GravityCoin()}))
+	}
+	addToPool := func(height int64, token types.EthAddress) {
+		amount, err := types.NewInternalERC20Token(sdk.NewInt(100), token.GetAddress())
+		require.NoError(t, err)
+		fee, err := types.NewInternalERC20Token(sdk.NewInt(1), token.GetAddress())
+		require.NoError(t, err)
+		_, err = k.AddToOutgoingPool(ctx.WithBlockHeight(height), mySender, *receiver, amount.GravityCoin(), fee.GravityCoin())
+		require.NoError(t, err)
+	}
+	addToPool(10, tokens[0])
+	addToPool(20, tokens[1])
+	addToPool(30, tokens[0])
+
+	var pooled []types.EthAddress
+	k.iteratePooledTokens(ctx, func(token types.EthAddress) bool {
+		pooled = append(pooled, token)
+		return false
+	})
+	assert.Equal(t, []types.EthAddress{tokens[1], tokens[0]}, pooled)
+
+	assert.Empty(t, k.GetAgedUnbatchedTokens(ctx.WithBlockHeight(25), 15))
+	assert.Equal(t, []types.EthAddress{tokens[0]}, k.GetAgedUnbatchedTokens(ctx.WithBlockHeight(31), 15))
+	assert.Equal(t, []types.EthAddress{tokens[0], tokens[1]}, k.GetAgedUnbatchedTokens(ctx.WithBlockHeight(40), 15))
+
+	// batched transfers are no longer waiting in the pool
+	_, err = k.BuildOutgoingTXBatch(ctx.WithBlockHeight(40), tokens[0], 2)
+	require.NoError(t, err)
+	assert.Equal(t, []types.EthAddress{tokens[1]}, k.GetAgedUnbatchedTokens(ctx.WithBlockHeight(60), 15))
+
+	// a transfer without a status was sent before statuses were tracked and is aged from the start
+	ctx.KVStore(k.storeKey).Delete([]byte(types.GetTransferStatusKey(2)))
+	assert.Equal(t, []types.EthAddress{tokens[1]}, k.GetAgedUnbatchedTokens(ctx.WithBlockHeight(21), 15))
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// Confirms are only useful while their subject exists, they are pruned whenever a valset, batch or
+// logic call is deleted. Valsets are only deleted once their slashing window has passed, batches and
+// logic calls once they are executed or cancelled, after which they can not be slashed for either.
+// Every pruning reports the number of confirms removed under the gravity pruned_confirms metric
+
+// pruneValsetConfirms deletes all the confirms for the valset with the given nonce
+// WARNING: Do not make this function public
+func (k Keeper) pruneValsetConfirms(ctx sdk.Context, nonce uint64) {
+	pruned := k.deletePrefix(ctx, []byte(types.GetValsetConfirmNoncePrefix(nonce)))
+	incrPrunedConfirms("valset", pruned)
+}
+
+// pruneBatchConfirms deletes all the confirms for the given batch
+// WARNING: Do not make this function public
+func (k Keeper) pruneBatchConfirms(ctx sdk.Context, tokenContract types.EthAddress, nonce uint64) {
+	pruned := k.deletePrefix(ctx, []byte(types.GetBatchConfirmNoncePrefix(tokenContract, nonce)))
+	incrPrunedConfirms("batch", pruned)
+}
+
+// pruneLogicCallConfirms deletes all the confirms for the given logic call
+// WARNING: Do not make this function public
+func (k Keeper) pruneLogicCallConfirms(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
+	pruned := 0
+	for _, confirm := range k.GetLogicConfirmByInvalidationIDAndNonce(ctx, invalidationID, invalidationNonce) {
+		// the confirm prefix does not delimit the invalidation id, skip confirms belonging to another call
+		if confirm.InvalidationId != hex.EncodeToString(invalidationID) || confirm.InvalidationNonce != invalidationNonce {
+			continue
+		}
+		orchestrator, err := sdk.AccAddressFromBech32(confirm.Orchestrator)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "invalid orchestrator in logic call confirm"))
+		}
+		k.DeleteLogicCallConfirm(ctx, invalidationID, invalidationNonce, orchestrator)
+		pruned++
+	}
+	incrPrunedConfirms("logic_call", pruned)
+}
+
+// deletePrefix deletes every key under prefixKey and returns the number of keys deleted
+func (k Keeper) deletePrefix(ctx sdk.Context, prefixKey []byte) int {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+	iter := prefixStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+	return len(keys)
+}
+
+func incrPrunedConfirms(subject string, pruned int) {
+	if pruned == 0 {
+		return
+	}
+	telemetry.IncrCounter(float32(pruned), types.ModuleName, "pruned_confirms", subject)
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// RecordSlash stores the historical record of a gravity specific slash of validator, jailed is true
+// when the slash also jailed the validator
+func (k Keeper) RecordSlash(
+	ctx sdk.Context,
+	validator sdk.ValAddress,
+	reason types.SlashReason,
+	subjectNonce uint64,
+	subjectToken string,
+	fraction sdk.Dec,
+	jailed bool,
+) types.SlashRecord {
+	record := types.SlashRecord{
+		Id:           k.autoIncrementID(ctx, []byte(types.KeyLastSlashRecordID)),
+		Validator:    validator.String(),
+		Reason:       reason,
+		SubjectNonce: subjectNonce,
+		SubjectToken: subjectToken,
+		BlockHeight:  uint64(ctx.BlockHeight()),
+		Fraction:     fraction,
+		Jailed:       jailed,
+	}
+	k.SetSlashRecord(ctx, record)
+	return record
+}
+
+// SetSlashRecord stores a slash record and indexes it by validator and by reason
+func (k Keeper) SetSlashRecord(ctx sdk.Context, record types.SlashRecord) {
+	val, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid validator in slash record %d: %s", record.Id, record.Validator))
+	}
+	store := ctx.KVStore(k.storeKey)
+	id := types.UInt64Bytes(record.Id)
+	store.Set([]byte(types.GetSlashRecordKey(record.Id)), k.cdc.MustMarshal(&record))
+	store.Set([]byte(types.GetSlashRecordByValidatorKey(val, record.Id)), id)
+	store.Set([]byte(types.GetSlashRecordByReasonKey(record.Reason, record.Id)), id)
+}
+
+// GetSlashRecord returns the slash record with the given id
+func (k Keeper) GetSlashRecord(ctx sdk.Context, id uint64) *types.SlashRecord {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetSlashRecordKey(id)))
+	if bz == nil {
+		return nil
+	}
+	var record types.SlashRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record
+}
+
+// IterateSlashRecords iterates through all slash records in the order the slashes happened
+func (k Keeper) IterateSlashRecords(ctx sdk.Context, cb func([]byte, types.SlashRecord) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.SlashRecordKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.SlashRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		// cb returns true to stop early
+		if cb(iter.Key(), record) {
+			break
+		}
+	}
+}
+
+// GetSlashRecords returns all the slash records in state
+func (k Keeper) GetSlashRecords(ctx sdk.Context) (out []types.SlashRecord) {
+	k.IterateSlashRecords(ctx, func(_ []byte, record types.SlashRecord) bool {
+		out = append(out, record)
+		return false
+	})
+	return
+}
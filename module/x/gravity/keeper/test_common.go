@@ -213,25 +213,45 @@ var (
 
 	// TestingGravityParams is a set of gravity params for testing
 	TestingGravityParams = types.Params{
-		GravityId:                    "testgravityid",
-		ContractSourceHash:           "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
-		BridgeEthereumAddress:        "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
-		BridgeChainId:                11,
-		SignedValsetsWindow:          10,
-		SignedBatchesWindow:          10,
-		SignedLogicCallsWindow:       10,
-		TargetBatchTimeout:           60001,
-		AverageBlockTime:             5000,
-		AverageEthereumBlockTime:     15000,
-		SlashFractionValset:          sdk.NewDecWithPrec(1, 2),
-		SlashFractionBatch:           sdk.NewDecWithPrec(1, 2),
-		SlashFractionLogicCall:       sdk.Dec{},
-		UnbondSlashingValsetsWindow:  15,
-		SlashFractionBadEthSignature: sdk.NewDecWithPrec(1, 2),
-		ValsetReward:                 sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
-		ResetBridgeState:             false,
-		ResetBridgeNonce:             0,
-		BridgeActive:                 true,
+		GravityId:                          "testgravityid",
+		ContractSourceHash:                 "62328f7bc12efb28f86111d08c29b39285680a906ea0e524e0209d6f6657b713",
+		BridgeEthereumAddress:              "0x8858eeb3dfffa017d4bce9801d340d36cf895ccf",
+		BridgeChainId:                      11,
+		SignedValsetsWindow:                10,
+		SignedBatchesWindow:                10,
+		SignedLogicCallsWindow:             10,
+		TargetBatchTimeout:                 60001,
+		AverageBlockTime:                   5000,
+		AverageEthereumBlockTime:           15000,
+		SlashFractionValset:                sdk.NewDecWithPrec(1, 2),
+		SlashFractionBatch:                 sdk.NewDecWithPrec(1, 2),
+		SlashFractionLogicCall:             sdk.Dec{},
+		UnbondSlashingValsetsWindow:        15,
+		SlashFractionBadEthSignature:       sdk.NewDecWithPrec(1, 2),
+		ValsetReward:                       sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		ResetBridgeState:                   false,
+		ResetBridgeNonce:                   0,
+		BridgeActive:                       true,
+		TransferStatusRetentionBlocks:      10,
+		AttestationPowerPolicy:             types.ATTESTATION_POWER_POLICY_SNAPSHOT,
+		AttestationThresholds:              types.DefaultAttestationThresholds(),
+		OracleLivenessWindow:               10,
+		SlashFractionOracleLiveness:        sdk.NewDecWithPrec(1, 2),
+		SlashFractionConflictingClaim:      sdk.NewDecWithPrec(1, 2),
+		ConfirmSigningWindow:               0,
+		MinSignedPerWindow:                 sdk.NewDecWithPrec(5, 1),
+		BadSignatureEvidenceBountyFraction: sdk.NewDecWithPrec(1, 1),
+		BadSignatureEvidenceBounty:         sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		EthereumHeightHistoryBlocks:        17280,
+		MinAverageBlockTime:                1000,
+		MaxAverageBlockTime:                30000,
+		MinAverageEthereumBlockTime:        5000,
+		MaxAverageEthereumBlockTime:        30000,
+		BatchSizeLimits:                    []types.BatchSizeLimit{},
+		BatchSelectionPolicy:               types.BATCH_SELECTION_POLICY_FEE_DESC,
+		BatchAgeWeight:                     sdk.NewDecWithPrec(1, 3),
+		AutoBatchFeeThresholds:             []types.AutoBatchFeeThreshold{},
+		AutoBatchMaxTxAge:                  0,
 	}
 )
 
@@ -454,9 +474,19 @@ func CreateTestEnv(t *testing.T) TestInput {
 
 	// Load default wasm config
 
+	slashingKeeper := slashingkeeper.NewKeeper(
+		marshaler,
+		keySlashing,
+		&stakingKeeper,
+		getSubspace(paramsKeeper, slashingtypes.ModuleName).WithKeyTable(slashingtypes.ParamKeyTable()),
+	)
+
+	k := NewKeeper(marshaler, gravityKey, getSubspace(paramsKeeper, types.DefaultParamspace), stakingKeeper, bankKeeper, distKeeper, slashingKeeper, accountKeeper)
+
 	govRouter := govtypes.NewRouter().
 		AddRoute(paramsproposal.RouterKey, params.NewParamChangeProposalHandler(paramsKeeper)).
-		AddRoute(govtypes.RouterKey, govtypes.ProposalHandler)
+		AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
+		AddRoute(types.RouterKey, NewGravityProposalHandler(k))
 
 	govKeeper := govkeeper.NewKeeper(
 		marshaler, keyGov, getSubspace(paramsKeeper, govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable()), accountKeeper, bankKeeper, stakingKeeper, govRouter,
@@ -467,15 +497,6 @@ func CreateTestEnv(t *testing.T) TestInput {
 	govKeeper.SetVotingParams(ctx, govtypes.DefaultVotingParams())
 	govKeeper.SetTallyParams(ctx, govtypes.DefaultTallyParams())
 
-	slashingKeeper := slashingkeeper.NewKeeper(
-		marshaler,
-		keySlashing,
-		&stakingKeeper,
-		getSubspace(paramsKeeper, slashingtypes.ModuleName).WithKeyTable(slashingtypes.ParamKeyTable()),
-	)
-
-	k := NewKeeper(marshaler, gravityKey, getSubspace(paramsKeeper, types.DefaultParamspace), stakingKeeper, bankKeeper, distKeeper, slashingKeeper, accountKeeper)
-
 	stakingKeeper = *stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
 			distKeeper.Hooks(),
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// GetTransferMinimum returns the smallest amount and bridge fee a transfer of the denom to Ethereum may carry
+func (k Keeper) GetTransferMinimum(ctx sdk.Context, denom string) (types.TransferMinimum, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetTransferMinimumKey(denom)))
+	if len(bz) == 0 {
+		return types.TransferMinimum{}, false
+	}
+	var minimum types.TransferMinimum
+	k.cdc.MustUnmarshal(bz, &minimum)
+	return minimum, true
+}
+
+// SetTransferMinimum stores the transfer minimum of a denom, a minimum with a zero amount and fee is removed
+func (k Keeper) SetTransferMinimum(ctx sdk.Context, minimum types.TransferMinimum) {
+	store := ctx.KVStore(k.storeKey)
+	key := []byte(types.GetTransferMinimumKey(minimum.Denom))
+	if minimum.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(&minimum))
+}
+
+// IterateTransferMinimums iterates through the transfer minimum of every denom
+func (k Keeper) IterateTransferMinimums(ctx sdk.Context, cb func([]byte, types.TransferMinimum) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.TransferMinimumKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var minimum types.TransferMinimum
+		k.cdc.MustUnmarshal(iter.Value(), &minimum)
+		// cb returns true to stop early
+		if cb(iter.Key(), minimum) {
+			break
+		}
+	}
+}
+
+// GetTransferMinimums returns the transfer minimum of every denom
+func (k Keeper) GetTransferMinimums(ctx sdk.Context) (out []types.TransferMinimum) {
+	k.IterateTransferMinimums(ctx, func(_ []byte, minimum types.TransferMinimum) bool {
+		out = append(out, minimum)
+		return false
+	})
+	return
+}
+
+// checkTransferMinimum returns an error if the amount or the bridge fee of a transfer to Ethereum is below the
+// transfer minimum of its denom
+func (k Keeper) checkTransferMinimum(ctx sdk.Context, amount sdk.Coin, fee sdk.Coin) error {
+	minimum, found := k.GetTransferMinimum(ctx, amount.Denom)
+	if !found {
+		return nil
+	}
+	if amount.Amount.LT(minimum.MinAmount) {
+		return sdkerrors.Wrapf(types.ErrBelowMinimum, "amount %s is below the minimum transfer amount %s%s",
+			amount, minimum.MinAmount, minimum.Denom)
+	}
+	if fee.Amount.LT(minimum.MinFee) {
+		return sdkerrors.Wrapf(types.ErrBelowMinimum, "bridge fee %s is below the minimum bridge fee %s%s",
+			fee, minimum.MinFee, minimum.Denom)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/althea-net/cosmos-gravity-bridge/module/x/gravity/types"
+)
+
+// SetTransferStatus stores the status of an outgoing transfer, executed and cancelled transfers
+// are also indexed by the height they were last updated at so that they can be pruned later
+func (k Keeper) SetTransferStatus(ctx sdk.Context, status types.TransferStatus) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetTransferStatusKey(status.Id)), k.cdc.MustMarshal(&status))
+	if isFinalTransferState(status.State) {
+		store.Set([]byte(types.GetTransferStatusPruneKey(status.UpdatedHeight, status.Id)), types.UInt64Bytes(status.Id))
+	}
+}
+
+// GetTransferStatus returns the status of an outgoing transfer, nil if the transfer never
+// existed or its status has been pruned
+func (k Keeper) GetTransferStatus(ctx sdk.Context, id uint64) *types.TransferStatus {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetTransferStatusKey(id)))
+	if bz == nil {
+		return nil
+	}
+	var status types.TransferStatus
+	k.cdc.MustUnmarshal(bz, &status)
+	return &status
+}
+
+// IterateTransferStatuses iterates through the status of every outgoing transfer in ASC id order
+func (k Keeper) IterateTransferStatuses(ctx sdk.Context, cb func([]byte, types.TransferStatus) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.TransferStatusKey))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var status types.TransferStatus
+		k.cdc.MustUnmarshal(iter.Value(), &status)
+		// cb returns true to stop early
+		if cb(iter.Key(), status) {
+			break
+		}
+	}
+}
+
+// GetTransferStatuses returns the status of every outgoing transfer in state
+func (k Keeper) GetTransferStatuses(ctx sdk.Context) (out []types.TransferStatus) {
+	k.IterateTransferStatuses(ctx, func(_ []byte, status types.TransferStatus) bool {
+		out = append(out, status)
+		return false
+	})
+	return
+}
+
+// updateTransferStatus moves an outgoing transfer into a new state, a non zero batchNonce records the batch
+// the transfer was put into or executed with. A transfer going from a batch back into the pool has been requeued
+// WARNING: Do not make this function public
+func (k Keeper) updateTransferStatus(ctx sdk.Context, tx *types.InternalOutgoingTransferTx, state types.TransferState, batchNonce uint64) {
+	height := uint64(ctx.BlockHeight())
+	status := k.GetTransferStatus(ctx, tx.Id)
+	if status == nil {
+		// transfers which entered the pool before statuses were tracked start their record here
+		status = &types.TransferStatus{
+			Id:            tx.Id,
+			State:         types.TRANSFER_STATE_UNSPECIFIED,
+			Sender:        tx.Sender.String(),
+			DestAddress:   tx.DestAddress.GetAddress(),
+			TokenContract: tx.Erc20Token.Contract.GetAddress(),
+			BatchNonce:    0,
+			TimesRequeued: 0,
+			CreatedHeight: height,
+			UpdatedHeight: height,
+		}
+	}
+	if status.State == types.TRANSFER_STATE_BATCHED && state == types.TRANSFER_STATE_POOLED {
+		status.TimesRequeued++
+	}
+	if batchNonce != 0 {
+		status.BatchNonce = batchNonce
+	}
+	status.State = state
+	status.UpdatedHeight = height
+	k.SetTransferStatus(ctx, *status)
+}
+
+// PruneTransferStatuses deletes the status of transfers which were executed or cancelled more than
+// retentionBlocks ago, a retentionBlocks of zero keeps every status
+func (k Keeper) PruneTransferStatuses(ctx sdk.Context, retentionBlocks uint64) {
+	height := uint64(ctx.BlockHeight())
+	if retentionBlocks == 0 || height <= retentionBlocks {
+		return
+	}
+	cutoff := height - retentionBlocks
+
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, []byte(types.TransferStatusPruneKey))
+	// the prune index is sorted by height, so everything updated before the cutoff has expired
+	iter := prefixStore.Iterator(nil, types.UInt64Bytes(cutoff))
+	var expiredKeys [][]byte
+	var expiredIDs []uint64
+	for ; iter.Valid(); iter.Next() {
+		expiredKeys = append(expiredKeys, iter.Key())
+		expiredIDs = append(expiredIDs, types.UInt64FromBytes(iter.Value()))
+	}
+	iter.Close()
+	for i, key := range expiredKeys {
+		prefixStore.Delete(key)
+		store.Delete([]byte(types.GetTransferStatusKey(expiredIDs[i])))
+	}
+}
+
+func isFinalTransferState(state types.TransferState) bool {
+	return state == types.TRANSFER_STATE_EXECUTED || state == types.TRANSFER_STATE_CANCELLED
+}
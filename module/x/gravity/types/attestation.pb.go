@@ -34,6 +34,7 @@ const (
 	CLAIM_TYPE_ERC20_DEPLOYED      ClaimType = 3
 	CLAIM_TYPE_LOGIC_CALL_EXECUTED ClaimType = 4
 	CLAIM_TYPE_VALSET_UPDATED      ClaimType = 5
+	CLAIM_TYPE_ETHEREUM_HEIGHT     ClaimType = 6
 )
 
 var ClaimType_name = map[int32]string{
@@ -43,6 +44,7 @@ var ClaimType_name = map[int32]string{
 	3: "CLAIM_TYPE_ERC20_DEPLOYED",
 	4: "CLAIM_TYPE_LOGIC_CALL_EXECUTED",
 	5: "CLAIM_TYPE_VALSET_UPDATED",
+	6: "CLAIM_TYPE_ETHEREUM_HEIGHT",
 }
 
 var ClaimType_value = map[string]int32{
@@ -52,6 +54,7 @@ var ClaimType_value = map[string]int32{
 	"CLAIM_TYPE_ERC20_DEPLOYED":      3,
 	"CLAIM_TYPE_LOGIC_CALL_EXECUTED": 4,
 	"CLAIM_TYPE_VALSET_UPDATED":      5,
+	"CLAIM_TYPE_ETHEREUM_HEIGHT":     6,
 }
 
 func (x ClaimType) String() string {
@@ -62,6 +65,35 @@ func (ClaimType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e3205613bbab7525, []int{0}
 }
 
+// AttestationPowerPolicy selects the voting power attestations are tallied with
+type AttestationPowerPolicy int32
+
+const (
+	// tally the current power of the voters against the current total power
+	ATTESTATION_POWER_POLICY_CURRENT AttestationPowerPolicy = 0
+	// tally each vote with the share of the total power the voter held when
+	// the vote was cast
+	ATTESTATION_POWER_POLICY_SNAPSHOT AttestationPowerPolicy = 1
+)
+
+var AttestationPowerPolicy_name = map[int32]string{
+	0: "ATTESTATION_POWER_POLICY_CURRENT",
+	1: "ATTESTATION_POWER_POLICY_SNAPSHOT",
+}
+
+var AttestationPowerPolicy_value = map[string]int32{
+	"ATTESTATION_POWER_POLICY_CURRENT":  0,
+	"ATTESTATION_POWER_POLICY_SNAPSHOT": 1,
+}
+
+func (x AttestationPowerPolicy) String() string {
+	return proto.EnumName(AttestationPowerPolicy_name, int32(x))
+}
+
+func (AttestationPowerPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{1}
+}
+
 // Attestation is an aggregate of `claims` that eventually becomes `observed` by
 // all orchestrators
 // EVENT_NONCE:
@@ -77,11 +109,15 @@ func (ClaimType) EnumDescriptor() ([]byte, []int) {
 // the key in which the attestation is stored is keyed on the exact details of the claim
 // but there is no reason to store those exact details becuause the next message sender
 // will kindly provide you with them.
+// VOTE_POWERS:
+// The power of each voter and the total power at the time they voted, these are
+// used to tally the attestation under ATTESTATION_POWER_POLICY_SNAPSHOT
 type Attestation struct {
-	Observed bool       `protobuf:"varint,1,opt,name=observed,proto3" json:"observed,omitempty"`
-	Votes    []string   `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
-	Height   uint64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	Claim    *types.Any `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim,omitempty"`
+	Observed   bool        `protobuf:"varint,1,opt,name=observed,proto3" json:"observed,omitempty"`
+	Votes      []string    `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
+	Height     uint64      `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Claim      *types.Any  `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim,omitempty"`
+	VotePowers []VotePower `protobuf:"bytes,5,rep,name=vote_powers,json=votePowers,proto3" json:"vote_powers"`
 }
 
 func (m *Attestation) Reset()         { *m = Attestation{} }
@@ -145,6 +181,155 @@ func (m *Attestation) GetClaim() *types.Any {
 	return nil
 }
 
+func (m *Attestation) GetVotePowers() []VotePower {
+	if m != nil {
+		return m.VotePowers
+	}
+	return nil
+}
+
+// VotePower records the consensus power of a validator and the total power of
+// the validator set at the time the validator voted on an attestation
+type VotePower struct {
+	Validator  string                                 `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Power      int64                                  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	TotalPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total_power,json=totalPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_power"`
+}
+
+func (m *VotePower) Reset()         { *m = VotePower{} }
+func (m *VotePower) String() string { return proto.CompactTextString(m) }
+func (*VotePower) ProtoMessage()    {}
+func (*VotePower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{1}
+}
+func (m *VotePower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotePower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotePower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotePower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotePower.Merge(m, src)
+}
+func (m *VotePower) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotePower) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotePower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotePower proto.InternalMessageInfo
+
+func (m *VotePower) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *VotePower) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// ConflictingClaim records a validator which voted for an attestation that lost
+// to a different attestation at the same event nonce, this usually means the
+// orchestrator of the validator is running a faulty Ethereum node
+type ConflictingClaim struct {
+	Validator         string    `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	EventNonce        uint64    `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	ClaimType         ClaimType `protobuf:"varint,3,opt,name=claim_type,json=claimType,proto3,enum=gravity.v1.ClaimType" json:"claim_type,omitempty"`
+	ClaimHash         string    `protobuf:"bytes,4,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
+	ObservedClaimHash string    `protobuf:"bytes,5,opt,name=observed_claim_hash,json=observedClaimHash,proto3" json:"observed_claim_hash,omitempty"`
+	BlockHeight       uint64    `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *ConflictingClaim) Reset()         { *m = ConflictingClaim{} }
+func (m *ConflictingClaim) String() string { return proto.CompactTextString(m) }
+func (*ConflictingClaim) ProtoMessage()    {}
+func (*ConflictingClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{2}
+}
+func (m *ConflictingClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictingClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictingClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictingClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictingClaim.Merge(m, src)
+}
+func (m *ConflictingClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictingClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictingClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictingClaim proto.InternalMessageInfo
+
+func (m *ConflictingClaim) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ConflictingClaim) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *ConflictingClaim) GetClaimType() ClaimType {
+	if m != nil {
+		return m.ClaimType
+	}
+	return CLAIM_TYPE_UNSPECIFIED
+}
+
+func (m *ConflictingClaim) GetClaimHash() string {
+	if m != nil {
+		return m.ClaimHash
+	}
+	return ""
+}
+
+func (m *ConflictingClaim) GetObservedClaimHash() string {
+	if m != nil {
+		return m.ObservedClaimHash
+	}
+	return ""
+}
+
+func (m *ConflictingClaim) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
 // ERC20Token unique identifier for an Ethereum ERC20 token.
 // CONTRACT:
 // The contract address on ETH of the token, this could be a Cosmos
@@ -159,7 +344,7 @@ func (m *ERC20Token) Reset()         { *m = ERC20Token{} }
 func (m *ERC20Token) String() string { return proto.CompactTextString(m) }
 func (*ERC20Token) ProtoMessage()    {}
 func (*ERC20Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3205613bbab7525, []int{1}
+	return fileDescriptor_e3205613bbab7525, []int{3}
 }
 func (m *ERC20Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -197,44 +382,64 @@ func (m *ERC20Token) GetContract() string {
 
 func init() {
 	proto.RegisterEnum("gravity.v1.ClaimType", ClaimType_name, ClaimType_value)
+	proto.RegisterEnum("gravity.v1.AttestationPowerPolicy", AttestationPowerPolicy_name, AttestationPowerPolicy_value)
 	proto.RegisterType((*Attestation)(nil), "gravity.v1.Attestation")
+	proto.RegisterType((*VotePower)(nil), "gravity.v1.VotePower")
+	proto.RegisterType((*ConflictingClaim)(nil), "gravity.v1.ConflictingClaim")
 	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
 }
 
 func init() { proto.RegisterFile("gravity/v1/attestation.proto", fileDescriptor_e3205613bbab7525) }
 
 var fileDescriptor_e3205613bbab7525 = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x4f, 0x6f, 0x9b, 0x30,
-	0x18, 0xc6, 0x71, 0xfe, 0xa9, 0x71, 0x2f, 0x91, 0x15, 0x55, 0x69, 0xd4, 0xd1, 0x28, 0x87, 0x29,
-	0xaa, 0x14, 0xbc, 0x76, 0x9f, 0x80, 0x80, 0xbb, 0x46, 0xa2, 0x25, 0x02, 0x32, 0xad, 0xd3, 0x24,
-	0x04, 0xc4, 0x23, 0xa8, 0x01, 0x47, 0xe0, 0xa0, 0x71, 0xde, 0x65, 0xc7, 0x7d, 0x87, 0x7d, 0x99,
-	0x1e, 0x73, 0x9c, 0x76, 0xa8, 0xa6, 0xe4, 0x8b, 0x4c, 0x01, 0x92, 0x45, 0x3d, 0xc1, 0xe3, 0xdf,
-	0xeb, 0xd7, 0x8f, 0x1f, 0xbf, 0xf0, 0xc2, 0x8f, 0x9d, 0x34, 0xe0, 0x19, 0x4e, 0xaf, 0xb1, 0xc3,
-	0x39, 0x4d, 0xb8, 0xc3, 0x03, 0x16, 0x49, 0xcb, 0x98, 0x71, 0x86, 0x60, 0x49, 0xa5, 0xf4, 0xba,
-	0xdb, 0xf6, 0x99, 0xcf, 0xf2, 0x65, 0xbc, 0xfb, 0x2b, 0x2a, 0xba, 0xe7, 0x3e, 0x63, 0xfe, 0x82,
-	0xe2, 0x5c, 0xb9, 0xab, 0xaf, 0xd8, 0x89, 0xb2, 0x02, 0xf5, 0xbf, 0x03, 0x78, 0x2a, 0xff, 0x6f,
-	0x89, 0xba, 0xf0, 0x84, 0xb9, 0x09, 0x8d, 0x53, 0x3a, 0xeb, 0x80, 0x1e, 0x18, 0x9c, 0x18, 0x07,
-	0x8d, 0xda, 0xb0, 0x9e, 0x32, 0x4e, 0x93, 0x4e, 0xa5, 0x57, 0x1d, 0x34, 0x8d, 0x42, 0xa0, 0x33,
-	0xd8, 0x98, 0xd3, 0xc0, 0x9f, 0xf3, 0x4e, 0xb5, 0x07, 0x06, 0x35, 0xa3, 0x54, 0xe8, 0x0a, 0xd6,
-	0xbd, 0x85, 0x13, 0x84, 0x9d, 0x5a, 0x0f, 0x0c, 0x4e, 0x6f, 0xda, 0x52, 0x61, 0x42, 0xda, 0x9b,
-	0x90, 0xe4, 0x28, 0x33, 0x8a, 0x92, 0xfe, 0x12, 0x42, 0x62, 0x28, 0x37, 0xef, 0x2c, 0xf6, 0x44,
-	0x73, 0x0f, 0x1e, 0x8b, 0x78, 0xec, 0x78, 0x3c, 0xf7, 0xd0, 0x34, 0x0e, 0x1a, 0xdd, 0xc2, 0x86,
-	0x13, 0xb2, 0x55, 0xc4, 0x3b, 0x95, 0x1d, 0x19, 0x49, 0xcf, 0x2f, 0x97, 0xc2, 0x9f, 0x97, 0xcb,
-	0xb7, 0x7e, 0xc0, 0xe7, 0x2b, 0x57, 0xf2, 0x58, 0x88, 0x3d, 0x96, 0x84, 0x2c, 0x29, 0x3f, 0xc3,
-	0x64, 0xf6, 0x84, 0x79, 0xb6, 0xa4, 0x89, 0x34, 0x8e, 0xb8, 0x51, 0xee, 0xbe, 0x5a, 0x03, 0xd8,
-	0x54, 0x76, 0x67, 0x5b, 0xd9, 0x92, 0xa2, 0x2e, 0x3c, 0x53, 0x34, 0x79, 0x7c, 0x6f, 0x5b, 0x8f,
-	0x13, 0x62, 0x4f, 0x1f, 0xcc, 0x09, 0x51, 0xc6, 0xb7, 0x63, 0xa2, 0xb6, 0x04, 0xf4, 0x06, 0x9e,
-	0x1f, 0x31, 0x93, 0x3c, 0xa8, 0xb6, 0xa5, 0xdb, 0x8a, 0x6e, 0xde, 0xeb, 0x66, 0x0b, 0xa0, 0x1e,
-	0xbc, 0x38, 0xc2, 0x23, 0xd9, 0x52, 0xee, 0x0e, 0x45, 0xc4, 0xba, 0x6b, 0x55, 0x5e, 0x35, 0xc8,
-	0xef, 0x69, 0xab, 0x64, 0xa2, 0xe9, 0x8f, 0x44, 0x6d, 0x55, 0x51, 0x1f, 0x8a, 0x47, 0x58, 0xd3,
-	0x3f, 0x8c, 0x15, 0x5b, 0x91, 0x35, 0xcd, 0x26, 0x9f, 0x88, 0x32, 0xb5, 0x88, 0xda, 0xaa, 0xbd,
-	0x6a, 0xf1, 0x51, 0xd6, 0x4c, 0x62, 0xd9, 0xd3, 0x89, 0x2a, 0xef, 0x70, 0xbd, 0x5b, 0xfb, 0xf1,
-	0x4b, 0x14, 0x46, 0x5f, 0x9e, 0x37, 0x22, 0x58, 0x6f, 0x44, 0xf0, 0x77, 0x23, 0x82, 0x9f, 0x5b,
-	0x51, 0x58, 0x6f, 0x45, 0xe1, 0xf7, 0x56, 0x14, 0x3e, 0x8f, 0x8e, 0xc2, 0x71, 0x16, 0x7c, 0x4e,
-	0x9d, 0x61, 0x44, 0xf9, 0x3e, 0xa0, 0x72, 0x7c, 0x86, 0x6e, 0x1c, 0xcc, 0x7c, 0x8a, 0x43, 0x36,
-	0x5b, 0x2d, 0x28, 0xfe, 0x86, 0xf7, 0x43, 0x97, 0x87, 0xe7, 0x36, 0xf2, 0x77, 0x7b, 0xff, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x0f, 0x0b, 0x25, 0x26, 0x8c, 0x02, 0x00, 0x00,
+	// 750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0x8e, 0x37, 0x3f, 0xd4, 0xbc, 0x20, 0x64, 0x86, 0x65, 0x95, 0x46, 0x5b, 0x6f, 0x1a, 0x01,
+	0x8a, 0x56, 0x5a, 0x9b, 0x2e, 0x1c, 0xb9, 0x38, 0xce, 0xb4, 0x89, 0x94, 0x8d, 0x2d, 0x7b, 0x52,
+	0x58, 0x84, 0x34, 0x72, 0x9c, 0xa9, 0x63, 0xad, 0xe3, 0x89, 0xe2, 0x59, 0x43, 0xfe, 0x03, 0x8e,
+	0xfd, 0x1f, 0xf8, 0x4b, 0xb8, 0xf5, 0xd8, 0x23, 0xe2, 0x50, 0xa1, 0xdd, 0x7f, 0x80, 0x2b, 0x37,
+	0xe4, 0xb1, 0x93, 0x0d, 0x95, 0xe0, 0xd0, 0x53, 0xfc, 0xbe, 0xef, 0xf3, 0x7b, 0xdf, 0x37, 0x6f,
+	0x62, 0x38, 0x0d, 0x37, 0x7e, 0x16, 0x89, 0xad, 0x91, 0x3d, 0x33, 0x7c, 0x21, 0x58, 0x2a, 0x7c,
+	0x11, 0xf1, 0x44, 0x5f, 0x6f, 0xb8, 0xe0, 0x08, 0x4a, 0x56, 0xcf, 0x9e, 0x75, 0x8e, 0x43, 0x1e,
+	0x72, 0x09, 0x1b, 0xf9, 0x53, 0xa1, 0xe8, 0x3c, 0x0e, 0x39, 0x0f, 0x63, 0x66, 0xc8, 0x6a, 0x7e,
+	0xfb, 0xca, 0xf0, 0x93, 0x6d, 0x41, 0xf5, 0x7e, 0x53, 0xa0, 0x65, 0x3e, 0xb4, 0x44, 0x1d, 0x78,
+	0xc4, 0xe7, 0x29, 0xdb, 0x64, 0x6c, 0xd1, 0x56, 0xba, 0x4a, 0xff, 0x91, 0xbb, 0xaf, 0xd1, 0x31,
+	0xd4, 0x33, 0x2e, 0x58, 0xda, 0x3e, 0xea, 0x56, 0xfb, 0x4d, 0xb7, 0x28, 0xd0, 0x09, 0x34, 0x96,
+	0x2c, 0x0a, 0x97, 0xa2, 0x5d, 0xed, 0x2a, 0xfd, 0x9a, 0x5b, 0x56, 0xe8, 0x1c, 0xea, 0x41, 0xec,
+	0x47, 0xab, 0x76, 0xad, 0xab, 0xf4, 0x5b, 0x97, 0xc7, 0x7a, 0x61, 0x42, 0xdf, 0x99, 0xd0, 0xcd,
+	0x64, 0xeb, 0x16, 0x12, 0xf4, 0x2d, 0xb4, 0xf2, 0x66, 0x74, 0xcd, 0x7f, 0x62, 0x9b, 0xb4, 0x5d,
+	0xef, 0x56, 0xfb, 0xad, 0xcb, 0xcf, 0xf4, 0x87, 0x60, 0xfa, 0x4b, 0x2e, 0x98, 0x93, 0xb3, 0x83,
+	0xda, 0x9b, 0x77, 0x67, 0x15, 0x17, 0xb2, 0x1d, 0x90, 0xf6, 0x5e, 0x2b, 0xd0, 0xdc, 0xf3, 0xe8,
+	0x14, 0x9a, 0x99, 0x1f, 0x47, 0x0b, 0x5f, 0xf0, 0x8d, 0x8c, 0xd0, 0x74, 0x1f, 0x80, 0x3c, 0x83,
+	0x1c, 0xd2, 0x3e, 0xea, 0x2a, 0xfd, 0xaa, 0x5b, 0x14, 0xc8, 0x86, 0x96, 0xe0, 0xc2, 0x8f, 0x0b,
+	0x03, 0x32, 0x48, 0x73, 0xa0, 0xe7, 0x83, 0xfe, 0x78, 0x77, 0xf6, 0x65, 0x18, 0x89, 0xe5, 0xed,
+	0x5c, 0x0f, 0xf8, 0xca, 0x08, 0x78, 0xba, 0xe2, 0x69, 0xf9, 0x73, 0x91, 0x2e, 0x6e, 0x0c, 0xb1,
+	0x5d, 0xb3, 0x54, 0x1f, 0x27, 0xc2, 0x05, 0xd9, 0x42, 0x9a, 0xe8, 0xfd, 0xad, 0x80, 0x6a, 0xf1,
+	0xe4, 0x55, 0x1c, 0x05, 0x22, 0x4a, 0x42, 0x4b, 0xa6, 0xfc, 0x7f, 0x67, 0x67, 0xd0, 0x62, 0x19,
+	0x4b, 0x04, 0x4d, 0x78, 0x12, 0x30, 0xe9, 0xaf, 0xe6, 0x82, 0x84, 0xa6, 0x39, 0x82, 0xbe, 0x01,
+	0x90, 0xa7, 0x45, 0xf3, 0x91, 0xd2, 0xe3, 0xc7, 0xff, 0x3e, 0x23, 0x39, 0x85, 0x6c, 0xd7, 0xcc,
+	0x6d, 0x06, 0xbb, 0x47, 0xf4, 0x64, 0xf7, 0xd6, 0xd2, 0x4f, 0x97, 0x72, 0x17, 0xcd, 0x92, 0x1e,
+	0xf9, 0xe9, 0x12, 0xe9, 0xf0, 0xe9, 0x6e, 0xbf, 0xf4, 0x40, 0x57, 0x97, 0xba, 0x4f, 0x76, 0x94,
+	0xb5, 0xd7, 0x3f, 0x85, 0x8f, 0xe6, 0x31, 0x0f, 0x6e, 0x68, 0xb9, 0xf3, 0x86, 0xb4, 0xd9, 0x92,
+	0xd8, 0x48, 0x42, 0xbd, 0x35, 0x00, 0x76, 0xad, 0xcb, 0xaf, 0x08, 0xbf, 0x61, 0xf2, 0x42, 0x05,
+	0x3c, 0x11, 0x1b, 0x3f, 0x10, 0x65, 0xe6, 0x7d, 0x8d, 0x9e, 0x43, 0xc3, 0x5f, 0xf1, 0xdb, 0x44,
+	0xb4, 0x8f, 0x3e, 0xe8, 0xc4, 0xcb, 0xb7, 0xcf, 0xff, 0x52, 0xa0, 0xb9, 0x0f, 0x8f, 0x3a, 0x70,
+	0x62, 0x4d, 0xcc, 0xf1, 0x15, 0x25, 0xd7, 0x0e, 0xa6, 0xb3, 0xa9, 0xe7, 0x60, 0x6b, 0xfc, 0x7c,
+	0x8c, 0x87, 0x6a, 0x05, 0x3d, 0x81, 0xc7, 0x07, 0x9c, 0x87, 0xa7, 0x43, 0x4a, 0x6c, 0x6a, 0xd9,
+	0xde, 0x95, 0xed, 0xa9, 0x0a, 0xea, 0xc2, 0xe9, 0x01, 0x3d, 0x30, 0x89, 0x35, 0xda, 0x8b, 0x30,
+	0x19, 0xa9, 0x47, 0xef, 0x35, 0x90, 0x39, 0xe9, 0x10, 0x3b, 0x13, 0xfb, 0x1a, 0x0f, 0xd5, 0x2a,
+	0xea, 0x81, 0x76, 0x40, 0x4f, 0xec, 0x17, 0x63, 0x8b, 0x5a, 0xe6, 0x64, 0x42, 0xf1, 0xf7, 0xd8,
+	0x9a, 0x11, 0x3c, 0x54, 0x6b, 0xef, 0xb5, 0x78, 0x69, 0x4e, 0x3c, 0x4c, 0xe8, 0xcc, 0x19, 0x9a,
+	0x39, 0x5d, 0x47, 0x1a, 0x74, 0x0e, 0x27, 0x90, 0x11, 0x76, 0xf1, 0xec, 0x8a, 0x8e, 0xf0, 0xf8,
+	0xc5, 0x88, 0xa8, 0x8d, 0x4e, 0xed, 0x97, 0x5f, 0xb5, 0xca, 0xf9, 0x0d, 0x9c, 0x1c, 0xfc, 0x6d,
+	0xe5, 0xa5, 0x73, 0x78, 0x1c, 0x05, 0x5b, 0xf4, 0x39, 0x74, 0x4d, 0x42, 0xb0, 0x47, 0x4c, 0x32,
+	0xb6, 0xa7, 0xd4, 0xb1, 0xbf, 0xc3, 0x2e, 0x75, 0xec, 0xc9, 0xd8, 0xba, 0xa6, 0xd6, 0xcc, 0x75,
+	0xf1, 0x94, 0xa8, 0x15, 0xf4, 0x05, 0x3c, 0xfd, 0x4f, 0x95, 0x37, 0x35, 0x1d, 0x6f, 0x64, 0x13,
+	0x55, 0x29, 0x86, 0x0d, 0x7e, 0x7c, 0x73, 0xa7, 0x29, 0x6f, 0xef, 0x34, 0xe5, 0xcf, 0x3b, 0x4d,
+	0x79, 0x7d, 0xaf, 0x55, 0xde, 0xde, 0x6b, 0x95, 0xdf, 0xef, 0xb5, 0xca, 0x0f, 0x83, 0x83, 0x4d,
+	0xf9, 0xb1, 0x58, 0x32, 0xff, 0x22, 0x61, 0x62, 0xb7, 0xad, 0xf2, 0x6e, 0x5e, 0xcc, 0x37, 0xd1,
+	0x22, 0x64, 0xc6, 0x8a, 0x2f, 0x6e, 0x63, 0x66, 0xfc, 0x6c, 0x94, 0x78, 0xb1, 0xc9, 0x79, 0x43,
+	0x7e, 0x11, 0xbe, 0xfe, 0x67, 0x00, 0x27, 0x10, 0x43, 0x89, 0xe6, 0x04, 0x00, 0x00,
 }
 
 func (m *Attestation) Marshal() (dAtA []byte, err error) {
@@ -257,6 +462,20 @@ func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VotePowers) > 0 {
+		for iNdEx := len(m.VotePowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotePowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttestation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Claim != nil {
 		{
 			size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
@@ -296,6 +515,110 @@ func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VotePower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotePower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotePower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalPower.Size()
+		i -= size
+		if _, err := m.TotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Power != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConflictingClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictingClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictingClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ObservedClaimHash) > 0 {
+		i -= len(m.ObservedClaimHash)
+		copy(dAtA[i:], m.ObservedClaimHash)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.ObservedClaimHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClaimHash) > 0 {
+		i -= len(m.ClaimHash)
+		copy(dAtA[i:], m.ClaimHash)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.ClaimHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ClaimType != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.ClaimType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -369,6 +692,60 @@ func (m *Attestation) Size() (n int) {
 		l = m.Claim.Size()
 		n += 1 + l + sovAttestation(uint64(l))
 	}
+	if len(m.VotePowers) > 0 {
+		for _, e := range m.VotePowers {
+			l = e.Size()
+			n += 1 + l + sovAttestation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VotePower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovAttestation(uint64(m.Power))
+	}
+	l = m.TotalPower.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	return n
+}
+
+func (m *ConflictingClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovAttestation(uint64(m.EventNonce))
+	}
+	if m.ClaimType != 0 {
+		n += 1 + sovAttestation(uint64(m.ClaimType))
+	}
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.ObservedClaimHash)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovAttestation(uint64(m.BlockHeight))
+	}
 	return n
 }
 
@@ -529,6 +906,384 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePowers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotePowers = append(m.VotePowers, VotePower{})
+			if err := m.VotePowers[len(m.VotePowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotePower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotePower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotePower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConflictingClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictingClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictingClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			}
+			m.ClaimType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimType |= ClaimType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedClaimHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservedClaimHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttestation(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -23,6 +24,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BatchSelectionPolicy selects the order transactions are taken from the
+// pool in when a batch is built
+type BatchSelectionPolicy int32
+
+const (
+	// take the transactions paying the highest fees first
+	BATCH_SELECTION_POLICY_FEE_DESC BatchSelectionPolicy = 0
+	// take the transactions in the order they were sent
+	BATCH_SELECTION_POLICY_FIFO BatchSelectionPolicy = 1
+	// take the transactions with the highest fee scaled up by the number of
+	// blocks they have waited in the pool first
+	BATCH_SELECTION_POLICY_AGE_WEIGHTED BatchSelectionPolicy = 2
+)
+
+var BatchSelectionPolicy_name = map[int32]string{
+	0: "BATCH_SELECTION_POLICY_FEE_DESC",
+	1: "BATCH_SELECTION_POLICY_FIFO",
+	2: "BATCH_SELECTION_POLICY_AGE_WEIGHTED",
+}
+
+var BatchSelectionPolicy_value = map[string]int32{
+	"BATCH_SELECTION_POLICY_FEE_DESC":     0,
+	"BATCH_SELECTION_POLICY_FIFO":         1,
+	"BATCH_SELECTION_POLICY_AGE_WEIGHTED": 2,
+}
+
+func (x BatchSelectionPolicy) String() string {
+	return proto.EnumName(BatchSelectionPolicy_name, int32(x))
+}
+
+func (BatchSelectionPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{0}
+}
+
 // OutgoingTxBatch represents a batch of transactions going from gravity to ETH
 type OutgoingTxBatch struct {
 	BatchNonce    uint64               `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
@@ -100,6 +135,107 @@ func (m *OutgoingTxBatch) GetBlock() uint64 {
 	return 0
 }
 
+// BatchSizeLimit is the largest number of transactions a batch of a token may
+// contain
+type BatchSizeLimit struct {
+	Token        string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	MaxBatchSize uint64 `protobuf:"varint,2,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+}
+
+func (m *BatchSizeLimit) Reset()         { *m = BatchSizeLimit{} }
+func (m *BatchSizeLimit) String() string { return proto.CompactTextString(m) }
+func (*BatchSizeLimit) ProtoMessage()    {}
+func (*BatchSizeLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{1}
+}
+func (m *BatchSizeLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchSizeLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchSizeLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchSizeLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchSizeLimit.Merge(m, src)
+}
+func (m *BatchSizeLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchSizeLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchSizeLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchSizeLimit proto.InternalMessageInfo
+
+func (m *BatchSizeLimit) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *BatchSizeLimit) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+// AutoBatchFeeThreshold is the total fee the pooled transactions of a token
+// must pay before a batch of them is built in the end blocker
+type AutoBatchFeeThreshold struct {
+	Token       string                                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	MinTotalFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_total_fee,json=minTotalFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_total_fee"`
+}
+
+func (m *AutoBatchFeeThreshold) Reset()         { *m = AutoBatchFeeThreshold{} }
+func (m *AutoBatchFeeThreshold) String() string { return proto.CompactTextString(m) }
+func (*AutoBatchFeeThreshold) ProtoMessage()    {}
+func (*AutoBatchFeeThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{2}
+}
+func (m *AutoBatchFeeThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoBatchFeeThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoBatchFeeThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoBatchFeeThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoBatchFeeThreshold.Merge(m, src)
+}
+func (m *AutoBatchFeeThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoBatchFeeThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoBatchFeeThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoBatchFeeThreshold proto.InternalMessageInfo
+
+func (m *AutoBatchFeeThreshold) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 // OutgoingTransferTx represents an individual send from gravity to ETH
 type OutgoingTransferTx struct {
 	Id          uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -113,7 +249,7 @@ func (m *OutgoingTransferTx) Reset()         { *m = OutgoingTransferTx{} }
 func (m *OutgoingTransferTx) String() string { return proto.CompactTextString(m) }
 func (*OutgoingTransferTx) ProtoMessage()    {}
 func (*OutgoingTransferTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4453b445b0660cab, []int{1}
+	return fileDescriptor_4453b445b0660cab, []int{3}
 }
 func (m *OutgoingTransferTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,13 +323,16 @@ type OutgoingLogicCall struct {
 	InvalidationId       []byte       `protobuf:"bytes,6,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
 	InvalidationNonce    uint64       `protobuf:"varint,7,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
 	Block                uint64       `protobuf:"varint,8,opt,name=block,proto3" json:"block,omitempty"`
+	// the account the transfers and fees were escrowed from, they are
+	// returned to it if the call is canceled
+	Sender string `protobuf:"bytes,9,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
 func (m *OutgoingLogicCall) Reset()         { *m = OutgoingLogicCall{} }
 func (m *OutgoingLogicCall) String() string { return proto.CompactTextString(m) }
 func (*OutgoingLogicCall) ProtoMessage()    {}
 func (*OutgoingLogicCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4453b445b0660cab, []int{2}
+	return fileDescriptor_4453b445b0660cab, []int{4}
 }
 func (m *OutgoingLogicCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,8 +417,18 @@ func (m *OutgoingLogicCall) GetBlock() uint64 {
 	return 0
 }
 
+func (m *OutgoingLogicCall) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("gravity.v1.BatchSelectionPolicy", BatchSelectionPolicy_name, BatchSelectionPolicy_value)
 	proto.RegisterType((*OutgoingTxBatch)(nil), "gravity.v1.OutgoingTxBatch")
+	proto.RegisterType((*BatchSizeLimit)(nil), "gravity.v1.BatchSizeLimit")
+	proto.RegisterType((*AutoBatchFeeThreshold)(nil), "gravity.v1.AutoBatchFeeThreshold")
 	proto.RegisterType((*OutgoingTransferTx)(nil), "gravity.v1.OutgoingTransferTx")
 	proto.RegisterType((*OutgoingLogicCall)(nil), "gravity.v1.OutgoingLogicCall")
 }
@@ -287,41 +436,54 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/batch.proto", fileDescriptor_4453b445b0660cab) }
 
 var fileDescriptor_4453b445b0660cab = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x51, 0x6b, 0x1a, 0x41,
-	0x10, 0xf6, 0x8c, 0x31, 0x71, 0x34, 0x86, 0x2c, 0x22, 0x47, 0x28, 0x17, 0x6b, 0x29, 0xf5, 0x45,
-	0xcf, 0xd8, 0xbe, 0xb4, 0xd0, 0x87, 0x2a, 0x2d, 0x2d, 0x94, 0x16, 0x0e, 0x9f, 0x4a, 0x41, 0xd6,
-	0xdb, 0xf5, 0x5c, 0x72, 0xde, 0xca, 0xed, 0x2a, 0xfa, 0x2f, 0xfa, 0xb3, 0xf2, 0x98, 0xc7, 0xe6,
-	0x25, 0x14, 0xfd, 0x23, 0x65, 0xe7, 0xee, 0x8c, 0x69, 0xa1, 0xcd, 0xdb, 0xcd, 0x37, 0xdf, 0xb7,
-	0x33, 0xdf, 0xcc, 0x1c, 0xd4, 0x83, 0x98, 0x2e, 0x85, 0x5e, 0xbb, 0xcb, 0x4b, 0x77, 0x4c, 0xb5,
-	0x3f, 0xed, 0xcc, 0x63, 0xa9, 0x25, 0x81, 0x14, 0xef, 0x2c, 0x2f, 0xcf, 0x6b, 0x81, 0x0c, 0x24,
-	0xc2, 0xae, 0xf9, 0x4a, 0x18, 0xe7, 0x4f, 0xf6, 0x94, 0x54, 0x6b, 0xae, 0x34, 0xd5, 0x42, 0x46,
-	0x49, 0xb6, 0x79, 0x67, 0xc1, 0xe9, 0xd7, 0x85, 0x0e, 0xa4, 0x88, 0x82, 0xe1, 0xaa, 0x6f, 0x5e,
-	0x26, 0x17, 0x50, 0xc6, 0x12, 0xa3, 0x48, 0x46, 0x3e, 0xb7, 0xad, 0x86, 0xd5, 0x2a, 0x78, 0x80,
-	0xd0, 0x17, 0x83, 0x90, 0x67, 0x70, 0x92, 0x10, 0xb4, 0x98, 0x71, 0xb9, 0xd0, 0x76, 0x1e, 0x29,
-	0x15, 0x04, 0x87, 0x09, 0x46, 0x3e, 0x42, 0x45, 0xc7, 0x34, 0x52, 0xd4, 0x37, 0xe5, 0x94, 0x7d,
-	0xd0, 0x38, 0x68, 0x95, 0x7b, 0x4e, 0xe7, 0xbe, 0xe1, 0xce, 0xae, 0xb0, 0xe1, 0x4d, 0x78, 0x3c,
-	0x5c, 0xf5, 0x0b, 0xd7, 0x77, 0x17, 0x39, 0xef, 0x81, 0x92, 0x3c, 0x87, 0xaa, 0x96, 0x57, 0x3c,
-	0x1a, 0xf9, 0x32, 0xd2, 0x31, 0xf5, 0xb5, 0x5d, 0x68, 0x58, 0xad, 0x92, 0x77, 0x82, 0xe8, 0x20,
-	0x05, 0x49, 0x0d, 0x0e, 0xc7, 0xa1, 0xf4, 0xaf, 0xec, 0x43, 0xec, 0x26, 0x09, 0x9a, 0xb7, 0x16,
-	0x90, 0xbf, 0xeb, 0x90, 0x2a, 0xe4, 0x05, 0x4b, 0xad, 0xe5, 0x05, 0x23, 0x75, 0x28, 0x2a, 0x1e,
-	0x31, 0x1e, 0xa3, 0x97, 0x92, 0x97, 0x46, 0xe4, 0x29, 0x54, 0x18, 0x57, 0x7a, 0x44, 0x19, 0x8b,
-	0xb9, 0x32, 0x2e, 0x4c, 0xb6, 0x6c, 0xb0, 0x77, 0x09, 0x44, 0xde, 0x42, 0x99, 0xc7, 0x7e, 0xaf,
-	0x3b, 0xc2, 0x76, 0xb0, 0xb7, 0x72, 0xaf, 0xbe, 0xef, 0xf3, 0xbd, 0x37, 0xe8, 0x75, 0x87, 0x26,
-	0x9b, 0xfa, 0x03, 0x14, 0x20, 0x42, 0x5e, 0x43, 0x29, 0x91, 0x4f, 0x38, 0xc7, 0xd6, 0xff, 0x27,
-	0x3e, 0x46, 0xfa, 0x07, 0xce, 0x9b, 0xb7, 0x79, 0x38, 0xcb, 0xbc, 0x7d, 0x96, 0x81, 0xf0, 0x07,
-	0x34, 0x0c, 0xc9, 0x1b, 0x28, 0xe9, 0xd4, 0xa8, 0xb2, 0x2d, 0x9c, 0xfa, 0xbf, 0x1f, 0xbc, 0xa7,
-	0x93, 0x2e, 0x14, 0x26, 0x9c, 0x2b, 0x3b, 0xff, 0x08, 0x19, 0x32, 0xc9, 0x2b, 0xa8, 0x87, 0xa6,
-	0xf4, 0x6e, 0x39, 0x7f, 0x8c, 0xaa, 0x86, 0xd9, 0x6c, 0x49, 0xd9, 0xcc, 0x6c, 0x38, 0x9a, 0xd3,
-	0x75, 0x28, 0x29, 0xc3, 0x79, 0x55, 0xbc, 0x2c, 0x34, 0x99, 0xec, 0xaa, 0x92, 0x3d, 0x66, 0x21,
-	0x79, 0x01, 0xa7, 0x22, 0x5a, 0xd2, 0x50, 0x30, 0x3c, 0xe0, 0x91, 0x60, 0x76, 0x11, 0xb5, 0xd5,
-	0x7d, 0xf8, 0x13, 0x23, 0x6d, 0x20, 0x0f, 0x88, 0xc9, 0x19, 0x1f, 0xe1, 0x6b, 0x67, 0xfb, 0x99,
-	0xe4, 0x9a, 0x77, 0x77, 0x73, 0xbc, 0x77, 0x37, 0xfd, 0xef, 0xd7, 0x1b, 0xc7, 0xba, 0xd9, 0x38,
-	0xd6, 0xaf, 0x8d, 0x63, 0xfd, 0xd8, 0x3a, 0xb9, 0x9b, 0xad, 0x93, 0xfb, 0xb9, 0x75, 0x72, 0xdf,
-	0xfa, 0x81, 0xd0, 0xd3, 0xc5, 0xb8, 0xe3, 0xcb, 0x99, 0x4b, 0x43, 0x3d, 0xe5, 0xb4, 0x1d, 0x71,
-	0xed, 0xfa, 0x52, 0xcd, 0xa4, 0x6a, 0xa7, 0x13, 0x6b, 0x8f, 0x63, 0xc1, 0x02, 0xee, 0xce, 0x24,
-	0x5b, 0x84, 0xdc, 0x5d, 0xb9, 0xd9, 0x5f, 0xa8, 0xd7, 0x73, 0xae, 0xc6, 0x45, 0xfc, 0xfb, 0x5e,
-	0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xd5, 0x6c, 0x52, 0xe5, 0xd7, 0x03, 0x00, 0x00,
+	// 745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x4e, 0xfb, 0x46,
+	0x10, 0x8e, 0x43, 0xf8, 0x93, 0x4d, 0x08, 0xb0, 0x4a, 0x23, 0x8b, 0x56, 0x4e, 0x1a, 0xda, 0x82,
+	0x2a, 0x25, 0x86, 0xb4, 0x97, 0x56, 0xea, 0x21, 0x09, 0x0e, 0x44, 0x8a, 0x08, 0x32, 0x96, 0xaa,
+	0x56, 0x95, 0xac, 0x8d, 0xbd, 0x38, 0x2b, 0x6c, 0x2f, 0xb2, 0x37, 0x51, 0xc2, 0xad, 0xb7, 0x4a,
+	0xbd, 0xf4, 0x1d, 0xfa, 0x32, 0x1c, 0x39, 0xb6, 0x3d, 0xa0, 0x0a, 0x9e, 0xa0, 0x6f, 0x50, 0xed,
+	0xae, 0x1d, 0x4c, 0x2b, 0xf4, 0xe3, 0x94, 0xec, 0x37, 0xdf, 0xcc, 0xfa, 0x9b, 0x99, 0x6f, 0x41,
+	0xcd, 0x8b, 0xd0, 0x9c, 0xb0, 0xa5, 0x3e, 0x3f, 0xd1, 0x27, 0x88, 0x39, 0xd3, 0xf6, 0x6d, 0x44,
+	0x19, 0x85, 0x20, 0xc1, 0xdb, 0xf3, 0x93, 0xfd, 0xaa, 0x47, 0x3d, 0x2a, 0x60, 0x9d, 0xff, 0x93,
+	0x8c, 0xfd, 0x4f, 0x32, 0x99, 0x88, 0x31, 0x1c, 0x33, 0xc4, 0x08, 0x0d, 0x65, 0xb4, 0xf9, 0xa8,
+	0x80, 0x9d, 0xf1, 0x8c, 0x79, 0x94, 0x84, 0x9e, 0xb5, 0xe8, 0xf1, 0xca, 0xb0, 0x0e, 0x4a, 0xe2,
+	0x0a, 0x3b, 0xa4, 0xa1, 0x83, 0x55, 0xa5, 0xa1, 0x1c, 0x15, 0x4c, 0x20, 0xa0, 0x0b, 0x8e, 0xc0,
+	0x03, 0xb0, 0x2d, 0x09, 0x8c, 0x04, 0x98, 0xce, 0x98, 0x9a, 0x17, 0x94, 0xb2, 0x00, 0x2d, 0x89,
+	0xc1, 0x73, 0x50, 0x66, 0x11, 0x0a, 0x63, 0xe4, 0xf0, 0xeb, 0x62, 0x75, 0xad, 0xb1, 0x76, 0x54,
+	0xea, 0x68, 0xed, 0x97, 0x0f, 0x6e, 0xaf, 0x2e, 0xe6, 0xbc, 0x6b, 0x1c, 0x59, 0x8b, 0x5e, 0xe1,
+	0xfe, 0xb1, 0x9e, 0x33, 0x5f, 0x65, 0xc2, 0xcf, 0x41, 0x85, 0xd1, 0x1b, 0x1c, 0xda, 0x0e, 0x0d,
+	0x59, 0x84, 0x1c, 0xa6, 0x16, 0x1a, 0xca, 0x51, 0xd1, 0xdc, 0x16, 0x68, 0x3f, 0x01, 0x61, 0x15,
+	0xac, 0x4f, 0x7c, 0xea, 0xdc, 0xa8, 0xeb, 0xe2, 0x6b, 0xe4, 0xa1, 0x39, 0x02, 0x15, 0xa1, 0xea,
+	0x8a, 0xdc, 0xe1, 0x11, 0x09, 0x88, 0xe0, 0x89, 0x44, 0x21, 0xac, 0x68, 0xca, 0x03, 0xfc, 0x0c,
+	0x54, 0x02, 0xb4, 0xb0, 0xa5, 0xae, 0x98, 0xdc, 0xe1, 0x54, 0x54, 0x80, 0x16, 0xab, 0x02, 0xcd,
+	0x9f, 0x15, 0xf0, 0x51, 0x77, 0xc6, 0xa8, 0x40, 0x06, 0x18, 0x5b, 0xd3, 0x08, 0xc7, 0x53, 0xea,
+	0xbb, 0x6f, 0x54, 0x35, 0xc1, 0x76, 0x40, 0x42, 0x9b, 0x51, 0x86, 0x7c, 0xfb, 0x1a, 0xcb, 0xa2,
+	0xc5, 0x5e, 0x9b, 0xab, 0xfc, 0xeb, 0xb1, 0xfe, 0x85, 0x47, 0xd8, 0x74, 0x36, 0x69, 0x3b, 0x34,
+	0xd0, 0x1d, 0x1a, 0x07, 0x34, 0x4e, 0x7e, 0x5a, 0xb1, 0x7b, 0xa3, 0xb3, 0xe5, 0x2d, 0x8e, 0xdb,
+	0xc3, 0x90, 0x99, 0xa5, 0x80, 0x84, 0x16, 0xaf, 0x31, 0xc0, 0xb8, 0xf9, 0xa7, 0x02, 0xe0, 0xff,
+	0x3b, 0x07, 0x2b, 0x20, 0x4f, 0xdc, 0x64, 0x58, 0x79, 0xe2, 0xc2, 0x1a, 0xd8, 0x88, 0x71, 0xe8,
+	0xe2, 0x48, 0xde, 0x69, 0x26, 0x27, 0xf8, 0x29, 0x28, 0xbb, 0x38, 0x66, 0x36, 0x72, 0xdd, 0x08,
+	0xc7, 0x7c, 0x2e, 0x3c, 0x5a, 0xe2, 0x58, 0x57, 0x42, 0xf0, 0x3b, 0x50, 0xc2, 0x91, 0xd3, 0x39,
+	0xb6, 0xa5, 0x22, 0xde, 0xed, 0x52, 0xa7, 0x96, 0x9d, 0x9c, 0x61, 0xf6, 0x3b, 0xc7, 0x16, 0x8f,
+	0x26, 0x13, 0x03, 0x22, 0x41, 0x20, 0xf0, 0x1b, 0x50, 0x94, 0xe9, 0x5c, 0xf0, 0xfa, 0x3b, 0x92,
+	0xb7, 0x04, 0x9d, 0x6b, 0xfb, 0x27, 0x0f, 0xf6, 0x52, 0x6d, 0x23, 0xea, 0x11, 0xa7, 0x8f, 0x7c,
+	0x1f, 0x7e, 0x0b, 0x8a, 0x2c, 0x11, 0x1a, 0xab, 0x4a, 0x63, 0xed, 0x83, 0x05, 0x5f, 0xe8, 0xf0,
+	0x18, 0x14, 0xae, 0x31, 0x8e, 0xd5, 0xfc, 0x3b, 0xd2, 0x04, 0x13, 0x7e, 0x0d, 0x6a, 0x3e, 0xbf,
+	0x7a, 0xb5, 0x6e, 0xff, 0x69, 0x55, 0x55, 0x44, 0xd3, 0xb5, 0x4b, 0x7b, 0xa6, 0x82, 0xcd, 0x5b,
+	0xb4, 0xf4, 0x29, 0x72, 0x45, 0xbf, 0xca, 0x66, 0x7a, 0xe4, 0x91, 0xd4, 0x27, 0x72, 0x33, 0xd3,
+	0x23, 0x3c, 0x04, 0x3b, 0x24, 0x9c, 0x23, 0x9f, 0xb8, 0xc2, 0x92, 0x36, 0x71, 0xd5, 0x0d, 0x91,
+	0x5b, 0xc9, 0xc2, 0x43, 0x17, 0xb6, 0x00, 0x7c, 0x45, 0x94, 0xc6, 0xdc, 0x14, 0xd5, 0xf6, 0xb2,
+	0x11, 0xe9, 0xcf, 0x95, 0x13, 0xb6, 0x32, 0x4e, 0xc8, 0x2c, 0x44, 0x31, 0xbb, 0x10, 0x5f, 0xfe,
+	0xaa, 0x80, 0xaa, 0xdc, 0x70, 0xec, 0x63, 0x61, 0xb9, 0x4b, 0xea, 0x13, 0x67, 0x09, 0x0f, 0x40,
+	0xbd, 0xd7, 0xb5, 0xfa, 0xe7, 0xf6, 0x95, 0x31, 0x32, 0xfa, 0xd6, 0x70, 0x7c, 0x61, 0x5f, 0x8e,
+	0x47, 0xc3, 0xfe, 0x0f, 0xf6, 0xc0, 0x30, 0xec, 0x53, 0xe3, 0xaa, 0xbf, 0x9b, 0x83, 0x75, 0xf0,
+	0xf1, 0x5b, 0xa4, 0xe1, 0x60, 0xbc, 0xab, 0xc0, 0x43, 0x70, 0xf0, 0x06, 0xa1, 0x7b, 0x66, 0xd8,
+	0xdf, 0x1b, 0xc3, 0xb3, 0x73, 0xcb, 0x38, 0xdd, 0xcd, 0xef, 0x17, 0x7e, 0xf9, 0x5d, 0xcb, 0xf5,
+	0x7e, 0xba, 0x7f, 0xd2, 0x94, 0x87, 0x27, 0x4d, 0xf9, 0xfb, 0x49, 0x53, 0x7e, 0x7b, 0xd6, 0x72,
+	0x0f, 0xcf, 0x5a, 0xee, 0x8f, 0x67, 0x2d, 0xf7, 0x63, 0x2f, 0x63, 0x16, 0xe4, 0xb3, 0x29, 0x46,
+	0xad, 0x10, 0xb3, 0xd4, 0x30, 0xc9, 0x5c, 0x5b, 0x93, 0x88, 0xb8, 0x1e, 0xd6, 0x03, 0xea, 0xce,
+	0x7c, 0xac, 0x2f, 0xf4, 0xf4, 0xf5, 0x13, 0x66, 0x9a, 0x6c, 0x88, 0x57, 0xef, 0xab, 0x7f, 0x07,
+	0x00, 0x6f, 0x9e, 0xde, 0x41, 0x4f, 0x05, 0x00, 0x00,
 }
 
 func (m *OutgoingTxBatch) Marshal() (dAtA []byte, err error) {
@@ -383,6 +545,81 @@ func (m *OutgoingTxBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BatchSizeLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchSizeLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchSizeLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoBatchFeeThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoBatchFeeThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoBatchFeeThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinTotalFee.Size()
+		i -= size
+		if _, err := m.MinTotalFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBatch(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OutgoingTransferTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -465,6 +702,13 @@ func (m *OutgoingLogicCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Block != 0 {
 		i = encodeVarintBatch(dAtA, i, uint64(m.Block))
 		i--
@@ -571,6 +815,37 @@ func (m *OutgoingTxBatch) Size() (n int) {
 	return n
 }
 
+func (m *BatchSizeLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovBatch(uint64(m.MaxBatchSize))
+	}
+	return n
+}
+
+func (m *AutoBatchFeeThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = m.MinTotalFee.Size()
+	n += 1 + l + sovBatch(uint64(l))
+	return n
+}
+
 func (m *OutgoingTransferTx) Size() (n int) {
 	if m == nil {
 		return 0
@@ -634,6 +909,10 @@ func (m *OutgoingLogicCall) Size() (n int) {
 	if m.Block != 0 {
 		n += 1 + sovBatch(uint64(m.Block))
 	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
 	return n
 }
 
@@ -819,6 +1098,229 @@ func (m *OutgoingTxBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BatchSizeLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchSizeLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchSizeLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoBatchFeeThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoBatchFeeThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoBatchFeeThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTotalFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTotalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1275,6 +1777,38 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBatch(dAtA[iNdEx:])
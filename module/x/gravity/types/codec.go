@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // ModuleCdc is the codec for the module
@@ -27,10 +28,19 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBatchSendToEthClaim{},
 		&MsgERC20DeployedClaim{},
 		&MsgSetOrchestratorAddress{},
+		&MsgRotateDelegateKeys{},
 		&MsgLogicCallExecutedClaim{},
 		&MsgValsetUpdatedClaim{},
+		&MsgEthereumHeightClaim{},
 		&MsgCancelSendToEth{},
 		&MsgSubmitBadSignatureEvidence{},
+		&MsgClaimDepositEscrow{},
+		&MsgSubmitDoubleSignEvidence{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&DepositEscrowReleaseProposal{},
+		&TransferMinimumProposal{},
 	)
 
 	registry.RegisterInterface(
@@ -41,6 +51,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgERC20DeployedClaim{},
 		&MsgLogicCallExecutedClaim{},
 		&MsgValsetUpdatedClaim{},
+		&MsgEthereumHeightClaim{},
 	)
 
 	registry.RegisterInterface("gravity.v1beta1.EthereumSigned", (*EthereumSigned)(nil), &Valset{}, &OutgoingTxBatch{}, &OutgoingLogicCall{})
@@ -53,6 +64,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*EthereumClaim)(nil), nil)
 	cdc.RegisterConcrete(&MsgSetOrchestratorAddress{}, "gravity/MsgSetOrchestratorAddress", nil)
+	cdc.RegisterConcrete(&MsgRotateDelegateKeys{}, "gravity/MsgRotateDelegateKeys", nil)
 	cdc.RegisterConcrete(&MsgValsetConfirm{}, "gravity/MsgValsetConfirm", nil)
 	cdc.RegisterConcrete(&MsgSendToEth{}, "gravity/MsgSendToEth", nil)
 	cdc.RegisterConcrete(&MsgRequestBatch{}, "gravity/MsgRequestBatch", nil)
@@ -64,6 +76,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgERC20DeployedClaim{}, "gravity/MsgERC20DeployedClaim", nil)
 	cdc.RegisterConcrete(&MsgLogicCallExecutedClaim{}, "gravity/MsgLogicCallExecutedClaim", nil)
 	cdc.RegisterConcrete(&MsgValsetUpdatedClaim{}, "gravity/MsgValsetUpdatedClaim", nil)
+	cdc.RegisterConcrete(&MsgEthereumHeightClaim{}, "gravity/MsgEthereumHeightClaim", nil)
 	cdc.RegisterConcrete(&OutgoingTxBatch{}, "gravity/OutgoingTxBatch", nil)
 	cdc.RegisterConcrete(&MsgCancelSendToEth{}, "gravity/MsgCancelSendToEth", nil)
 	cdc.RegisterConcrete(&OutgoingTransferTx{}, "gravity/OutgoingTransferTx", nil)
@@ -71,4 +84,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&IDSet{}, "gravity/IDSet", nil)
 	cdc.RegisterConcrete(&Attestation{}, "gravity/Attestation", nil)
 	cdc.RegisterConcrete(&MsgSubmitBadSignatureEvidence{}, "gravity/MsgSubmitBadSignatureEvidence", nil)
+	cdc.RegisterConcrete(&MsgClaimDepositEscrow{}, "gravity/MsgClaimDepositEscrow", nil)
+	cdc.RegisterConcrete(&MsgSubmitDoubleSignEvidence{}, "gravity/MsgSubmitDoubleSignEvidence", nil)
+	cdc.RegisterConcrete(&DepositEscrowReleaseProposal{}, "gravity/DepositEscrowReleaseProposal", nil)
+	cdc.RegisterConcrete(&TransferMinimumProposal{}, "gravity/TransferMinimumProposal", nil)
 }
@@ -72,3 +72,18 @@ func ValidateEthereumSignature(hash []byte, signature []byte, ethAddress EthAddr
 
 	return nil
 }
+
+// GetDelegateKeysSignHash returns the hash an Ethereum key signs to prove that the validator
+// registering it as a delegate key controls it
+func GetDelegateKeysSignHash(gravityID string, validator string, orchestrator string) []byte {
+	msg := DelegateKeysSignMsg{
+		GravityId:    gravityID,
+		Validator:    validator,
+		Orchestrator: orchestrator,
+	}
+	bz, err := msg.Marshal()
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "delegate keys sign msg"))
+	}
+	return crypto.Keccak256(bz)
+}
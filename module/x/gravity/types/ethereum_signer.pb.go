@@ -7,7 +7,9 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -46,30 +48,415 @@ func (SignType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_005a3d0c6f36c26c, []int{0}
 }
 
+// DelegateKeysSignMsg is the message signed by an Ethereum key to prove that
+// the validator registering it as a delegate key controls it, the gravity id
+// separates signatures made for different bridges
+type DelegateKeysSignMsg struct {
+	GravityId    string `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	Validator    string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Orchestrator string `protobuf:"bytes,3,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+}
+
+func (m *DelegateKeysSignMsg) Reset()         { *m = DelegateKeysSignMsg{} }
+func (m *DelegateKeysSignMsg) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysSignMsg) ProtoMessage()    {}
+func (*DelegateKeysSignMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_005a3d0c6f36c26c, []int{0}
+}
+func (m *DelegateKeysSignMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeysSignMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysSignMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegateKeysSignMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysSignMsg.Merge(m, src)
+}
+func (m *DelegateKeysSignMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeysSignMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysSignMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateKeysSignMsg proto.InternalMessageInfo
+
+func (m *DelegateKeysSignMsg) GetGravityId() string {
+	if m != nil {
+		return m.GravityId
+	}
+	return ""
+}
+
+func (m *DelegateKeysSignMsg) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *DelegateKeysSignMsg) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("gravity.v1.SignType", SignType_name, SignType_value)
+	proto.RegisterType((*DelegateKeysSignMsg)(nil), "gravity.v1.DelegateKeysSignMsg")
 }
 
 func init() { proto.RegisterFile("gravity/v1/ethereum_signer.proto", fileDescriptor_005a3d0c6f36c26c) }
 
 var fileDescriptor_005a3d0c6f36c26c = []byte{
-	// 280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x2f, 0x4a, 0x2c,
-	0xcb, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0xc9, 0x48, 0x2d, 0x4a, 0x2d, 0xcd, 0x8d,
-	0x2f, 0xce, 0x4c, 0xcf, 0x4b, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x82, 0xaa,
-	0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x0b, 0xeb, 0x83, 0x58, 0x10, 0x15,
-	0x5a, 0x53, 0x19, 0xb9, 0x38, 0x82, 0x33, 0xd3, 0xf3, 0x42, 0x2a, 0x0b, 0x52, 0x85, 0x24, 0xb9,
-	0x44, 0x83, 0x3d, 0xdd, 0xfd, 0xe2, 0x43, 0x22, 0x03, 0x5c, 0xe3, 0x43, 0xfd, 0x82, 0x03, 0x5c,
-	0x9d, 0x3d, 0xdd, 0x3c, 0x5d, 0x5d, 0x04, 0x18, 0x84, 0x8c, 0xb8, 0xf4, 0x10, 0x52, 0xfe, 0x41,
-	0xce, 0x1e, 0xae, 0xc1, 0x21, 0x41, 0x8e, 0x21, 0xfe, 0x41, 0xf1, 0x20, 0x61, 0x57, 0x97, 0x78,
-	0xdf, 0x50, 0x9f, 0x10, 0x4f, 0x10, 0x27, 0x3e, 0x34, 0xc0, 0xc5, 0x31, 0xc4, 0x55, 0x80, 0x51,
-	0xc8, 0x80, 0x4b, 0x07, 0xbf, 0x9e, 0x70, 0xcf, 0x10, 0x0f, 0x97, 0x20, 0xc7, 0xf0, 0x78, 0x27,
-	0xc7, 0x10, 0x67, 0x0f, 0x01, 0x26, 0x29, 0x8e, 0x8e, 0xc5, 0x72, 0x0c, 0x2b, 0x96, 0xc8, 0x31,
-	0x38, 0xc5, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
-	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x53, 0x7a, 0x66,
-	0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x62, 0x4e, 0x49, 0x46, 0x6a, 0xa2, 0x6e,
-	0x5e, 0x6a, 0x89, 0x7e, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0xb1, 0x2e, 0xd4, 0xc3, 0xba, 0x49, 0x45,
-	0x99, 0x29, 0xe9, 0xa9, 0xfa, 0xb9, 0xf9, 0x29, 0xa5, 0x39, 0xa9, 0xfa, 0x15, 0xfa, 0xb0, 0xa0,
-	0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xde, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x5a, 0x4c, 0xd8, 0xbf, 0x42, 0x01, 0x00, 0x00,
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcf, 0x4a, 0xe3, 0x40,
+	0x00, 0xc6, 0x93, 0x2e, 0x2c, 0xed, 0xb0, 0x87, 0x92, 0xdd, 0x85, 0x6e, 0xd9, 0x1d, 0x4a, 0x4f,
+	0x8b, 0xd8, 0x8c, 0xd5, 0x27, 0x48, 0x9b, 0x68, 0x83, 0xf6, 0x0f, 0xc9, 0x94, 0xa2, 0x08, 0x43,
+	0xda, 0x0c, 0x93, 0x40, 0xd2, 0x29, 0x93, 0x69, 0x30, 0x6f, 0xe0, 0xd1, 0x8b, 0x4f, 0xa0, 0x07,
+	0x1f, 0xc5, 0x63, 0x8f, 0x1e, 0xa5, 0x7d, 0x11, 0x49, 0xda, 0x2a, 0x5e, 0xbc, 0xcd, 0xfc, 0xbe,
+	0xdf, 0xc7, 0xc0, 0x37, 0xa0, 0xc1, 0x84, 0x97, 0x86, 0x32, 0x43, 0x69, 0x1b, 0x51, 0x19, 0x50,
+	0x41, 0x97, 0x31, 0x49, 0x42, 0x36, 0xa7, 0x42, 0x5f, 0x08, 0x2e, 0xb9, 0x06, 0x76, 0x86, 0x9e,
+	0xb6, 0xeb, 0xbf, 0x18, 0x67, 0xbc, 0xc0, 0x28, 0x3f, 0x6d, 0x8d, 0x66, 0x0a, 0x7e, 0x9a, 0x34,
+	0xa2, 0xcc, 0x93, 0xf4, 0x9c, 0x66, 0x89, 0x1b, 0xb2, 0x79, 0x3f, 0x61, 0xda, 0x3f, 0xb0, 0xaf,
+	0x92, 0xd0, 0xaf, 0xa9, 0x0d, 0xf5, 0x7f, 0xc5, 0xa9, 0xec, 0x88, 0xed, 0x6b, 0x7f, 0x41, 0x25,
+	0xf5, 0xa2, 0xd0, 0xf7, 0x24, 0x17, 0xb5, 0xd2, 0x36, 0x7d, 0x07, 0x5a, 0x13, 0xfc, 0xe0, 0x62,
+	0x16, 0xd0, 0x44, 0x8a, 0x42, 0xf8, 0x56, 0x08, 0x9f, 0xd8, 0xc1, 0xbd, 0x0a, 0xca, 0xf9, 0x63,
+	0x38, 0x5b, 0x50, 0xed, 0x0f, 0xf8, 0xed, 0xda, 0x67, 0x03, 0x82, 0x2f, 0x47, 0x16, 0x19, 0x0f,
+	0xdc, 0x91, 0xd5, 0xb5, 0x4f, 0x6d, 0xcb, 0xac, 0x2a, 0xda, 0x31, 0xd0, 0x3f, 0xa2, 0xa1, 0xd3,
+	0xed, 0x59, 0x2e, 0x76, 0x0c, 0x3c, 0x74, 0x48, 0x8e, 0x2d, 0x93, 0xf4, 0xc7, 0x17, 0xd8, 0xce,
+	0x2f, 0x64, 0x3c, 0x32, 0x0d, 0x6c, 0x55, 0x55, 0xed, 0x08, 0x1c, 0x7e, 0xdd, 0x99, 0xd8, 0xb8,
+	0x67, 0x3a, 0xc6, 0x84, 0x74, 0x0c, 0xdc, 0xed, 0x55, 0x4b, 0xf5, 0xf2, 0xed, 0x03, 0x54, 0x9e,
+	0x1e, 0xa1, 0xd2, 0xb9, 0x7e, 0x5e, 0x43, 0x75, 0xb5, 0x86, 0xea, 0xeb, 0x1a, 0xaa, 0x77, 0x1b,
+	0xa8, 0xac, 0x36, 0x50, 0x79, 0xd9, 0x40, 0xe5, 0xaa, 0xc3, 0x42, 0x19, 0x2c, 0xa7, 0xfa, 0x8c,
+	0xc7, 0xc8, 0x8b, 0x64, 0x40, 0xbd, 0xd6, 0x9c, 0x4a, 0x34, 0xe3, 0x49, 0xcc, 0x93, 0xd6, 0x6e,
+	0x9b, 0xd6, 0x54, 0x84, 0x3e, 0xa3, 0x28, 0xe6, 0xfe, 0x32, 0xa2, 0xe8, 0x06, 0xed, 0xbf, 0x48,
+	0x66, 0x0b, 0x9a, 0x4c, 0xbf, 0x17, 0xa3, 0x9f, 0xbc, 0x0d, 0x00, 0xdb, 0x90, 0x0e, 0xe0, 0xba,
+	0x01, 0x00, 0x00,
 }
+
+func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysSignMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysSignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintEthereumSigner(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEthereumSigner(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintEthereumSigner(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEthereumSigner(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEthereumSigner(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DelegateKeysSignMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovEthereumSigner(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEthereumSigner(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovEthereumSigner(uint64(l))
+	}
+	return n
+}
+
+func sovEthereumSigner(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEthereumSigner(x uint64) (n int) {
+	return sovEthereumSigner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEthereumSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateKeysSignMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateKeysSignMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthereumSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereumSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthereumSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereumSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEthereumSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEthereumSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEthereumSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEthereumSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEthereumSigner
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEthereumSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEthereumSigner(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEthereumSigner
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEthereumSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEthereumSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEthereumSigner
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEthereumSigner
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEthereumSigner
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEthereumSigner        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEthereumSigner          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEthereumSigner = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,14 +1,21 @@
 package types
 
 const (
-	EventTypeObservation               = "observation"
-	EventTypeOutgoingBatch             = "outgoing_batch"
-	EventTypeMultisigUpdateRequest     = "multisig_update_request"
-	EventTypeOutgoingBatchCanceled     = "outgoing_batch_canceled"
-	EventTypeOutgoingLogicCallCanceled = "outgoing_logic_call_canceled"
-	EventTypeBridgeWithdrawalReceived  = "withdrawal_received"
-	EventTypeBridgeDepositReceived     = "deposit_received"
-	EventTypeBridgeWithdrawCanceled    = "withdraw_canceled"
+	EventTypeObservation                = "observation"
+	EventTypeOutgoingBatch              = "outgoing_batch"
+	EventTypeMultisigUpdateRequest      = "multisig_update_request"
+	EventTypeOutgoingBatchCanceled      = "outgoing_batch_canceled"
+	EventTypeOutgoingLogicCallCanceled  = "outgoing_logic_call_canceled"
+	EventTypeOutgoingLogicCallExecuted  = "outgoing_logic_call_executed"
+	EventTypeBridgeWithdrawalReceived   = "withdrawal_received"
+	EventTypeBridgeDepositReceived      = "deposit_received"
+	EventTypeBridgeWithdrawCanceled     = "withdraw_canceled"
+	EventTypeValsetHijackDetected       = "valset_hijack_detected"
+	EventTypeDepositEscrowed            = "deposit_escrowed"
+	EventTypeDepositEscrowReleased      = "deposit_escrow_released"
+	EventTypeConflictingClaim           = "conflicting_claim"
+	EventTypeBadSignatureEvidenceBounty = "bad_signature_evidence_bounty"
+	EventTypeEthereumHeightObserved     = "ethereum_height_observed"
 
 	AttributeKeyAttestationID          = "attestation_id"
 	AttributeKeyBatchConfirmKey        = "batch_confirm_key"
@@ -27,4 +34,12 @@ const (
 	AttributeKeyInvalidationNonce      = "logic_call_invalidation_nonce"
 	AttributeKeyBadEthSignature        = "bad_eth_signature"
 	AttributeKeyBadEthSignatureSubject = "bad_eth_signature_subject"
+	AttributeKeyHijackReason           = "hijack_reason"
+	AttributeKeyReceiver               = "receiver"
+	AttributeKeyRecipient              = "recipient"
+	AttributeKeyAmount                 = "amount"
+	AttributeKeyValidator              = "validator"
+	AttributeKeyClaimHash              = "claim_hash"
+	AttributeKeyObservedClaimHash      = "observed_claim_hash"
+	AttributeKeyEthereumHeight         = "ethereum_height"
 )
@@ -44,6 +44,7 @@ type SlashingKeeper interface {
 
 type DistributionKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
 	GetFeePool(ctx sdk.Context) (feePool types.FeePool)
 	SetFeePool(ctx sdk.Context, feePool types.FeePool)
 }
@@ -17,7 +17,8 @@ const (
 )
 
 var (
-	// AttestationVotesPowerThreshold threshold of votes power to succeed
+	// AttestationVotesPowerThreshold threshold of votes power to succeed, in percent, for the
+	// claim types which do not have a threshold set in ParamStoreAttestationThresholds
 	AttestationVotesPowerThreshold = sdk.NewInt(66)
 
 	// ParamsStoreKeyGravityID stores the gravity id
@@ -77,6 +78,79 @@ var (
 	// to be allowed as it must continue to ensure bridge continuity.
 	ParamStoreBridgeActive = []byte("BridgeActive")
 
+	// ParamStoreTransferStatusRetentionBlocks stores the number of blocks the status of an executed or
+	// cancelled outgoing transfer is kept for
+	ParamStoreTransferStatusRetentionBlocks = []byte("TransferStatusRetentionBlocks")
+
+	// ParamStoreAttestationPowerPolicy stores whether attestations are tallied with the power of the
+	// voters when they voted or with their current power
+	ParamStoreAttestationPowerPolicy = []byte("AttestationPowerPolicy")
+
+	// ParamStoreAttestationThresholds stores the share of the voting power required to observe
+	// an attestation of each claim type
+	ParamStoreAttestationThresholds = []byte("AttestationThresholds")
+
+	// ParamStoreOracleLivenessWindow stores the number of blocks a validator has to submit a claim
+	// for an event after it was first claimed
+	ParamStoreOracleLivenessWindow = []byte("OracleLivenessWindow")
+
+	// ParamStoreSlashFractionOracleLiveness stores the slash fraction for not submitting a claim
+	// within the oracle liveness window
+	ParamStoreSlashFractionOracleLiveness = []byte("SlashFractionOracleLiveness")
+
+	// ParamStoreSlashFractionConflictingClaim stores the slash fraction for voting for an attestation
+	// which lost to a different attestation at the same event nonce
+	ParamStoreSlashFractionConflictingClaim = []byte("SlashFractionConflictingClaim")
+
+	// ParamStoreConfirmSigningWindow stores the number of signing requests a validator's missed
+	// confirmations are counted over
+	ParamStoreConfirmSigningWindow = []byte("ConfirmSigningWindow")
+
+	// ParamStoreMinSignedPerWindow stores the share of the signing window a validator must confirm
+	// to avoid being slashed
+	ParamStoreMinSignedPerWindow = []byte("MinSignedPerWindow")
+
+	// ParamStoreBadSignatureEvidenceBountyFraction stores the share of the slashed stake paid to the
+	// sender of bad signature evidence
+	ParamStoreBadSignatureEvidenceBountyFraction = []byte("BadSignatureEvidenceBountyFraction")
+
+	// ParamStoreBadSignatureEvidenceBounty stores the fixed bounty paid from the community pool to the
+	// sender of bad signature evidence
+	ParamStoreBadSignatureEvidenceBounty = []byte("BadSignatureEvidenceBounty")
+
+	// ParamStoreEthereumHeightHistoryBlocks stores the number of blocks observed Ethereum heights are kept
+	// for to calibrate the average block times
+	ParamStoreEthereumHeightHistoryBlocks = []byte("EthereumHeightHistoryBlocks")
+
+	// ParamStoreMinAverageBlockTime stores the lower bound of the calibrated average Cosmos block time
+	ParamStoreMinAverageBlockTime = []byte("MinAverageBlockTime")
+
+	// ParamStoreMaxAverageBlockTime stores the upper bound of the calibrated average Cosmos block time
+	ParamStoreMaxAverageBlockTime = []byte("MaxAverageBlockTime")
+
+	// ParamStoreMinAverageEthereumBlockTime stores the lower bound of the calibrated average Ethereum block time
+	ParamStoreMinAverageEthereumBlockTime = []byte("MinAverageEthereumBlockTime")
+
+	// ParamStoreMaxAverageEthereumBlockTime stores the upper bound of the calibrated average Ethereum block time
+	ParamStoreMaxAverageEthereumBlockTime = []byte("MaxAverageEthereumBlockTime")
+
+	// ParamStoreBatchSizeLimits stores the largest number of transactions a batch of each token may contain
+	ParamStoreBatchSizeLimits = []byte("BatchSizeLimits")
+
+	// ParamStoreBatchSelectionPolicy stores the order transactions are taken from the pool in
+	ParamStoreBatchSelectionPolicy = []byte("BatchSelectionPolicy")
+
+	// ParamStoreBatchAgeWeight stores the fee score bonus per block waited of the age weighted selection policy
+	ParamStoreBatchAgeWeight = []byte("BatchAgeWeight")
+
+	// ParamStoreAutoBatchFeeThresholds stores the total fee each token's pool must pay before it is batched
+	// automatically
+	ParamStoreAutoBatchFeeThresholds = []byte("AutoBatchFeeThresholds")
+
+	// ParamStoreAutoBatchMaxTxAge stores the number of blocks a transfer may wait in the pool before its token
+	// is batched automatically
+	ParamStoreAutoBatchMaxTxAge = []byte("AutoBatchMaxTxAge")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{
 		GravityId:                    "",
@@ -98,9 +172,32 @@ var (
 			Denom:  "",
 			Amount: sdk.Int{},
 		},
-		ResetBridgeState: false,
-		ResetBridgeNonce: 0,
-		BridgeActive:     true,
+		ResetBridgeState:                   false,
+		ResetBridgeNonce:                   0,
+		BridgeActive:                       true,
+		TransferStatusRetentionBlocks:      0,
+		AttestationPowerPolicy:             ATTESTATION_POWER_POLICY_CURRENT,
+		AttestationThresholds:              []ClaimTypeThreshold{},
+		OracleLivenessWindow:               0,
+		SlashFractionOracleLiveness:        sdk.Dec{},
+		SlashFractionConflictingClaim:      sdk.Dec{},
+		ConfirmSigningWindow:               0,
+		MinSignedPerWindow:                 sdk.Dec{},
+		BadSignatureEvidenceBountyFraction: sdk.Dec{},
+		BadSignatureEvidenceBounty: sdk.Coin{
+			Denom:  "",
+			Amount: sdk.Int{},
+		},
+		EthereumHeightHistoryBlocks: 0,
+		MinAverageBlockTime:         0,
+		MaxAverageBlockTime:         0,
+		MinAverageEthereumBlockTime: 0,
+		MaxAverageEthereumBlockTime: 0,
+		BatchSizeLimits:             []BatchSizeLimit{},
+		BatchSelectionPolicy:        BATCH_SELECTION_POLICY_FEE_DESC,
+		BatchAgeWeight:              sdk.Dec{},
+		AutoBatchFeeThresholds:      []AutoBatchFeeThreshold{},
+		AutoBatchMaxTxAge:           0,
 	}
 )
 
@@ -129,6 +226,36 @@ func DefaultGenesisState() *GenesisState {
 		DelegateKeys:       []MsgSetOrchestratorAddress{},
 		Erc20ToDenoms:      []ERC20ToDenom{},
 		UnbatchedTransfers: []OutgoingTransferTx{},
+		GravityNonces: GravityNonces{
+			LatestValsetNonce:         0,
+			LastSlashedValsetNonce:    0,
+			LastSlashedBatchBlock:     0,
+			LastSlashedLogicCallBlock: 0,
+			LastUnbondingBlockHeight:  0,
+			LastTxPoolId:              0,
+			LastBatchId:               0,
+		},
+		LastEventNonces: []LastEventNonceByValidator{},
+		LastObservedEthereumHeight: LastObservedEthereumBlockHeight{
+			CosmosBlockHeight:   0,
+			EthereumBlockHeight: 0,
+		},
+		LastObservedValset:          nil,
+		PastEthSignatureCheckpoints: [][]byte{},
+		ValsetHijackIncidents:       []ValsetHijackIncident{},
+		PastDelegateKeys:            []PastDelegateKey{},
+		TransferStatuses:            []TransferStatus{},
+		DepositRecords:              []DepositRecord{},
+		DepositEscrows:              []DepositEscrow{},
+		BridgeEscrow:                sdk.Coins{},
+		OracleLivenessSlashes:       []LastEventNonceByValidator{},
+		ConflictingClaims:           []ConflictingClaim{},
+		ConfirmSigningInfos:         []ConfirmSigningInfo{},
+		BadSignatureEvidence:        [][]byte{},
+		SlashRecords:                []SlashRecord{},
+		EthereumHeightVotes:         []EthereumHeightVote{},
+		EthereumHeightSamples:       []EthereumHeightSample{},
+		TransferMinimums:            []TransferMinimum{},
 	}
 }
 
@@ -152,6 +279,47 @@ func DefaultParams() *Params {
 		SlashFractionBadEthSignature: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
 		ValsetReward:                 sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
 		BridgeActive:                 true,
+		// one week of 5 second blocks
+		TransferStatusRetentionBlocks:      120960,
+		AttestationPowerPolicy:             ATTESTATION_POWER_POLICY_CURRENT,
+		AttestationThresholds:              DefaultAttestationThresholds(),
+		OracleLivenessWindow:               10000,
+		SlashFractionOracleLiveness:        sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		SlashFractionConflictingClaim:      sdk.NewDec(1).Quo(sdk.NewDec(1000)),
+		ConfirmSigningWindow:               100,
+		MinSignedPerWindow:                 sdk.NewDecWithPrec(5, 1),
+		BadSignatureEvidenceBountyFraction: sdk.NewDecWithPrec(1, 1),
+		BadSignatureEvidenceBounty:         sdk.Coin{Denom: "", Amount: sdk.ZeroInt()},
+		// one day of 5 second blocks
+		EthereumHeightHistoryBlocks: 17280,
+		MinAverageBlockTime:         1000,
+		MaxAverageBlockTime:         30000,
+		MinAverageEthereumBlockTime: 5000,
+		MaxAverageEthereumBlockTime: 30000,
+		BatchSizeLimits:             []BatchSizeLimit{},
+		BatchSelectionPolicy:        BATCH_SELECTION_POLICY_FEE_DESC,
+		// doubles the score of a transaction after 1000 blocks
+		BatchAgeWeight:         sdk.NewDecWithPrec(1, 3),
+		AutoBatchFeeThresholds: []AutoBatchFeeThreshold{},
+		AutoBatchMaxTxAge:      0,
+	}
+}
+
+// DefaultAttestationThreshold returns the share of the voting power required to observe an attestation
+// of a claim type which has no threshold in the params
+func DefaultAttestationThreshold() sdk.Dec {
+	return sdk.NewDecFromInt(AttestationVotesPowerThreshold).QuoInt64(100)
+}
+
+// DefaultAttestationThresholds returns the default threshold for every claim type
+func DefaultAttestationThresholds() []ClaimTypeThreshold {
+	return []ClaimTypeThreshold{
+		{ClaimType: CLAIM_TYPE_SEND_TO_COSMOS, Threshold: DefaultAttestationThreshold()},
+		{ClaimType: CLAIM_TYPE_BATCH_SEND_TO_ETH, Threshold: DefaultAttestationThreshold()},
+		{ClaimType: CLAIM_TYPE_ERC20_DEPLOYED, Threshold: DefaultAttestationThreshold()},
+		{ClaimType: CLAIM_TYPE_LOGIC_CALL_EXECUTED, Threshold: DefaultAttestationThreshold()},
+		{ClaimType: CLAIM_TYPE_VALSET_UPDATED, Threshold: DefaultAttestationThreshold()},
+		{ClaimType: CLAIM_TYPE_ETHEREUM_HEIGHT, Threshold: DefaultAttestationThreshold()},
 	}
 }
 
@@ -211,6 +379,72 @@ func (p Params) ValidateBasic() error {
 	if err := validateResetBridgeNonce(p.ResetBridgeNonce); err != nil {
 		return sdkerrors.Wrap(err, "Reset Bridge Nonce")
 	}
+	if err := validateTransferStatusRetentionBlocks(p.TransferStatusRetentionBlocks); err != nil {
+		return sdkerrors.Wrap(err, "transfer status retention blocks")
+	}
+	if err := validateAttestationPowerPolicy(p.AttestationPowerPolicy); err != nil {
+		return sdkerrors.Wrap(err, "attestation power policy")
+	}
+	if err := validateAttestationThresholds(p.AttestationThresholds); err != nil {
+		return sdkerrors.Wrap(err, "attestation thresholds")
+	}
+	if err := validateOracleLivenessWindow(p.OracleLivenessWindow); err != nil {
+		return sdkerrors.Wrap(err, "oracle liveness window")
+	}
+	if err := validateSlashFractionOracleLiveness(p.SlashFractionOracleLiveness); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction oracle liveness")
+	}
+	if err := validateSlashFractionConflictingClaim(p.SlashFractionConflictingClaim); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction conflicting claim")
+	}
+	if err := validateConfirmSigningWindow(p.ConfirmSigningWindow); err != nil {
+		return sdkerrors.Wrap(err, "confirm signing window")
+	}
+	if err := validateMinSignedPerWindow(p.MinSignedPerWindow); err != nil {
+		return sdkerrors.Wrap(err, "min signed per window")
+	}
+	if err := validateBadSignatureEvidenceBountyFraction(p.BadSignatureEvidenceBountyFraction); err != nil {
+		return sdkerrors.Wrap(err, "bad signature evidence bounty fraction")
+	}
+	if err := validateBadSignatureEvidenceBounty(p.BadSignatureEvidenceBounty); err != nil {
+		return sdkerrors.Wrap(err, "bad signature evidence bounty")
+	}
+	if err := validateEthereumHeightHistoryBlocks(p.EthereumHeightHistoryBlocks); err != nil {
+		return sdkerrors.Wrap(err, "ethereum height history blocks")
+	}
+	if err := validateMinAverageBlockTime(p.MinAverageBlockTime); err != nil {
+		return sdkerrors.Wrap(err, "min average block time")
+	}
+	if err := validateMaxAverageBlockTime(p.MaxAverageBlockTime); err != nil {
+		return sdkerrors.Wrap(err, "max average block time")
+	}
+	if p.MinAverageBlockTime > p.MaxAverageBlockTime {
+		return fmt.Errorf("min average block time %d is above max average block time %d", p.MinAverageBlockTime, p.MaxAverageBlockTime)
+	}
+	if err := validateMinAverageEthereumBlockTime(p.MinAverageEthereumBlockTime); err != nil {
+		return sdkerrors.Wrap(err, "min average ethereum block time")
+	}
+	if err := validateMaxAverageEthereumBlockTime(p.MaxAverageEthereumBlockTime); err != nil {
+		return sdkerrors.Wrap(err, "max average ethereum block time")
+	}
+	if p.MinAverageEthereumBlockTime > p.MaxAverageEthereumBlockTime {
+		return fmt.Errorf("min average ethereum block time %d is above max average ethereum block time %d", p.MinAverageEthereumBlockTime, p.MaxAverageEthereumBlockTime)
+	}
+	if err := validateBatchSizeLimits(p.BatchSizeLimits); err != nil {
+		return sdkerrors.Wrap(err, "batch size limits")
+	}
+	if err := validateBatchSelectionPolicy(p.BatchSelectionPolicy); err != nil {
+		return sdkerrors.Wrap(err, "batch selection policy")
+	}
+	if err := validateBatchAgeWeight(p.BatchAgeWeight); err != nil {
+		return sdkerrors.Wrap(err, "batch age weight")
+	}
+	if err := validateAutoBatchFeeThresholds(p.AutoBatchFeeThresholds); err != nil {
+		return sdkerrors.Wrap(err, "auto batch fee thresholds")
+	}
+	if err := validateAutoBatchMaxTxAge(p.AutoBatchMaxTxAge); err != nil {
+		return sdkerrors.Wrap(err, "auto batch max tx age")
+	}
 	return nil
 }
 
@@ -261,6 +495,26 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreResetBridgeState, &p.ResetBridgeState, validateResetBridgeState),
 		paramtypes.NewParamSetPair(ParamStoreResetBridgeNonce, &p.ResetBridgeNonce, validateResetBridgeNonce),
 		paramtypes.NewParamSetPair(ParamStoreBridgeActive, &p.BridgeActive, validateBridgeActive),
+		paramtypes.NewParamSetPair(ParamStoreTransferStatusRetentionBlocks, &p.TransferStatusRetentionBlocks, validateTransferStatusRetentionBlocks),
+		paramtypes.NewParamSetPair(ParamStoreAttestationPowerPolicy, &p.AttestationPowerPolicy, validateAttestationPowerPolicy),
+		paramtypes.NewParamSetPair(ParamStoreAttestationThresholds, &p.AttestationThresholds, validateAttestationThresholds),
+		paramtypes.NewParamSetPair(ParamStoreOracleLivenessWindow, &p.OracleLivenessWindow, validateOracleLivenessWindow),
+		paramtypes.NewParamSetPair(ParamStoreSlashFractionOracleLiveness, &p.SlashFractionOracleLiveness, validateSlashFractionOracleLiveness),
+		paramtypes.NewParamSetPair(ParamStoreSlashFractionConflictingClaim, &p.SlashFractionConflictingClaim, validateSlashFractionConflictingClaim),
+		paramtypes.NewParamSetPair(ParamStoreConfirmSigningWindow, &p.ConfirmSigningWindow, validateConfirmSigningWindow),
+		paramtypes.NewParamSetPair(ParamStoreMinSignedPerWindow, &p.MinSignedPerWindow, validateMinSignedPerWindow),
+		paramtypes.NewParamSetPair(ParamStoreBadSignatureEvidenceBountyFraction, &p.BadSignatureEvidenceBountyFraction, validateBadSignatureEvidenceBountyFraction),
+		paramtypes.NewParamSetPair(ParamStoreBadSignatureEvidenceBounty, &p.BadSignatureEvidenceBounty, validateBadSignatureEvidenceBounty),
+		paramtypes.NewParamSetPair(ParamStoreEthereumHeightHistoryBlocks, &p.EthereumHeightHistoryBlocks, validateEthereumHeightHistoryBlocks),
+		paramtypes.NewParamSetPair(ParamStoreMinAverageBlockTime, &p.MinAverageBlockTime, validateMinAverageBlockTime),
+		paramtypes.NewParamSetPair(ParamStoreMaxAverageBlockTime, &p.MaxAverageBlockTime, validateMaxAverageBlockTime),
+		paramtypes.NewParamSetPair(ParamStoreMinAverageEthereumBlockTime, &p.MinAverageEthereumBlockTime, validateMinAverageEthereumBlockTime),
+		paramtypes.NewParamSetPair(ParamStoreMaxAverageEthereumBlockTime, &p.MaxAverageEthereumBlockTime, validateMaxAverageEthereumBlockTime),
+		paramtypes.NewParamSetPair(ParamStoreBatchSizeLimits, &p.BatchSizeLimits, validateBatchSizeLimits),
+		paramtypes.NewParamSetPair(ParamStoreBatchSelectionPolicy, &p.BatchSelectionPolicy, validateBatchSelectionPolicy),
+		paramtypes.NewParamSetPair(ParamStoreBatchAgeWeight, &p.BatchAgeWeight, validateBatchAgeWeight),
+		paramtypes.NewParamSetPair(ParamStoreAutoBatchFeeThresholds, &p.AutoBatchFeeThresholds, validateAutoBatchFeeThresholds),
+		paramtypes.NewParamSetPair(ParamStoreAutoBatchMaxTxAge, &p.AutoBatchMaxTxAge, validateAutoBatchMaxTxAge),
 	}
 }
 
@@ -434,6 +688,218 @@ func validateBridgeActive(i interface{}) error {
 	return nil
 }
 
+func validateTransferStatusRetentionBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAttestationPowerPolicy(i interface{}) error {
+	v, ok := i.(AttestationPowerPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := AttestationPowerPolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown attestation power policy %d", v)
+	}
+	return nil
+}
+
+func validateAttestationThresholds(i interface{}) error {
+	v, ok := i.([]ClaimTypeThreshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	half := sdk.NewDecWithPrec(5, 1)
+	seen := make(map[ClaimType]bool, len(v))
+	for _, t := range v {
+		if _, ok := ClaimType_name[int32(t.ClaimType)]; !ok || t.ClaimType == CLAIM_TYPE_UNSPECIFIED {
+			return fmt.Errorf("invalid claim type %d", t.ClaimType)
+		}
+		if seen[t.ClaimType] {
+			return fmt.Errorf("duplicate threshold for %s", t.ClaimType)
+		}
+		seen[t.ClaimType] = true
+		if t.Threshold.IsNil() || t.Threshold.LTE(half) || t.Threshold.GT(sdk.OneDec()) {
+			return fmt.Errorf("threshold for %s must be above 0.5 and at most 1: %s", t.ClaimType, t.Threshold)
+		}
+	}
+	return nil
+}
+
+func validateOracleLivenessWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSlashFractionOracleLiveness(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateSlashFractionConflictingClaim(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateConfirmSigningWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMinSignedPerWindow(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min signed per window must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateBadSignatureEvidenceBountyFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("bounty fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateBadSignatureEvidenceBounty(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// the zero value without a denom disables the fixed bounty
+	if v.Denom == "" && (v.Amount.IsNil() || v.Amount.IsZero()) {
+		return nil
+	}
+	if err := sdk.ValidateDenom(v.Denom); err != nil {
+		return fmt.Errorf("invalid bounty denom: %s", err)
+	}
+	if v.Amount.IsNil() || v.Amount.IsNegative() {
+		return fmt.Errorf("bounty amount must not be negative: %s", v.Amount)
+	}
+	return nil
+}
+
+func validateEthereumHeightHistoryBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMinAverageBlockTime(i interface{}) error {
+	return validateAverageBlockTime(i)
+}
+
+func validateMaxAverageBlockTime(i interface{}) error {
+	return validateAverageBlockTime(i)
+}
+
+func validateMinAverageEthereumBlockTime(i interface{}) error {
+	return validateAverageEthereumBlockTime(i)
+}
+
+func validateMaxAverageEthereumBlockTime(i interface{}) error {
+	return validateAverageEthereumBlockTime(i)
+}
+
+func validateBatchSizeLimits(i interface{}) error {
+	v, ok := i.([]BatchSizeLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, l := range v {
+		token, err := NewEthAddress(l.Token)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid token %s", l.Token)
+		}
+		if seen[strings.ToLower(token.GetAddress())] {
+			return fmt.Errorf("duplicate batch size limit for %s", token.GetAddress())
+		}
+		seen[strings.ToLower(token.GetAddress())] = true
+		if l.MaxBatchSize == 0 {
+			return fmt.Errorf("batch size limit for %s must be positive", token.GetAddress())
+		}
+	}
+	return nil
+}
+
+func validateBatchSelectionPolicy(i interface{}) error {
+	v, ok := i.(BatchSelectionPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := BatchSelectionPolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown batch selection policy %d", v)
+	}
+	return nil
+}
+
+func validateBatchAgeWeight(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("batch age weight must not be negative: %s", v)
+	}
+	return nil
+}
+
+func validateAutoBatchFeeThresholds(i interface{}) error {
+	v, ok := i.([]AutoBatchFeeThreshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(v))
+	for _, t := range v {
+		token, err := NewEthAddress(t.Token)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid token %s", t.Token)
+		}
+		if seen[strings.ToLower(token.GetAddress())] {
+			return fmt.Errorf("duplicate auto batch fee threshold for %s", token.GetAddress())
+		}
+		seen[strings.ToLower(token.GetAddress())] = true
+		if t.MinTotalFee.IsNil() || !t.MinTotalFee.IsPositive() {
+			return fmt.Errorf("auto batch fee threshold for %s must be positive", token.GetAddress())
+		}
+	}
+	return nil
+}
+
+func validateAutoBatchMaxTxAge(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
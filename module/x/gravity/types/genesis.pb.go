@@ -66,26 +66,132 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // set and steal funds on Ethereum without consequence.
 // The practical outcome of this flag being set to 'false' is that deposits from Ethereum will not show up and withdraws from
 // Cosmos will not execute on Ethereum.
+//
+// transfer_status_retention_blocks
+//
+// The number of blocks the status of an outgoing transfer is kept for after the transfer has been executed
+// on Ethereum or cancelled, zero keeps the status of every transfer forever.
+//
+// attestation_power_policy
+//
+// Selects whether attestations are tallied with the power each voter held when their vote was cast
+// (snapshot) or with the power they hold at tally time (current). Snapshots keep the oracle threshold
+// predictable when a lot of stake moves between the vote and the tally, for example during large redelegations.
+//
+// attestation_thresholds
+//
+// The share of the voting power which must vote for an attestation of a claim type before it is observed, each
+// threshold must be above 1/2. Claim types without a threshold use the default of 66%. This allows governance to
+// require more votes for events like validator set updates than for routine ones. For the Ethereum height
+// heartbeat it is the share of the voting power which must have claimed a height before it is observed.
+//
+// oracle_liveness_window
+// slash_fraction_oracle_liveness
+//
+// A validator which has not submitted a claim for an event that was observed more than oracle_liveness_window
+// blocks after it was first claimed is slashed by slash_fraction_oracle_liveness and jailed, once for every time
+// it falls behind. Unbonding validators are held to this for unbond_slashing_valsets_window blocks after they
+// started unbonding. A window of zero disables oracle liveness slashing.
+//
+// slash_fraction_conflicting_claim
+//
+// The fraction a validator is slashed by for voting for an attestation which lost to a different attestation at
+// the same event nonce, the validator is not jailed since this is usually caused by a faulty Ethereum node.
+//
+// confirm_signing_window
+// min_signed_per_window
+//
+// Like the x/slashing liveness window, every valset, batch and logic call a validator is required to confirm
+// is counted in a window of the last confirm_signing_window signing requests. A validator is only slashed and
+// jailed for a missed confirmation once it has signed less than min_signed_per_window of its window, after
+// which its window starts over. A window of zero slashes a validator for every missed confirmation.
+//
+// bad_signature_evidence_bounty_fraction
+// bad_signature_evidence_bounty
+//
+// The bounty paid to the sender of bad signature evidence which slashed a validator, so that outside watchers
+// have a reason to submit it. The sender receives bad_signature_evidence_bounty_fraction of the stake slashed
+// from the validator, which is minted back out of the burned stake, and bad_signature_evidence_bounty from the
+// community pool if the pool can afford it. As with valset_reward a coin with a blank denom or zero amount pays
+// no fixed bounty.
+//
+// ethereum_height_history_blocks
+//
+// The number of Cosmos blocks the observed Ethereum heights are kept for. The real average Cosmos and Ethereum
+// block times are computed from the oldest and newest observation in this window and used in place of
+// average_block_time and average_ethereum_block_time to project batch timeouts. Zero disables the calibration.
+//
+// min_average_block_time
+// max_average_block_time
+// min_average_ethereum_block_time
+// max_average_ethereum_block_time
+//
+// The bounds in milliseconds the calibrated average block times are clamped to, so that a burst of empty
+// blocks or an Ethereum outage can not push batch timeouts arbitrarily far into the future or the past.
+//
+// batch_size_limits
+//
+// The largest number of transactions a batch of a token may contain, tokens without a limit are batched 100
+// transactions at a time. Tokens which are expensive to transfer on Ethereum may need smaller batches to stay
+// under the block gas limit.
+//
+// batch_selection_policy
+// batch_age_weight
+//
+// Selects the order transactions are taken from the pool in when a batch is built, and which batch is considered
+// more profitable than the last one. Fee descending takes the highest fees first, FIFO takes the transactions in
+// the order they were sent. Age weighted ranks transactions by their fee times 1 + batch_age_weight for every
+// block they have waited in the pool, so that low fee transfers are eventually batched instead of starving.
+//
+// auto_batch_fee_thresholds
+// auto_batch_max_tx_age
+//
+// Batches are normally only built when a relayer sends MsgRequestBatch. At the end of every block a batch of a
+// token is also built once the fees of the batch it would have reach its auto_batch_fee_threshold, or once its
+// oldest pooled transfer was sent more than auto_batch_max_tx_age blocks ago, so that tokens without an active
+// relayer do not sit in the pool forever. As with MsgRequestBatch a batch is only built if it would be more
+// profitable than the last batch of the token. Tokens without a threshold and a max age of zero are never
+// batched automatically.
 type Params struct {
-	GravityId                    string                                 `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
-	ContractSourceHash           string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
-	BridgeEthereumAddress        string                                 `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
-	BridgeChainId                uint64                                 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
-	SignedValsetsWindow          uint64                                 `protobuf:"varint,6,opt,name=signed_valsets_window,json=signedValsetsWindow,proto3" json:"signed_valsets_window,omitempty"`
-	SignedBatchesWindow          uint64                                 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
-	SignedLogicCallsWindow       uint64                                 `protobuf:"varint,8,opt,name=signed_logic_calls_window,json=signedLogicCallsWindow,proto3" json:"signed_logic_calls_window,omitempty"`
-	TargetBatchTimeout           uint64                                 `protobuf:"varint,9,opt,name=target_batch_timeout,json=targetBatchTimeout,proto3" json:"target_batch_timeout,omitempty"`
-	AverageBlockTime             uint64                                 `protobuf:"varint,10,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
-	AverageEthereumBlockTime     uint64                                 `protobuf:"varint,11,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
-	SlashFractionValset          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=slash_fraction_valset,json=slashFractionValset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_valset"`
-	SlashFractionBatch           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
-	SlashFractionLogicCall       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_logic_call,json=slashFractionLogicCall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_logic_call"`
-	UnbondSlashingValsetsWindow  uint64                                 `protobuf:"varint,15,opt,name=unbond_slashing_valsets_window,json=unbondSlashingValsetsWindow,proto3" json:"unbond_slashing_valsets_window,omitempty"`
-	SlashFractionBadEthSignature github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_bad_eth_signature,json=slashFractionBadEthSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_bad_eth_signature"`
-	ValsetReward                 types.Coin                             `protobuf:"bytes,17,opt,name=valset_reward,json=valsetReward,proto3" json:"valset_reward"`
-	ResetBridgeState             bool                                   `protobuf:"varint,18,opt,name=reset_bridge_state,json=resetBridgeState,proto3" json:"reset_bridge_state,omitempty"`
-	ResetBridgeNonce             uint64                                 `protobuf:"varint,19,opt,name=reset_bridge_nonce,json=resetBridgeNonce,proto3" json:"reset_bridge_nonce,omitempty"`
-	BridgeActive                 bool                                   `protobuf:"varint,20,opt,name=bridge_active,json=bridgeActive,proto3" json:"bridge_active,omitempty"`
+	GravityId                          string                                 `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	ContractSourceHash                 string                                 `protobuf:"bytes,2,opt,name=contract_source_hash,json=contractSourceHash,proto3" json:"contract_source_hash,omitempty"`
+	BridgeEthereumAddress              string                                 `protobuf:"bytes,4,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	BridgeChainId                      uint64                                 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+	SignedValsetsWindow                uint64                                 `protobuf:"varint,6,opt,name=signed_valsets_window,json=signedValsetsWindow,proto3" json:"signed_valsets_window,omitempty"`
+	SignedBatchesWindow                uint64                                 `protobuf:"varint,7,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
+	SignedLogicCallsWindow             uint64                                 `protobuf:"varint,8,opt,name=signed_logic_calls_window,json=signedLogicCallsWindow,proto3" json:"signed_logic_calls_window,omitempty"`
+	TargetBatchTimeout                 uint64                                 `protobuf:"varint,9,opt,name=target_batch_timeout,json=targetBatchTimeout,proto3" json:"target_batch_timeout,omitempty"`
+	AverageBlockTime                   uint64                                 `protobuf:"varint,10,opt,name=average_block_time,json=averageBlockTime,proto3" json:"average_block_time,omitempty"`
+	AverageEthereumBlockTime           uint64                                 `protobuf:"varint,11,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
+	SlashFractionValset                github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=slash_fraction_valset,json=slashFractionValset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_valset"`
+	SlashFractionBatch                 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
+	SlashFractionLogicCall             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_logic_call,json=slashFractionLogicCall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_logic_call"`
+	UnbondSlashingValsetsWindow        uint64                                 `protobuf:"varint,15,opt,name=unbond_slashing_valsets_window,json=unbondSlashingValsetsWindow,proto3" json:"unbond_slashing_valsets_window,omitempty"`
+	SlashFractionBadEthSignature       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=slash_fraction_bad_eth_signature,json=slashFractionBadEthSignature,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_bad_eth_signature"`
+	ValsetReward                       types.Coin                             `protobuf:"bytes,17,opt,name=valset_reward,json=valsetReward,proto3" json:"valset_reward"`
+	ResetBridgeState                   bool                                   `protobuf:"varint,18,opt,name=reset_bridge_state,json=resetBridgeState,proto3" json:"reset_bridge_state,omitempty"`
+	ResetBridgeNonce                   uint64                                 `protobuf:"varint,19,opt,name=reset_bridge_nonce,json=resetBridgeNonce,proto3" json:"reset_bridge_nonce,omitempty"`
+	BridgeActive                       bool                                   `protobuf:"varint,20,opt,name=bridge_active,json=bridgeActive,proto3" json:"bridge_active,omitempty"`
+	TransferStatusRetentionBlocks      uint64                                 `protobuf:"varint,21,opt,name=transfer_status_retention_blocks,json=transferStatusRetentionBlocks,proto3" json:"transfer_status_retention_blocks,omitempty"`
+	AttestationPowerPolicy             AttestationPowerPolicy                 `protobuf:"varint,22,opt,name=attestation_power_policy,json=attestationPowerPolicy,proto3,enum=gravity.v1.AttestationPowerPolicy" json:"attestation_power_policy,omitempty"`
+	AttestationThresholds              []ClaimTypeThreshold                   `protobuf:"bytes,23,rep,name=attestation_thresholds,json=attestationThresholds,proto3" json:"attestation_thresholds"`
+	OracleLivenessWindow               uint64                                 `protobuf:"varint,24,opt,name=oracle_liveness_window,json=oracleLivenessWindow,proto3" json:"oracle_liveness_window,omitempty"`
+	SlashFractionOracleLiveness        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,25,opt,name=slash_fraction_oracle_liveness,json=slashFractionOracleLiveness,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_oracle_liveness"`
+	SlashFractionConflictingClaim      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,26,opt,name=slash_fraction_conflicting_claim,json=slashFractionConflictingClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_claim"`
+	ConfirmSigningWindow               uint64                                 `protobuf:"varint,27,opt,name=confirm_signing_window,json=confirmSigningWindow,proto3" json:"confirm_signing_window,omitempty"`
+	MinSignedPerWindow                 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,28,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signed_per_window"`
+	BadSignatureEvidenceBountyFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,29,opt,name=bad_signature_evidence_bounty_fraction,json=badSignatureEvidenceBountyFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bad_signature_evidence_bounty_fraction"`
+	BadSignatureEvidenceBounty         types.Coin                             `protobuf:"bytes,30,opt,name=bad_signature_evidence_bounty,json=badSignatureEvidenceBounty,proto3" json:"bad_signature_evidence_bounty"`
+	EthereumHeightHistoryBlocks        uint64                                 `protobuf:"varint,31,opt,name=ethereum_height_history_blocks,json=ethereumHeightHistoryBlocks,proto3" json:"ethereum_height_history_blocks,omitempty"`
+	MinAverageBlockTime                uint64                                 `protobuf:"varint,32,opt,name=min_average_block_time,json=minAverageBlockTime,proto3" json:"min_average_block_time,omitempty"`
+	MaxAverageBlockTime                uint64                                 `protobuf:"varint,33,opt,name=max_average_block_time,json=maxAverageBlockTime,proto3" json:"max_average_block_time,omitempty"`
+	MinAverageEthereumBlockTime        uint64                                 `protobuf:"varint,34,opt,name=min_average_ethereum_block_time,json=minAverageEthereumBlockTime,proto3" json:"min_average_ethereum_block_time,omitempty"`
+	MaxAverageEthereumBlockTime        uint64                                 `protobuf:"varint,35,opt,name=max_average_ethereum_block_time,json=maxAverageEthereumBlockTime,proto3" json:"max_average_ethereum_block_time,omitempty"`
+	BatchSizeLimits                    []BatchSizeLimit                       `protobuf:"bytes,36,rep,name=batch_size_limits,json=batchSizeLimits,proto3" json:"batch_size_limits"`
+	BatchSelectionPolicy               BatchSelectionPolicy                   `protobuf:"varint,37,opt,name=batch_selection_policy,json=batchSelectionPolicy,proto3,enum=gravity.v1.BatchSelectionPolicy" json:"batch_selection_policy,omitempty"`
+	BatchAgeWeight                     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,38,opt,name=batch_age_weight,json=batchAgeWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"batch_age_weight"`
+	AutoBatchFeeThresholds             []AutoBatchFeeThreshold                `protobuf:"bytes,39,rep,name=auto_batch_fee_thresholds,json=autoBatchFeeThresholds,proto3" json:"auto_batch_fee_thresholds"`
+	AutoBatchMaxTxAge                  uint64                                 `protobuf:"varint,40,opt,name=auto_batch_max_tx_age,json=autoBatchMaxTxAge,proto3" json:"auto_batch_max_tx_age,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -226,27 +332,198 @@ func (m *Params) GetBridgeActive() bool {
 	return false
 }
 
+func (m *Params) GetTransferStatusRetentionBlocks() uint64 {
+	if m != nil {
+		return m.TransferStatusRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAttestationPowerPolicy() AttestationPowerPolicy {
+	if m != nil {
+		return m.AttestationPowerPolicy
+	}
+	return ATTESTATION_POWER_POLICY_CURRENT
+}
+
+func (m *Params) GetAttestationThresholds() []ClaimTypeThreshold {
+	if m != nil {
+		return m.AttestationThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetOracleLivenessWindow() uint64 {
+	if m != nil {
+		return m.OracleLivenessWindow
+	}
+	return 0
+}
+
+func (m *Params) GetConfirmSigningWindow() uint64 {
+	if m != nil {
+		return m.ConfirmSigningWindow
+	}
+	return 0
+}
+
+func (m *Params) GetBadSignatureEvidenceBounty() types.Coin {
+	if m != nil {
+		return m.BadSignatureEvidenceBounty
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetEthereumHeightHistoryBlocks() uint64 {
+	if m != nil {
+		return m.EthereumHeightHistoryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMinAverageBlockTime() uint64 {
+	if m != nil {
+		return m.MinAverageBlockTime
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAverageBlockTime() uint64 {
+	if m != nil {
+		return m.MaxAverageBlockTime
+	}
+	return 0
+}
+
+func (m *Params) GetMinAverageEthereumBlockTime() uint64 {
+	if m != nil {
+		return m.MinAverageEthereumBlockTime
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAverageEthereumBlockTime() uint64 {
+	if m != nil {
+		return m.MaxAverageEthereumBlockTime
+	}
+	return 0
+}
+
+func (m *Params) GetBatchSizeLimits() []BatchSizeLimit {
+	if m != nil {
+		return m.BatchSizeLimits
+	}
+	return nil
+}
+
+func (m *Params) GetBatchSelectionPolicy() BatchSelectionPolicy {
+	if m != nil {
+		return m.BatchSelectionPolicy
+	}
+	return BATCH_SELECTION_POLICY_FEE_DESC
+}
+
+func (m *Params) GetAutoBatchFeeThresholds() []AutoBatchFeeThreshold {
+	if m != nil {
+		return m.AutoBatchFeeThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetAutoBatchMaxTxAge() uint64 {
+	if m != nil {
+		return m.AutoBatchMaxTxAge
+	}
+	return 0
+}
+
+// ClaimTypeThreshold is the share of the voting power required to observe an
+// attestation of a claim type
+type ClaimTypeThreshold struct {
+	ClaimType ClaimType                              `protobuf:"varint,1,opt,name=claim_type,json=claimType,proto3,enum=gravity.v1.ClaimType" json:"claim_type,omitempty"`
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold"`
+}
+
+func (m *ClaimTypeThreshold) Reset()         { *m = ClaimTypeThreshold{} }
+func (m *ClaimTypeThreshold) String() string { return proto.CompactTextString(m) }
+func (*ClaimTypeThreshold) ProtoMessage()    {}
+func (*ClaimTypeThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{1}
+}
+func (m *ClaimTypeThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimTypeThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimTypeThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimTypeThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimTypeThreshold.Merge(m, src)
+}
+func (m *ClaimTypeThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimTypeThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimTypeThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimTypeThreshold proto.InternalMessageInfo
+
+func (m *ClaimTypeThreshold) GetClaimType() ClaimType {
+	if m != nil {
+		return m.ClaimType
+	}
+	return CLAIM_TYPE_UNSPECIFIED
+}
+
 // GenesisState struct
 type GenesisState struct {
-	Params             *Params                     `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	LastObservedNonce  uint64                      `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
-	Valsets            []Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets"`
-	ValsetConfirms     []MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms"`
-	Batches            []OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches"`
-	BatchConfirms      []MsgConfirmBatch           `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
-	LogicCalls         []OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls"`
-	LogicCallConfirms  []MsgConfirmLogicCall       `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
-	Attestations       []Attestation               `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
-	DelegateKeys       []MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys"`
-	Erc20ToDenoms      []ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms"`
-	UnbatchedTransfers []OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers"`
+	Params                      *Params                                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	LastObservedNonce           uint64                                   `protobuf:"varint,2,opt,name=last_observed_nonce,json=lastObservedNonce,proto3" json:"last_observed_nonce,omitempty"`
+	Valsets                     []Valset                                 `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets"`
+	ValsetConfirms              []MsgValsetConfirm                       `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms"`
+	Batches                     []OutgoingTxBatch                        `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches"`
+	BatchConfirms               []MsgConfirmBatch                        `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
+	LogicCalls                  []OutgoingLogicCall                      `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls"`
+	LogicCallConfirms           []MsgConfirmLogicCall                    `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
+	Attestations                []Attestation                            `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
+	DelegateKeys                []MsgSetOrchestratorAddress              `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys"`
+	Erc20ToDenoms               []ERC20ToDenom                           `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms"`
+	UnbatchedTransfers          []OutgoingTransferTx                     `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers"`
+	GravityNonces               GravityNonces                            `protobuf:"bytes,13,opt,name=gravity_nonces,json=gravityNonces,proto3" json:"gravity_nonces"`
+	LastEventNonces             []LastEventNonceByValidator              `protobuf:"bytes,14,rep,name=last_event_nonces,json=lastEventNonces,proto3" json:"last_event_nonces"`
+	LastObservedEthereumHeight  LastObservedEthereumBlockHeight          `protobuf:"bytes,15,opt,name=last_observed_ethereum_height,json=lastObservedEthereumHeight,proto3" json:"last_observed_ethereum_height"`
+	LastObservedValset          *Valset                                  `protobuf:"bytes,16,opt,name=last_observed_valset,json=lastObservedValset,proto3" json:"last_observed_valset,omitempty"`
+	PastEthSignatureCheckpoints [][]byte                                 `protobuf:"bytes,17,rep,name=past_eth_signature_checkpoints,json=pastEthSignatureCheckpoints,proto3" json:"past_eth_signature_checkpoints,omitempty"`
+	ValsetHijackIncidents       []ValsetHijackIncident                   `protobuf:"bytes,18,rep,name=valset_hijack_incidents,json=valsetHijackIncidents,proto3" json:"valset_hijack_incidents"`
+	PastDelegateKeys            []PastDelegateKey                        `protobuf:"bytes,19,rep,name=past_delegate_keys,json=pastDelegateKeys,proto3" json:"past_delegate_keys"`
+	TransferStatuses            []TransferStatus                         `protobuf:"bytes,20,rep,name=transfer_statuses,json=transferStatuses,proto3" json:"transfer_statuses"`
+	DepositRecords              []DepositRecord                          `protobuf:"bytes,21,rep,name=deposit_records,json=depositRecords,proto3" json:"deposit_records"`
+	DepositEscrows              []DepositEscrow                          `protobuf:"bytes,22,rep,name=deposit_escrows,json=depositEscrows,proto3" json:"deposit_escrows"`
+	BridgeEscrow                github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,23,rep,name=bridge_escrow,json=bridgeEscrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"bridge_escrow"`
+	OracleLivenessSlashes       []LastEventNonceByValidator              `protobuf:"bytes,24,rep,name=oracle_liveness_slashes,json=oracleLivenessSlashes,proto3" json:"oracle_liveness_slashes"`
+	ConflictingClaims           []ConflictingClaim                       `protobuf:"bytes,25,rep,name=conflicting_claims,json=conflictingClaims,proto3" json:"conflicting_claims"`
+	ConfirmSigningInfos         []ConfirmSigningInfo                     `protobuf:"bytes,26,rep,name=confirm_signing_infos,json=confirmSigningInfos,proto3" json:"confirm_signing_infos"`
+	BadSignatureEvidence        [][]byte                                 `protobuf:"bytes,27,rep,name=bad_signature_evidence,json=badSignatureEvidence,proto3" json:"bad_signature_evidence,omitempty"`
+	SlashRecords                []SlashRecord                            `protobuf:"bytes,28,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records"`
+	EthereumHeightVotes         []EthereumHeightVote                     `protobuf:"bytes,29,rep,name=ethereum_height_votes,json=ethereumHeightVotes,proto3" json:"ethereum_height_votes"`
+	EthereumHeightSamples       []EthereumHeightSample                   `protobuf:"bytes,30,rep,name=ethereum_height_samples,json=ethereumHeightSamples,proto3" json:"ethereum_height_samples"`
+	TransferMinimums            []TransferMinimum                        `protobuf:"bytes,31,rep,name=transfer_minimums,json=transferMinimums,proto3" json:"transfer_minimums"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_387b0aba880adb60, []int{1}
+	return fileDescriptor_387b0aba880adb60, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,252 +636,515 @@ func (m *GenesisState) GetUnbatchedTransfers() []OutgoingTransferTx {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+func (m *GenesisState) GetGravityNonces() GravityNonces {
+	if m != nil {
+		return m.GravityNonces
+	}
+	return GravityNonces{}
 }
 
-func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+func (m *GenesisState) GetLastEventNonces() []LastEventNonceByValidator {
+	if m != nil {
+		return m.LastEventNonces
+	}
+	return nil
+}
 
-var fileDescriptor_387b0aba880adb60 = []byte{
-	// 1038 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x5b, 0x4f, 0x1b, 0x47,
-	0x14, 0xc6, 0x09, 0x31, 0x30, 0x98, 0xdb, 0x70, 0xc9, 0x70, 0x89, 0xb1, 0x52, 0x35, 0x42, 0x55,
-	0x59, 0x83, 0x2b, 0x55, 0x6a, 0xab, 0x3e, 0x60, 0x43, 0x9a, 0x28, 0x4d, 0x89, 0x6c, 0xda, 0x4a,
-	0x55, 0xa5, 0xe9, 0x78, 0xf7, 0xb0, 0xbb, 0x62, 0xbd, 0x83, 0x66, 0xc6, 0x0b, 0xbc, 0xf5, 0x27,
-	0xf4, 0xd7, 0xf4, 0x0f, 0xf4, 0x25, 0x8f, 0x79, 0xac, 0xaa, 0x2a, 0xaa, 0xe0, 0x8f, 0x54, 0x73,
-	0xd9, 0xf5, 0x62, 0x78, 0xe2, 0x09, 0x74, 0xbe, 0xcb, 0x39, 0x3e, 0xe7, 0xcc, 0xb1, 0x11, 0x09,
-	0x05, 0xcb, 0x62, 0x75, 0xd5, 0xcc, 0xf6, 0x9b, 0x21, 0xa4, 0x20, 0x63, 0xe9, 0x9d, 0x0b, 0xae,
-	0x38, 0x46, 0x0e, 0xf1, 0xb2, 0xfd, 0x8d, 0x95, 0x90, 0x87, 0xdc, 0x84, 0x9b, 0xfa, 0x3f, 0xcb,
-	0xd8, 0x58, 0x2b, 0x69, 0xd5, 0xd5, 0x39, 0x38, 0xe5, 0xc6, 0x6a, 0x29, 0x3e, 0x90, 0xa1, 0xbc,
-	0x87, 0xde, 0x67, 0xca, 0x8f, 0x5c, 0x7c, 0xab, 0x14, 0x67, 0x4a, 0x81, 0x54, 0x4c, 0xc5, 0x3c,
-	0x75, 0x68, 0xdd, 0xe7, 0x72, 0xc0, 0x65, 0xb3, 0xcf, 0x24, 0x34, 0xb3, 0xfd, 0x3e, 0x28, 0xb6,
-	0xdf, 0xf4, 0x79, 0xec, 0xf0, 0xe7, 0x7f, 0xce, 0xa0, 0xea, 0x3b, 0x26, 0xd8, 0x40, 0xe2, 0x67,
-	0x28, 0xaf, 0x99, 0xc6, 0x01, 0xa9, 0x34, 0x2a, 0x3b, 0x33, 0xdd, 0x19, 0x17, 0x79, 0x1d, 0xe0,
-	0x3d, 0xb4, 0xe2, 0xf3, 0x54, 0x09, 0xe6, 0x2b, 0x2a, 0xf9, 0x50, 0xf8, 0x40, 0x23, 0x26, 0x23,
-	0xf2, 0xc8, 0x10, 0x71, 0x8e, 0xf5, 0x0c, 0xf4, 0x8a, 0xc9, 0x08, 0x7f, 0x89, 0x9e, 0xf6, 0x45,
-	0x1c, 0x84, 0x40, 0x41, 0x45, 0x20, 0x60, 0x38, 0xa0, 0x2c, 0x08, 0x04, 0x48, 0x49, 0x26, 0x8d,
-	0x68, 0xd5, 0xc2, 0x47, 0x0e, 0x3d, 0xb0, 0x20, 0x7e, 0x81, 0x16, 0x9c, 0xce, 0x8f, 0x58, 0x9c,
-	0xea, 0x6a, 0x9e, 0x34, 0x2a, 0x3b, 0x93, 0xdd, 0x39, 0x1b, 0xee, 0xe8, 0xe8, 0xeb, 0x00, 0xb7,
-	0xd0, 0xaa, 0x8c, 0xc3, 0x14, 0x02, 0x9a, 0xb1, 0x44, 0x82, 0x92, 0xf4, 0x22, 0x4e, 0x03, 0x7e,
-	0x41, 0xaa, 0x86, 0xbd, 0x6c, 0xc1, 0x9f, 0x2c, 0xf6, 0xb3, 0x81, 0x4a, 0x1a, 0xd3, 0x43, 0x28,
-	0x34, 0x53, 0x65, 0x4d, 0xdb, 0x62, 0x4e, 0xf3, 0x15, 0x5a, 0x77, 0x9a, 0x84, 0x87, 0xb1, 0x4f,
-	0x7d, 0x96, 0x24, 0x85, 0x6e, 0xda, 0xe8, 0xd6, 0x2c, 0xe1, 0x7b, 0x8d, 0x77, 0x34, 0xec, 0xa4,
-	0x7b, 0x68, 0x45, 0x31, 0x11, 0x82, 0xb2, 0xe9, 0xa8, 0x8a, 0x07, 0xc0, 0x87, 0x8a, 0xcc, 0x18,
-	0x15, 0xb6, 0x98, 0xc9, 0x76, 0x62, 0x11, 0xfc, 0x39, 0xc2, 0x2c, 0x03, 0xc1, 0x42, 0xa0, 0xfd,
-	0x84, 0xfb, 0x67, 0x46, 0x42, 0x90, 0xe1, 0x2f, 0x3a, 0xa4, 0xad, 0x01, 0x2d, 0xc0, 0xdf, 0xa2,
-	0xcd, 0x9c, 0x5d, 0xf4, 0xb8, 0x24, 0x9b, 0x35, 0x32, 0xe2, 0x28, 0x79, 0x9f, 0x47, 0xf2, 0x3e,
-	0x5a, 0x95, 0x09, 0x93, 0x11, 0x3d, 0xd5, 0xa3, 0x8b, 0x79, 0xea, 0x3a, 0x49, 0x6a, 0x8d, 0xca,
-	0x4e, 0xad, 0xed, 0xbd, 0xff, 0xb8, 0x3d, 0xf1, 0xcf, 0xc7, 0xed, 0x17, 0x61, 0xac, 0xa2, 0x61,
-	0xdf, 0xf3, 0xf9, 0xa0, 0xe9, 0xf6, 0xc9, 0xfe, 0xd9, 0x95, 0xc1, 0x99, 0xdb, 0xdd, 0x43, 0xf0,
-	0xbb, 0xcb, 0xc6, 0xec, 0xa5, 0xf3, 0xb2, 0x8d, 0xc7, 0xbf, 0xa1, 0x95, 0xb1, 0x1c, 0xa6, 0x15,
-	0x64, 0xee, 0x41, 0x29, 0xf0, 0xad, 0x14, 0xa6, 0x73, 0x38, 0x46, 0xeb, 0x63, 0x19, 0x46, 0x73,
-	0x22, 0xf3, 0x0f, 0x4a, 0xb3, 0x76, 0x2b, 0x4d, 0x31, 0x56, 0xdc, 0x41, 0xf5, 0x61, 0xda, 0xe7,
-	0x69, 0x40, 0x0d, 0x21, 0x4e, 0xc3, 0xf1, 0xdd, 0x5b, 0x30, 0x2d, 0xdf, 0xb4, 0xac, 0x9e, 0x23,
-	0xdd, 0xde, 0xc1, 0x0c, 0x35, 0xee, 0x74, 0x24, 0xd0, 0xf3, 0xa3, 0x7a, 0x8b, 0x98, 0x1a, 0x0a,
-	0x20, 0x8b, 0x0f, 0x2a, 0x7b, 0x6b, 0xac, 0x3b, 0xc1, 0x91, 0x8a, 0x7a, 0xb9, 0x27, 0x3e, 0x44,
-	0x73, 0xb6, 0x58, 0x2a, 0xe0, 0x82, 0x89, 0x80, 0x2c, 0x35, 0x2a, 0x3b, 0xb3, 0xad, 0x75, 0xcf,
-	0x7a, 0x79, 0xfa, 0x46, 0x78, 0xee, 0x46, 0x78, 0x1d, 0x1e, 0xa7, 0xed, 0x49, 0x9d, 0xbf, 0x5b,
-	0xb3, 0xaa, 0xae, 0x11, 0xe9, 0x05, 0x15, 0xa0, 0x4d, 0xdc, 0x1b, 0xd5, 0xf7, 0x06, 0x08, 0x6e,
-	0x54, 0x76, 0xa6, 0xbb, 0x8b, 0x06, 0x69, 0x1b, 0xa0, 0xa7, 0xe3, 0x77, 0xd8, 0x29, 0x4f, 0x7d,
-	0x20, 0xcb, 0x76, 0x9d, 0x4b, 0xec, 0x1f, 0x74, 0x1c, 0x7f, 0x82, 0xdc, 0x13, 0xa7, 0xfa, 0x13,
-	0x64, 0x40, 0x56, 0x8c, 0x6d, 0xcd, 0x06, 0x0f, 0x4c, 0xec, 0xeb, 0xc9, 0xdf, 0xff, 0x6d, 0x4c,
-	0x3c, 0xff, 0xab, 0x8a, 0x6a, 0xdf, 0xd9, 0x8b, 0x6b, 0x33, 0x7d, 0x86, 0xaa, 0xe7, 0xe6, 0x90,
-	0x99, 0xd3, 0x35, 0xdb, 0xc2, 0xde, 0xe8, 0x02, 0x7b, 0xf6, 0xc4, 0x75, 0x1d, 0x03, 0x7b, 0x68,
-	0x39, 0x61, 0x52, 0x51, 0xde, 0x97, 0x20, 0x32, 0x08, 0x5c, 0x59, 0x8f, 0x4c, 0x59, 0x4b, 0x1a,
-	0x3a, 0x76, 0x88, 0xad, 0xab, 0x85, 0xa6, 0xdc, 0x98, 0xc9, 0xe3, 0xc6, 0xe3, 0x71, 0x73, 0x3b,
-	0x5d, 0xd7, 0xac, 0x9c, 0x88, 0xdf, 0xa0, 0x05, 0xd7, 0x6d, 0x9f, 0xa7, 0xa7, 0xb1, 0x18, 0xe8,
-	0xab, 0xa7, 0xb5, 0x5b, 0x65, 0xed, 0x5b, 0xe9, 0x96, 0xa3, 0x63, 0x49, 0xce, 0x65, 0x3e, 0x2b,
-	0x07, 0x25, 0xfe, 0x06, 0x4d, 0xb9, 0x7b, 0x45, 0x9e, 0x18, 0x93, 0xcd, 0xb2, 0xc9, 0xf1, 0x50,
-	0x85, 0x3c, 0x4e, 0xc3, 0x93, 0x4b, 0xf3, 0x20, 0xf2, 0x4a, 0x9c, 0x02, 0xbf, 0x42, 0xf3, 0xf6,
-	0xfa, 0x14, 0x85, 0x54, 0xef, 0x7a, 0xbc, 0x95, 0x61, 0x5e, 0x42, 0xc9, 0x63, 0xce, 0x08, 0x8b,
-	0x32, 0x0e, 0xd1, 0x6c, 0xe9, 0x04, 0x92, 0x29, 0x63, 0xf3, 0xec, 0xbe, 0x52, 0x8a, 0x27, 0xe3,
-	0x8c, 0x50, 0x52, 0x9c, 0x46, 0xfc, 0x23, 0x5a, 0x1e, 0xb9, 0x8c, 0x8a, 0x9a, 0x36, 0x6e, 0xdb,
-	0xf7, 0x17, 0x35, 0xee, 0xb7, 0x54, 0xf8, 0x15, 0xc5, 0x1d, 0xa0, 0x5a, 0xe9, 0xfb, 0x4f, 0x92,
-	0x19, 0xe3, 0xf7, 0xb4, 0xec, 0x77, 0x30, 0xc2, 0xf3, 0xdd, 0x2e, 0x4b, 0xf0, 0x3b, 0x34, 0x17,
-	0x40, 0x02, 0x21, 0x53, 0x40, 0xcf, 0xe0, 0x4a, 0x12, 0x64, 0x3c, 0x3e, 0x1d, 0xab, 0xa9, 0x07,
-	0xea, 0x58, 0xe8, 0xd6, 0x2a, 0xc1, 0x14, 0x17, 0xee, 0x7b, 0x2b, 0x77, 0xcc, 0x1d, 0xde, 0xc0,
-	0x95, 0xc4, 0x2f, 0xd1, 0x02, 0x08, 0xbf, 0xb5, 0x47, 0x15, 0xa7, 0x01, 0xa4, 0x7c, 0x20, 0xc9,
-	0xac, 0xf1, 0x24, 0x65, 0xcf, 0xa3, 0x6e, 0xa7, 0xb5, 0x77, 0xc2, 0x0f, 0x35, 0x21, 0xef, 0xbc,
-	0x91, 0xb9, 0x98, 0xe9, 0xd9, 0x30, 0xb5, 0x03, 0x0d, 0xa8, 0x12, 0x2c, 0x95, 0xa7, 0x20, 0x24,
-	0xa9, 0x19, 0xaf, 0xfa, 0xbd, 0xcb, 0xe0, 0x48, 0x27, 0x97, 0xce, 0x11, 0x17, 0x06, 0x39, 0x24,
-	0xdb, 0xbf, 0xbe, 0xbf, 0xae, 0x57, 0x3e, 0x5c, 0xd7, 0x2b, 0xff, 0x5d, 0xd7, 0x2b, 0x7f, 0xdc,
-	0xd4, 0x27, 0x3e, 0xdc, 0xd4, 0x27, 0xfe, 0xbe, 0xa9, 0x4f, 0xfc, 0xd2, 0x2e, 0x9d, 0x1c, 0x96,
-	0xa8, 0x08, 0xd8, 0x6e, 0x0a, 0x2a, 0x3f, 0x3b, 0x2e, 0xdf, 0xae, 0x7d, 0x98, 0xcd, 0x01, 0x0f,
-	0x86, 0x09, 0x34, 0x2f, 0x9b, 0xf9, 0x6f, 0x11, 0x73, 0x92, 0xfa, 0x55, 0xf3, 0x1b, 0xe3, 0x8b,
-	0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x8e, 0xe1, 0x61, 0x26, 0x09, 0x00, 0x00,
+func (m *GenesisState) GetLastObservedEthereumHeight() LastObservedEthereumBlockHeight {
+	if m != nil {
+		return m.LastObservedEthereumHeight
+	}
+	return LastObservedEthereumBlockHeight{}
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetLastObservedValset() *Valset {
+	if m != nil {
+		return m.LastObservedValset
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GenesisState) GetPastEthSignatureCheckpoints() [][]byte {
+	if m != nil {
+		return m.PastEthSignatureCheckpoints
+	}
+	return nil
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BridgeActive {
-		i--
-		if m.BridgeActive {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
+func (m *GenesisState) GetValsetHijackIncidents() []ValsetHijackIncident {
+	if m != nil {
+		return m.ValsetHijackIncidents
 	}
-	if m.ResetBridgeNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.ResetBridgeNonce))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
+	return nil
+}
+
+func (m *GenesisState) GetPastDelegateKeys() []PastDelegateKey {
+	if m != nil {
+		return m.PastDelegateKeys
 	}
-	if m.ResetBridgeState {
-		i--
-		if m.ResetBridgeState {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
+	return nil
+}
+
+func (m *GenesisState) GetTransferStatuses() []TransferStatus {
+	if m != nil {
+		return m.TransferStatuses
 	}
-	{
-		size, err := m.ValsetReward.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetDepositRecords() []DepositRecord {
+	if m != nil {
+		return m.DepositRecords
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x8a
-	{
-		size := m.SlashFractionBadEthSignature.Size()
-		i -= size
-		if _, err := m.SlashFractionBadEthSignature.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetDepositEscrows() []DepositEscrow {
+	if m != nil {
+		return m.DepositEscrows
 	}
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	if m.UnbondSlashingValsetsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
-		i--
-		dAtA[i] = 0x78
+	return nil
+}
+
+func (m *GenesisState) GetBridgeEscrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BridgeEscrow
 	}
-	{
-		size := m.SlashFractionLogicCall.Size()
-		i -= size
-		if _, err := m.SlashFractionLogicCall.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetOracleLivenessSlashes() []LastEventNonceByValidator {
+	if m != nil {
+		return m.OracleLivenessSlashes
 	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.SlashFractionBatch.Size()
-		i -= size
-		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *GenesisState) GetConflictingClaims() []ConflictingClaim {
+	if m != nil {
+		return m.ConflictingClaims
 	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.SlashFractionValset.Size()
-		i -= size
-		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return nil
+}
+
+func (m *GenesisState) GetConfirmSigningInfos() []ConfirmSigningInfo {
+	if m != nil {
+		return m.ConfirmSigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBadSignatureEvidence() [][]byte {
+	if m != nil {
+		return m.BadSignatureEvidence
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEthereumHeightVotes() []EthereumHeightVote {
+	if m != nil {
+		return m.EthereumHeightVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEthereumHeightSamples() []EthereumHeightSample {
+	if m != nil {
+		return m.EthereumHeightSamples
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferMinimums() []TransferMinimum {
+	if m != nil {
+		return m.TransferMinimums
+	}
+	return nil
+}
+
+// GravityNonces holds the counters and cursors of the gravity module, these
+// are exported so that a restarted chain does not reuse ids or slash twice
+type GravityNonces struct {
+	// the nonce of the last valset request created
+	LatestValsetNonce uint64 `protobuf:"varint,1,opt,name=latest_valset_nonce,json=latestValsetNonce,proto3" json:"latest_valset_nonce,omitempty"`
+	// the last valset nonce slashed for missing signatures
+	LastSlashedValsetNonce uint64 `protobuf:"varint,2,opt,name=last_slashed_valset_nonce,json=lastSlashedValsetNonce,proto3" json:"last_slashed_valset_nonce,omitempty"`
+	// the last batch block height slashed for missing signatures
+	LastSlashedBatchBlock uint64 `protobuf:"varint,3,opt,name=last_slashed_batch_block,json=lastSlashedBatchBlock,proto3" json:"last_slashed_batch_block,omitempty"`
+	// the last logic call block height slashed for missing signatures
+	LastSlashedLogicCallBlock uint64 `protobuf:"varint,4,opt,name=last_slashed_logic_call_block,json=lastSlashedLogicCallBlock,proto3" json:"last_slashed_logic_call_block,omitempty"`
+	// the block height a validator last started unbonding at
+	LastUnbondingBlockHeight uint64 `protobuf:"varint,5,opt,name=last_unbonding_block_height,json=lastUnbondingBlockHeight,proto3" json:"last_unbonding_block_height,omitempty"`
+	// the last id assigned to a transfer in the outgoing pool
+	LastTxPoolId uint64 `protobuf:"varint,6,opt,name=last_tx_pool_id,json=lastTxPoolId,proto3" json:"last_tx_pool_id,omitempty"`
+	// the last nonce assigned to an outgoing batch
+	LastBatchId uint64 `protobuf:"varint,7,opt,name=last_batch_id,json=lastBatchId,proto3" json:"last_batch_id,omitempty"`
+}
+
+func (m *GravityNonces) Reset()         { *m = GravityNonces{} }
+func (m *GravityNonces) String() string { return proto.CompactTextString(m) }
+func (*GravityNonces) ProtoMessage()    {}
+func (*GravityNonces) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{3}
+}
+func (m *GravityNonces) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GravityNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GravityNonces.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x62
-	if m.AverageEthereumBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
-		i--
-		dAtA[i] = 0x58
+}
+func (m *GravityNonces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GravityNonces.Merge(m, src)
+}
+func (m *GravityNonces) XXX_Size() int {
+	return m.Size()
+}
+func (m *GravityNonces) XXX_DiscardUnknown() {
+	xxx_messageInfo_GravityNonces.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GravityNonces proto.InternalMessageInfo
+
+func (m *GravityNonces) GetLatestValsetNonce() uint64 {
+	if m != nil {
+		return m.LatestValsetNonce
 	}
-	if m.AverageBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
-		i--
-		dAtA[i] = 0x50
+	return 0
+}
+
+func (m *GravityNonces) GetLastSlashedValsetNonce() uint64 {
+	if m != nil {
+		return m.LastSlashedValsetNonce
 	}
-	if m.TargetBatchTimeout != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBatchTimeout))
-		i--
-		dAtA[i] = 0x48
+	return 0
+}
+
+func (m *GravityNonces) GetLastSlashedBatchBlock() uint64 {
+	if m != nil {
+		return m.LastSlashedBatchBlock
 	}
-	if m.SignedLogicCallsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedLogicCallsWindow))
-		i--
-		dAtA[i] = 0x40
+	return 0
+}
+
+func (m *GravityNonces) GetLastSlashedLogicCallBlock() uint64 {
+	if m != nil {
+		return m.LastSlashedLogicCallBlock
 	}
-	if m.SignedBatchesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
-		i--
-		dAtA[i] = 0x38
+	return 0
+}
+
+func (m *GravityNonces) GetLastUnbondingBlockHeight() uint64 {
+	if m != nil {
+		return m.LastUnbondingBlockHeight
 	}
-	if m.SignedValsetsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *GravityNonces) GetLastTxPoolId() uint64 {
+	if m != nil {
+		return m.LastTxPoolId
 	}
-	if m.BridgeChainId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+func (m *GravityNonces) GetLastBatchId() uint64 {
+	if m != nil {
+		return m.LastBatchId
 	}
-	if len(m.BridgeEthereumAddress) > 0 {
-		i -= len(m.BridgeEthereumAddress)
-		copy(dAtA[i:], m.BridgeEthereumAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+// LastEventNonceByValidator records the last Ethereum event nonce
+// a validator has submitted a claim for, in oracle_liveness_slashes it
+// records the event nonce a validator was last slashed for not claiming
+type LastEventNonceByValidator struct {
+	Validator  string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	EventNonce uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+}
+
+func (m *LastEventNonceByValidator) Reset()         { *m = LastEventNonceByValidator{} }
+func (m *LastEventNonceByValidator) String() string { return proto.CompactTextString(m) }
+func (*LastEventNonceByValidator) ProtoMessage()    {}
+func (*LastEventNonceByValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{4}
+}
+func (m *LastEventNonceByValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastEventNonceByValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastEventNonceByValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ContractSourceHash) > 0 {
-		i -= len(m.ContractSourceHash)
-		copy(dAtA[i:], m.ContractSourceHash)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *LastEventNonceByValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastEventNonceByValidator.Merge(m, src)
+}
+func (m *LastEventNonceByValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastEventNonceByValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastEventNonceByValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastEventNonceByValidator proto.InternalMessageInfo
+
+func (m *LastEventNonceByValidator) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	if len(m.GravityId) > 0 {
-		i -= len(m.GravityId)
-		copy(dAtA[i:], m.GravityId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *LastEventNonceByValidator) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+// PastDelegateKey records a delegate key that has been replaced by
+// MsgRotateDelegateKeys, only one of orchestrator or eth_address is set
+type PastDelegateKey struct {
+	Validator    string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	EthAddress   string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+}
+
+func (m *PastDelegateKey) Reset()         { *m = PastDelegateKey{} }
+func (m *PastDelegateKey) String() string { return proto.CompactTextString(m) }
+func (*PastDelegateKey) ProtoMessage()    {}
+func (*PastDelegateKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_387b0aba880adb60, []int{5}
+}
+func (m *PastDelegateKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PastDelegateKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PastDelegateKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PastDelegateKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PastDelegateKey.Merge(m, src)
+}
+func (m *PastDelegateKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *PastDelegateKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_PastDelegateKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PastDelegateKey proto.InternalMessageInfo
+
+func (m *PastDelegateKey) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *PastDelegateKey) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+func (m *PastDelegateKey) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "gravity.v1.Params")
+	proto.RegisterType((*ClaimTypeThreshold)(nil), "gravity.v1.ClaimTypeThreshold")
+	proto.RegisterType((*GenesisState)(nil), "gravity.v1.GenesisState")
+	proto.RegisterType((*GravityNonces)(nil), "gravity.v1.GravityNonces")
+	proto.RegisterType((*LastEventNonceByValidator)(nil), "gravity.v1.LastEventNonceByValidator")
+	proto.RegisterType((*PastDelegateKey)(nil), "gravity.v1.PastDelegateKey")
+}
+
+func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
+
+var fileDescriptor_387b0aba880adb60 = []byte{
+	// 2258 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0x5b, 0x73, 0x1b, 0xb7,
+	0x15, 0x36, 0x2d, 0xc7, 0x8e, 0x20, 0xea, 0x06, 0x89, 0x34, 0x74, 0xa3, 0x18, 0xa6, 0x76, 0x35,
+	0x6d, 0x4d, 0xc9, 0x4c, 0xa6, 0x9d, 0xb6, 0x93, 0x99, 0xea, 0x66, 0x5b, 0x13, 0x39, 0x56, 0x49,
+	0xc5, 0xce, 0xa4, 0x99, 0x6e, 0xc1, 0x5d, 0x68, 0xb9, 0xf5, 0x72, 0xc1, 0x59, 0x80, 0x14, 0x95,
+	0xa7, 0xb6, 0xbf, 0xa0, 0x8f, 0xfd, 0x0d, 0x7d, 0xeb, 0xbf, 0xc8, 0x63, 0x1e, 0x3b, 0x9d, 0x4e,
+	0xda, 0xb1, 0x7f, 0x48, 0x3b, 0x38, 0x00, 0x96, 0x7b, 0xa1, 0xdc, 0x54, 0x4f, 0xa6, 0xcf, 0xe5,
+	0x3b, 0xc0, 0xc1, 0xb9, 0xae, 0x10, 0xf1, 0x63, 0x3a, 0x0a, 0xe4, 0xd5, 0xee, 0xe8, 0xf1, 0xae,
+	0xcf, 0x22, 0x26, 0x02, 0xd1, 0x1c, 0xc4, 0x5c, 0x72, 0x8c, 0x0c, 0xa7, 0x39, 0x7a, 0xbc, 0xbe,
+	0xea, 0x73, 0x9f, 0x03, 0x79, 0x57, 0xfd, 0xd2, 0x12, 0xeb, 0xd5, 0x94, 0xae, 0xbc, 0x1a, 0x30,
+	0xa3, 0xb9, 0x5e, 0x49, 0xd1, 0xfb, 0xc2, 0x17, 0x53, 0xc4, 0xbb, 0x54, 0xba, 0x3d, 0x43, 0xdf,
+	0x4c, 0xd1, 0xa9, 0x94, 0x4c, 0x48, 0x2a, 0x03, 0x1e, 0x4d, 0x01, 0x1b, 0x70, 0x1e, 0x1a, 0x72,
+	0xcd, 0xe5, 0xa2, 0xcf, 0xc5, 0x6e, 0x97, 0x0a, 0xb6, 0x3b, 0x7a, 0xdc, 0x65, 0x92, 0x3e, 0xde,
+	0x75, 0x79, 0x60, 0xd4, 0x1a, 0x7f, 0xab, 0xa2, 0xbb, 0x67, 0x34, 0xa6, 0x7d, 0x81, 0xb7, 0x90,
+	0xbd, 0x8a, 0x13, 0x78, 0xa4, 0x54, 0x2f, 0xed, 0xcc, 0xb6, 0x67, 0x0d, 0xe5, 0xc4, 0xc3, 0x7b,
+	0x68, 0xd5, 0xe5, 0x91, 0x8c, 0xa9, 0x2b, 0x1d, 0xc1, 0x87, 0xb1, 0xcb, 0x9c, 0x1e, 0x15, 0x3d,
+	0x72, 0x1b, 0x04, 0xb1, 0xe5, 0x75, 0x80, 0xf5, 0x8c, 0x8a, 0x1e, 0xfe, 0x29, 0xba, 0xdf, 0x8d,
+	0x03, 0xcf, 0x67, 0x0e, 0x93, 0x3d, 0x16, 0xb3, 0x61, 0xdf, 0xa1, 0x9e, 0x17, 0x33, 0x21, 0xc8,
+	0x1d, 0x50, 0xaa, 0x68, 0xf6, 0xb1, 0xe1, 0xee, 0x6b, 0x26, 0x7e, 0x88, 0x16, 0x8d, 0x9e, 0xdb,
+	0xa3, 0x41, 0xa4, 0x4e, 0xf3, 0x5e, 0xbd, 0xb4, 0x73, 0xa7, 0x3d, 0xaf, 0xc9, 0x87, 0x8a, 0x7a,
+	0xe2, 0xe1, 0x16, 0xaa, 0x88, 0xc0, 0x8f, 0x98, 0xe7, 0x8c, 0x68, 0x28, 0x98, 0x14, 0xce, 0x65,
+	0x10, 0x79, 0xfc, 0x92, 0xdc, 0x05, 0xe9, 0x15, 0xcd, 0x7c, 0xa9, 0x79, 0xaf, 0x80, 0x95, 0xd2,
+	0x01, 0xd7, 0xb2, 0x44, 0xe7, 0x5e, 0x5a, 0xe7, 0x40, 0xf3, 0x8c, 0xce, 0xcf, 0xd1, 0x9a, 0xd1,
+	0x09, 0xb9, 0x1f, 0xb8, 0x8e, 0x4b, 0xc3, 0x30, 0xd1, 0x7b, 0x1f, 0xf4, 0xaa, 0x5a, 0xe0, 0x54,
+	0xf1, 0x0f, 0x15, 0xdb, 0xa8, 0xee, 0xa1, 0x55, 0x49, 0x63, 0x9f, 0x49, 0x6d, 0xce, 0x91, 0x41,
+	0x9f, 0xf1, 0xa1, 0x24, 0xb3, 0xa0, 0x85, 0x35, 0x0f, 0xac, 0x9d, 0x6b, 0x0e, 0xfe, 0x09, 0xc2,
+	0x74, 0xc4, 0x62, 0xea, 0x33, 0xa7, 0x1b, 0x72, 0xf7, 0x35, 0xa8, 0x10, 0x04, 0xf2, 0x4b, 0x86,
+	0x73, 0xa0, 0x18, 0x4a, 0x01, 0x7f, 0x82, 0x36, 0xac, 0x74, 0xe2, 0xe3, 0x94, 0xda, 0x1c, 0xa8,
+	0x11, 0x23, 0x62, 0xfd, 0x3c, 0x51, 0xef, 0xa2, 0x8a, 0x08, 0xa9, 0xe8, 0x39, 0x17, 0xea, 0xe9,
+	0x02, 0x1e, 0x19, 0x4f, 0x92, 0x72, 0xbd, 0xb4, 0x53, 0x3e, 0x68, 0x7e, 0xf3, 0xdd, 0xf6, 0xad,
+	0x7f, 0x7c, 0xb7, 0xfd, 0xd0, 0x0f, 0x64, 0x6f, 0xd8, 0x6d, 0xba, 0xbc, 0xbf, 0x6b, 0xe2, 0x49,
+	0xff, 0xf3, 0x48, 0x78, 0xaf, 0x4d, 0x48, 0x1f, 0x31, 0xb7, 0xbd, 0x02, 0x60, 0x4f, 0x0c, 0x96,
+	0x76, 0x3c, 0xfe, 0x1d, 0x5a, 0xcd, 0xd9, 0x00, 0x57, 0x90, 0xf9, 0x1b, 0x99, 0xc0, 0x19, 0x13,
+	0xe0, 0x39, 0x1c, 0xa0, 0xb5, 0x9c, 0x85, 0xc9, 0x3b, 0x91, 0x85, 0x1b, 0x99, 0xa9, 0x66, 0xcc,
+	0x24, 0xcf, 0x8a, 0x0f, 0x51, 0x6d, 0x18, 0x75, 0x79, 0xe4, 0x39, 0x20, 0x10, 0x44, 0x7e, 0x3e,
+	0xf6, 0x16, 0xc1, 0xe5, 0x1b, 0x5a, 0xaa, 0x63, 0x84, 0xb2, 0x31, 0x38, 0x42, 0xf5, 0x82, 0x47,
+	0x3c, 0xf5, 0x7e, 0x8e, 0x8a, 0x22, 0x2a, 0x87, 0x31, 0x23, 0x4b, 0x37, 0x3a, 0xf6, 0x66, 0xce,
+	0x3b, 0xde, 0xb1, 0xec, 0x75, 0x2c, 0x26, 0x3e, 0x42, 0xf3, 0xfa, 0xb0, 0x4e, 0xcc, 0x2e, 0x69,
+	0xec, 0x91, 0xe5, 0x7a, 0x69, 0x67, 0xae, 0xb5, 0xd6, 0xd4, 0x58, 0x4d, 0x55, 0x23, 0x9a, 0xa6,
+	0x46, 0x34, 0x0f, 0x79, 0x10, 0x1d, 0xdc, 0x51, 0xf6, 0xdb, 0x65, 0xad, 0xd5, 0x06, 0x25, 0x15,
+	0xa0, 0x31, 0x53, 0x20, 0x26, 0x47, 0x85, 0xa4, 0x92, 0x11, 0x5c, 0x2f, 0xed, 0xbc, 0xdf, 0x5e,
+	0x02, 0xce, 0x01, 0x30, 0x3a, 0x8a, 0x5e, 0x90, 0x8e, 0x78, 0xe4, 0x32, 0xb2, 0xa2, 0xc3, 0x39,
+	0x25, 0xfd, 0x99, 0xa2, 0xe3, 0x0f, 0x91, 0x49, 0x71, 0x47, 0xdd, 0x60, 0xc4, 0xc8, 0x2a, 0xc0,
+	0x96, 0x35, 0x71, 0x1f, 0x68, 0xf8, 0x29, 0xaa, 0xcb, 0x98, 0x46, 0xe2, 0x82, 0xc5, 0x60, 0x7c,
+	0x28, 0x9c, 0x98, 0x49, 0x16, 0x69, 0x4f, 0xaa, 0xd8, 0x16, 0xa4, 0x02, 0x06, 0xb6, 0xac, 0x5c,
+	0x07, 0xc4, 0xda, 0x56, 0x0a, 0x12, 0x40, 0xe0, 0xaf, 0x10, 0x49, 0xd5, 0x51, 0x67, 0xc0, 0x2f,
+	0x59, 0xec, 0x0c, 0x78, 0x18, 0xb8, 0x57, 0xa4, 0x5a, 0x2f, 0xed, 0x2c, 0xb4, 0x1a, 0xcd, 0x49,
+	0x71, 0x6f, 0xee, 0x4f, 0x64, 0xcf, 0x94, 0xe8, 0x19, 0x48, 0xb6, 0xab, 0x74, 0x2a, 0x1d, 0xff,
+	0x06, 0xa5, 0x39, 0x8e, 0xec, 0xc5, 0x4c, 0xf4, 0x78, 0xe8, 0x09, 0x72, 0xbf, 0x3e, 0xb3, 0x33,
+	0xd7, 0xaa, 0xa5, 0xb1, 0x0f, 0x43, 0x1a, 0xf4, 0xcf, 0xaf, 0x06, 0xec, 0xdc, 0x8a, 0x19, 0xdf,
+	0x57, 0x52, 0x18, 0x09, 0x4f, 0xe0, 0x8f, 0x51, 0x95, 0xc7, 0xd4, 0x0d, 0x99, 0x13, 0x06, 0x23,
+	0xd5, 0x8e, 0x92, 0xf8, 0x23, 0x70, 0xf3, 0x55, 0xcd, 0x3d, 0x35, 0x4c, 0x13, 0x78, 0x02, 0xd5,
+	0x72, 0x81, 0x97, 0x03, 0x21, 0x6b, 0x37, 0x0a, 0xbb, 0x8d, 0x4c, 0xd8, 0xbd, 0xc8, 0x98, 0xc6,
+	0x97, 0x85, 0x68, 0x77, 0x79, 0x74, 0x11, 0x06, 0xae, 0x54, 0xd9, 0xe3, 0xaa, 0x8b, 0x93, 0xf5,
+	0x1b, 0x99, 0xdd, 0xca, 0x98, 0x3d, 0x9c, 0xa0, 0x82, 0x37, 0x95, 0x8f, 0x94, 0xa5, 0x20, 0xee,
+	0x43, 0x5e, 0x29, 0x6b, 0xc6, 0x47, 0x1b, 0xda, 0x47, 0x86, 0xdb, 0xd1, 0x4c, 0xe3, 0x23, 0x8a,
+	0x2a, 0xfd, 0x20, 0x72, 0x4c, 0xc1, 0x1f, 0xb0, 0xd8, 0x2a, 0x6d, 0xde, 0xac, 0x5e, 0xf5, 0x83,
+	0xa8, 0x03, 0x58, 0x67, 0x2c, 0x36, 0x26, 0xfe, 0x54, 0x42, 0x0f, 0x55, 0xc6, 0x27, 0xd9, 0xee,
+	0xb0, 0x51, 0xe0, 0xb1, 0xc8, 0x65, 0x4e, 0x97, 0x0f, 0x23, 0x79, 0x95, 0xb8, 0x8a, 0x6c, 0xdd,
+	0xc8, 0x68, 0xa3, 0x4b, 0xbd, 0x24, 0xed, 0x8f, 0x0d, 0xf6, 0x01, 0x40, 0x5b, 0x6f, 0xe1, 0x2e,
+	0xda, 0x7a, 0xe7, 0x19, 0x48, 0xed, 0xfb, 0x15, 0x87, 0xf5, 0xeb, 0x6d, 0xa9, 0x6a, 0x99, 0x74,
+	0xa5, 0x1e, 0x0b, 0xfc, 0x9e, 0x74, 0x7a, 0x81, 0x90, 0x3c, 0xbe, 0xb2, 0x79, 0xba, 0xad, 0xab,
+	0xa5, 0x95, 0x7a, 0x06, 0x42, 0xcf, 0xb4, 0x8c, 0xc9, 0xd2, 0x8f, 0x50, 0x55, 0x3d, 0xc8, 0x94,
+	0xa6, 0x58, 0xd7, 0x2d, 0xbb, 0x1f, 0x44, 0xfb, 0xf9, 0xbe, 0xa8, 0x94, 0xe8, 0x78, 0x9a, 0xd2,
+	0x07, 0x46, 0x89, 0x8e, 0x0b, 0x4a, 0x47, 0x68, 0x3b, 0x6d, 0x69, 0x5a, 0x43, 0x6d, 0xe8, 0xf3,
+	0x4e, 0x4c, 0x16, 0x7b, 0xaa, 0x42, 0xa1, 0xe3, 0x77, 0xa2, 0x7c, 0x68, 0x50, 0xe8, 0xf8, 0x5a,
+	0x94, 0x53, 0xb4, 0xac, 0x27, 0x06, 0x11, 0x7c, 0xad, 0xf2, 0xb3, 0x1f, 0x48, 0x41, 0x7e, 0x00,
+	0x85, 0x63, 0x3d, 0x5d, 0x38, 0xa0, 0x03, 0x76, 0x82, 0xaf, 0xd9, 0xa9, 0x12, 0x31, 0x6f, 0xb2,
+	0xd8, 0xcd, 0x50, 0x05, 0x7e, 0x89, 0xaa, 0x06, 0x8d, 0x85, 0xcc, 0x35, 0xd5, 0x0e, 0xea, 0xdc,
+	0x03, 0xa8, 0x73, 0xf5, 0x22, 0xa4, 0x15, 0x34, 0x55, 0x6e, 0xb5, 0x3b, 0x85, 0x8a, 0xbf, 0x40,
+	0x4b, 0x1a, 0x57, 0xdd, 0xf4, 0x12, 0x1e, 0x8f, 0x3c, 0xbc, 0x51, 0xc8, 0x2e, 0x00, 0xce, 0xbe,
+	0xcf, 0x5e, 0x01, 0x0a, 0xee, 0xa2, 0x35, 0x3a, 0x94, 0xdc, 0x8c, 0x4d, 0x17, 0x8c, 0xa5, 0x0b,
+	0xe8, 0x0f, 0xc1, 0x0f, 0x1f, 0x64, 0x8a, 0xf3, 0x50, 0x72, 0x38, 0xf8, 0x13, 0x56, 0xa8, 0xa1,
+	0x55, 0x3a, 0x8d, 0x29, 0xf0, 0x1e, 0xaa, 0xa4, 0x6c, 0xa8, 0x47, 0x93, 0x63, 0x75, 0x13, 0xb2,
+	0x03, 0xef, 0xb3, 0x9c, 0xa8, 0x3d, 0xa7, 0xe3, 0xf3, 0xf1, 0xbe, 0xcf, 0x7e, 0x71, 0xe7, 0x0f,
+	0xff, 0xac, 0xdf, 0x6a, 0xfc, 0xa5, 0x84, 0x70, 0xb1, 0x60, 0xe3, 0x8f, 0x11, 0x82, 0x6a, 0xe6,
+	0xa8, 0x5b, 0xc1, 0xfc, 0xbc, 0xd0, 0xaa, 0x4c, 0x2d, 0xf2, 0xed, 0x59, 0xd7, 0xfe, 0xc4, 0xa7,
+	0x68, 0x36, 0xb9, 0x19, 0xb9, 0x7d, 0x23, 0xdf, 0x4d, 0x00, 0x1a, 0x7f, 0x5c, 0x41, 0xe5, 0xa7,
+	0x7a, 0x3d, 0xd1, 0xfd, 0xf7, 0x47, 0xe8, 0xee, 0x00, 0xc6, 0x7b, 0x38, 0xd0, 0x5c, 0x0b, 0xa7,
+	0x0f, 0xa4, 0x07, 0xff, 0xb6, 0x91, 0xc0, 0x4d, 0xb4, 0x12, 0x52, 0x21, 0x1d, 0xde, 0x15, 0x2c,
+	0x1e, 0x31, 0xcf, 0x34, 0xeb, 0xdb, 0xda, 0x1b, 0x8a, 0xf5, 0xc2, 0x70, 0x74, 0xb7, 0x6e, 0xa1,
+	0x7b, 0x66, 0xf8, 0x21, 0x33, 0xf5, 0x99, 0x3c, 0xb8, 0x9e, 0x79, 0xcc, 0x13, 0x58, 0x41, 0xfc,
+	0x29, 0x5a, 0xd4, 0x3f, 0x1d, 0x53, 0x7d, 0xd5, 0x2e, 0xa0, 0x74, 0x37, 0xd3, 0xba, 0xcf, 0x85,
+	0x19, 0x99, 0x0e, 0xb5, 0x90, 0x41, 0x59, 0x18, 0xa5, 0x89, 0x02, 0xff, 0x12, 0xdd, 0x33, 0x53,
+	0x3c, 0x79, 0x0f, 0x40, 0x36, 0xd2, 0x20, 0x2f, 0x86, 0xd2, 0xe7, 0x41, 0xe4, 0x9f, 0x8f, 0xe1,
+	0x11, 0xed, 0x49, 0x8c, 0x06, 0x7e, 0x86, 0x74, 0xcc, 0x4d, 0x0e, 0x72, 0xb7, 0x88, 0xf1, 0x5c,
+	0xf8, 0xf6, 0x08, 0x29, 0x8c, 0x79, 0x50, 0x4c, 0x8e, 0x71, 0x84, 0xe6, 0x52, 0x8b, 0x01, 0xb9,
+	0x07, 0x30, 0x5b, 0xd3, 0x8e, 0x92, 0x0c, 0x92, 0x06, 0x08, 0x85, 0x96, 0x20, 0xf0, 0xe7, 0x68,
+	0x65, 0x82, 0x32, 0x39, 0xd4, 0xfb, 0x80, 0xb6, 0x3d, 0xfd, 0x50, 0x79, 0xbc, 0xe5, 0x04, 0x2f,
+	0x39, 0xdc, 0x3e, 0x2a, 0xa7, 0x46, 0x08, 0x41, 0x66, 0x01, 0xef, 0xfe, 0x35, 0x83, 0x8d, 0x9d,
+	0xf8, 0xd2, 0x2a, 0xf8, 0x0c, 0xcd, 0x7b, 0x2c, 0x64, 0x3e, 0x95, 0xcc, 0x79, 0xcd, 0xae, 0x04,
+	0x41, 0x80, 0xf1, 0x20, 0x77, 0xa6, 0x0e, 0x93, 0x2f, 0x62, 0xe5, 0x5a, 0x19, 0x53, 0xc9, 0x63,
+	0xb3, 0xcd, 0x59, 0x44, 0x8b, 0xf0, 0x29, 0xbb, 0x12, 0xf8, 0x09, 0x5a, 0x64, 0xb1, 0xdb, 0xda,
+	0x73, 0x24, 0x77, 0x3c, 0x16, 0xf1, 0xbe, 0x20, 0x73, 0x80, 0x49, 0xd2, 0x98, 0xc7, 0xed, 0xc3,
+	0xd6, 0xde, 0x39, 0x3f, 0x52, 0x02, 0xd6, 0xf3, 0xa0, 0x66, 0x68, 0xe0, 0xb3, 0x61, 0xa4, 0x1f,
+	0xd4, 0x73, 0xec, 0xb0, 0x27, 0x48, 0xb9, 0x38, 0x60, 0x25, 0xc1, 0x60, 0x84, 0xce, 0xc7, 0x06,
+	0x11, 0x27, 0x00, 0x96, 0xa5, 0x8e, 0xb7, 0x60, 0x54, 0x75, 0x0a, 0x08, 0x32, 0x6f, 0x9a, 0x61,
+	0x0a, 0xf1, 0xa9, 0xfe, 0x09, 0xa9, 0x60, 0x6f, 0x39, 0xef, 0xa7, 0x89, 0xf8, 0x15, 0x82, 0xac,
+	0x71, 0xd8, 0x88, 0x45, 0xd2, 0x42, 0x2d, 0x14, 0x9d, 0x77, 0x4a, 0x85, 0x3c, 0x56, 0x32, 0xa0,
+	0x77, 0x70, 0xf5, 0x92, 0x86, 0x81, 0xa7, 0x7c, 0x68, 0xeb, 0x79, 0x98, 0x11, 0x10, 0x58, 0xa2,
+	0xad, 0x6c, 0xa6, 0xe6, 0xda, 0x2c, 0x6c, 0x21, 0x73, 0xad, 0x1f, 0xe7, 0x8d, 0xd8, 0xfc, 0xcd,
+	0xf4, 0x1b, 0xdd, 0x74, 0x6d, 0x3b, 0x0f, 0xa7, 0x88, 0x69, 0x09, 0x7c, 0x84, 0x56, 0xb3, 0x56,
+	0xcd, 0xb2, 0xb8, 0x54, 0xac, 0x2c, 0x3a, 0x7b, 0xdb, 0x38, 0x8d, 0xa6, 0x69, 0x6a, 0x28, 0x18,
+	0x80, 0x53, 0xd2, 0xfb, 0x8e, 0xe3, 0xf6, 0x98, 0xfb, 0x7a, 0xc0, 0x83, 0x48, 0x0a, 0xb2, 0x5c,
+	0x9f, 0xd9, 0x29, 0xb7, 0x37, 0x94, 0x54, 0x7a, 0x7f, 0x39, 0x9c, 0x88, 0xe0, 0xdf, 0xa2, 0xfb,
+	0xa6, 0x8c, 0xf4, 0x82, 0xdf, 0x53, 0xf7, 0xb5, 0x13, 0x44, 0xae, 0x1a, 0x3d, 0xa4, 0x20, 0x18,
+	0xfc, 0x5b, 0x2f, 0x9e, 0xe6, 0x19, 0x48, 0x9e, 0x18, 0x41, 0x3b, 0x5f, 0x8f, 0xa6, 0xf0, 0x04,
+	0x7e, 0x81, 0x30, 0x1c, 0x32, 0x1b, 0xf7, 0x2b, 0xc5, 0x02, 0x71, 0x46, 0x85, 0x3c, 0x9a, 0x84,
+	0xb6, 0x41, 0x5d, 0x1a, 0x64, 0xc9, 0x02, 0x3f, 0x47, 0xcb, 0xb9, 0xa5, 0x85, 0x09, 0xb2, 0x5a,
+	0xec, 0xe7, 0xe7, 0x99, 0x8d, 0xc5, 0xc2, 0x65, 0xf7, 0x18, 0x28, 0x5e, 0x8b, 0x1e, 0x1b, 0x70,
+	0x11, 0xa8, 0x5d, 0xce, 0xe5, 0xb1, 0xa7, 0x56, 0x9e, 0x99, 0x7c, 0x88, 0x1e, 0x69, 0x91, 0x36,
+	0x48, 0xd8, 0x1a, 0xea, 0xa5, 0x89, 0x19, 0x24, 0x26, 0xdc, 0x98, 0x5f, 0x0a, 0x52, 0xbd, 0x16,
+	0xe9, 0x18, 0x24, 0x72, 0x48, 0x9a, 0x28, 0xf0, 0x20, 0x59, 0xde, 0x34, 0x90, 0xd9, 0x73, 0xde,
+	0x31, 0x41, 0xee, 0x29, 0x9c, 0xbf, 0xfe, 0x6b, 0x7b, 0xe7, 0x7b, 0x34, 0x3a, 0xa5, 0x20, 0xec,
+	0x26, 0xa8, 0x4d, 0x62, 0x17, 0xdd, 0xcf, 0x6f, 0x41, 0xb0, 0x12, 0x30, 0x41, 0xc8, 0xff, 0x9f,
+	0x65, 0x95, 0xec, 0xce, 0xd4, 0xd1, 0x48, 0xf8, 0xd7, 0x08, 0x17, 0x16, 0x16, 0xb5, 0x28, 0x15,
+	0x9a, 0x56, 0x7e, 0x01, 0xb1, 0x35, 0xd9, 0xcd, 0xd1, 0x05, 0xfe, 0x02, 0x55, 0xf2, 0x9b, 0x49,
+	0x10, 0x5d, 0x70, 0x41, 0xd6, 0xa7, 0x6c, 0x86, 0x99, 0x25, 0xe5, 0x24, 0xba, 0xe0, 0x06, 0x77,
+	0xc5, 0x2d, 0x70, 0x60, 0x2f, 0x9c, 0x3e, 0xd5, 0x93, 0x0d, 0x48, 0xaa, 0xd5, 0x69, 0xd3, 0x3a,
+	0x3e, 0x40, 0xf3, 0x7a, 0x45, 0xb3, 0xb1, 0xb4, 0x59, 0x6c, 0x12, 0xe0, 0x8e, 0x4c, 0x24, 0x95,
+	0xc5, 0x84, 0x04, 0x77, 0xca, 0xcf, 0xfa, 0x23, 0x2e, 0x99, 0x20, 0x5b, 0xc5, 0x3b, 0x65, 0xeb,
+	0xca, 0x4b, 0x2e, 0x99, 0xbd, 0x13, 0x2b, 0x70, 0x20, 0xd7, 0xf3, 0xc8, 0x82, 0xf6, 0x07, 0x21,
+	0x13, 0xa4, 0x56, 0xcc, 0xf5, 0x2c, 0x76, 0x07, 0x04, 0xed, 0x03, 0xb3, 0x29, 0x3c, 0x81, 0x3f,
+	0x4b, 0xa5, 0x66, 0x3f, 0x88, 0x82, 0xfe, 0xb0, 0xaf, 0x16, 0x93, 0x42, 0xaa, 0xdb, 0xd4, 0x7c,
+	0xae, 0x65, 0xf2, 0xb9, 0x69, 0xc8, 0xa2, 0xf1, 0x9f, 0xdb, 0x68, 0x3e, 0xd3, 0x1c, 0xf4, 0x60,
+	0x25, 0x99, 0x90, 0xa6, 0x62, 0x9a, 0xc1, 0xaa, 0x64, 0x07, 0x2b, 0xc5, 0xd2, 0x35, 0x0a, 0x14,
+	0xd4, 0x07, 0x47, 0x28, 0xb4, 0x3a, 0x98, 0xbd, 0xac, 0x96, 0x1e, 0xc7, 0xaa, 0x4a, 0x40, 0x87,
+	0xa8, 0x97, 0x56, 0xfd, 0x19, 0x22, 0x19, 0x55, 0x3d, 0xe2, 0xc0, 0xee, 0x41, 0x66, 0x40, 0xb3,
+	0x92, 0xd2, 0xd4, 0x43, 0x8d, 0x62, 0xe2, 0x5f, 0xa1, 0xad, 0x8c, 0x62, 0x6a, 0x16, 0xd1, 0xda,
+	0x77, 0x40, 0x7b, 0x2d, 0xa5, 0x3d, 0x99, 0x3e, 0x00, 0xe1, 0x13, 0xb4, 0x01, 0x08, 0xfa, 0xd3,
+	0x97, 0x0a, 0x6a, 0x50, 0xb4, 0x2d, 0x49, 0x7f, 0xc2, 0x85, 0xd3, 0x7d, 0x6e, 0x25, 0x52, 0xfd,
+	0x07, 0x3f, 0x40, 0xd0, 0xe6, 0xd4, 0x0c, 0xae, 0xbe, 0x5f, 0xab, 0xaf, 0xbe, 0xfa, 0x3b, 0x6e,
+	0x59, 0x91, 0xcf, 0xc7, 0x67, 0x9c, 0x87, 0x27, 0x1e, 0x6e, 0xa0, 0x79, 0x10, 0xd3, 0x17, 0x0b,
+	0x3c, 0xf3, 0xe1, 0x76, 0x4e, 0x11, 0xe1, 0x3a, 0x27, 0x5e, 0xe3, 0x4b, 0xb4, 0x76, 0x6d, 0xb2,
+	0xe3, 0x4d, 0x34, 0x3b, 0xb2, 0xff, 0xb1, 0x5f, 0xb9, 0x13, 0x02, 0xde, 0x46, 0x73, 0xa9, 0x6e,
+	0x6d, 0x9c, 0x8d, 0x58, 0x82, 0xd4, 0x90, 0x68, 0x31, 0x57, 0xf3, 0xff, 0x07, 0x62, 0x03, 0x95,
+	0x79, 0x6a, 0x2c, 0x32, 0xdf, 0xcb, 0x33, 0x34, 0xb0, 0x2a, 0x7b, 0xc9, 0xd7, 0xf1, 0x19, 0x10,
+	0x41, 0x4c, 0xf6, 0xec, 0x10, 0xf5, 0xd5, 0x37, 0x6f, 0x6a, 0xa5, 0x6f, 0xdf, 0xd4, 0x4a, 0xff,
+	0x7e, 0x53, 0x2b, 0xfd, 0xf9, 0x6d, 0xed, 0xd6, 0xb7, 0x6f, 0x6b, 0xb7, 0xfe, 0xfe, 0xb6, 0x76,
+	0xeb, 0xcb, 0x83, 0x54, 0xed, 0xa4, 0xa1, 0xec, 0x31, 0xfa, 0x28, 0x62, 0xd2, 0xd6, 0x4f, 0x13,
+	0xbe, 0x8f, 0x74, 0xd9, 0xdc, 0xed, 0x73, 0x6f, 0x18, 0xb2, 0xdd, 0xf1, 0xae, 0xa1, 0xeb, 0xda,
+	0xda, 0xbd, 0x0b, 0x7f, 0x0b, 0xf8, 0xe8, 0xbf, 0x03, 0x00, 0xd6, 0xd9, 0xdc, 0xc5, 0xe5, 0x18,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -614,20 +1154,27 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UnbatchedTransfers) > 0 {
-		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+	if m.AutoBatchMaxTxAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AutoBatchMaxTxAge))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.AutoBatchFeeThresholds) > 0 {
+		for iNdEx := len(m.AutoBatchFeeThresholds) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AutoBatchFeeThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -635,27 +1182,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x62
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
 		}
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
+	{
+		size := m.BatchAgeWeight.Size()
+		i -= size
+		if _, err := m.BatchAgeWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.DelegateKeys) > 0 {
-		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0xb2
+	if m.BatchSelectionPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchSelectionPolicy))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.BatchSizeLimits) > 0 {
+		for iNdEx := len(m.BatchSizeLimits) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BatchSizeLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -663,97 +1217,124 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.Attestations) > 0 {
-		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	if m.MaxAverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxAverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
 	}
-	if len(m.LogicCallConfirms) > 0 {
-		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
+	if m.MinAverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinAverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxAverageBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxAverageBlockTime))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MinAverageBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinAverageBlockTime))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.EthereumHeightHistoryBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EthereumHeightHistoryBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	{
+		size, err := m.BadSignatureEvidenceBounty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.LogicCalls) > 0 {
-		for iNdEx := len(m.LogicCalls) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LogicCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	{
+		size := m.BadSignatureEvidenceBountyFraction.Size()
+		i -= size
+		if _, err := m.BadSignatureEvidenceBountyFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.BatchConfirms) > 0 {
-		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Batches) > 0 {
-		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe2
+	if m.ConfirmSigningWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ConfirmSigningWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	{
+		size := m.SlashFractionConflictingClaim.Size()
+		i -= size
+		if _, err := m.SlashFractionConflictingClaim.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.ValsetConfirms) > 0 {
-		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	{
+		size := m.SlashFractionOracleLiveness.Size()
+		i -= size
+		if _, err := m.SlashFractionOracleLiveness.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if len(m.Valsets) > 0 {
-		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	if m.OracleLivenessWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OracleLivenessWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.AttestationThresholds) > 0 {
+		for iNdEx := len(m.AttestationThresholds) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AttestationThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -761,187 +1342,2352 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
 		}
 	}
-	if m.LastObservedNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedNonce))
+	if m.AttestationPowerPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestationPowerPolicy))
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xb0
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.TransferStatusRetentionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TransferStatusRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.BridgeActive {
+		i--
+		if m.BridgeActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	var l int
-	_ = l
-	l = len(m.GravityId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.ResetBridgeNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ResetBridgeNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	l = len(m.ContractSourceHash)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.ResetBridgeState {
+		i--
+		if m.ResetBridgeState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	l = len(m.BridgeEthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.ValsetReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.BridgeChainId != 0 {
-		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.SlashFractionBadEthSignature.Size()
+		i -= size
+		if _, err := m.SlashFractionBadEthSignature.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.SignedValsetsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedValsetsWindow))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.UnbondSlashingValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
+		i--
+		dAtA[i] = 0x78
 	}
-	if m.SignedBatchesWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	{
+		size := m.SlashFractionLogicCall.Size()
+		i -= size
+		if _, err := m.SlashFractionLogicCall.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.SignedLogicCallsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedLogicCallsWindow))
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.SlashFractionBatch.Size()
+		i -= size
+		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.TargetBatchTimeout != 0 {
-		n += 1 + sovGenesis(uint64(m.TargetBatchTimeout))
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.SlashFractionValset.Size()
+		i -= size
+		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.AverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x58
 	}
 	if m.AverageBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageBlockTime))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.AverageEthereumBlockTime != 0 {
-		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	if m.TargetBatchTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBatchTimeout))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = m.SlashFractionValset.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionBatch.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionLogicCall.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.UnbondSlashingValsetsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.UnbondSlashingValsetsWindow))
+	if m.SignedLogicCallsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedLogicCallsWindow))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = m.SlashFractionBadEthSignature.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	l = m.ValsetReward.Size()
-	n += 2 + l + sovGenesis(uint64(l))
-	if m.ResetBridgeState {
-		n += 3
+	if m.SignedBatchesWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.ResetBridgeNonce != 0 {
-		n += 2 + sovGenesis(uint64(m.ResetBridgeNonce))
+	if m.SignedValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.BridgeActive {
-		n += 3
+	if m.BridgeChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractSourceHash) > 0 {
+		i -= len(m.ContractSourceHash)
+		copy(dAtA[i:], m.ContractSourceHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClaimTypeThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimTypeThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimTypeThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.LastObservedNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
+	i--
+	dAtA[i] = 0x12
+	if m.ClaimType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ClaimType))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.Valsets) > 0 {
-		for _, e := range m.Valsets {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ValsetConfirms) > 0 {
-		for _, e := range m.ValsetConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransferMinimums) > 0 {
+		for iNdEx := len(m.TransferMinimums) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferMinimums[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
 		}
 	}
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EthereumHeightSamples) > 0 {
+		for iNdEx := len(m.EthereumHeightSamples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumHeightSamples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
 		}
 	}
-	if len(m.BatchConfirms) > 0 {
-		for _, e := range m.BatchConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EthereumHeightVotes) > 0 {
+		for iNdEx := len(m.EthereumHeightVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EthereumHeightVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
 		}
 	}
-	if len(m.LogicCalls) > 0 {
-		for _, e := range m.LogicCalls {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.LogicCallConfirms) > 0 {
-		for _, e := range m.LogicCallConfirms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BadSignatureEvidence) > 0 {
+		for iNdEx := len(m.BadSignatureEvidence) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BadSignatureEvidence[iNdEx])
+			copy(dAtA[i:], m.BadSignatureEvidence[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BadSignatureEvidence[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
 		}
 	}
-	if len(m.Attestations) > 0 {
-		for _, e := range m.Attestations {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ConfirmSigningInfos) > 0 {
+		for iNdEx := len(m.ConfirmSigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfirmSigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.DelegateKeys) > 0 {
-		for _, e := range m.DelegateKeys {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ConflictingClaims) > 0 {
+		for iNdEx := len(m.ConflictingClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConflictingClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
 		}
 	}
-	if len(m.Erc20ToDenoms) > 0 {
-		for _, e := range m.Erc20ToDenoms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OracleLivenessSlashes) > 0 {
+		for iNdEx := len(m.OracleLivenessSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleLivenessSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.UnbatchedTransfers) > 0 {
-		for _, e := range m.UnbatchedTransfers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BridgeEscrow) > 0 {
+		for iNdEx := len(m.BridgeEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
 		}
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+	if len(m.DepositEscrows) > 0 {
+		for iNdEx := len(m.DepositEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.DepositRecords) > 0 {
+		for iNdEx := len(m.DepositRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.TransferStatuses) > 0 {
+		for iNdEx := len(m.TransferStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.PastDelegateKeys) > 0 {
+		for iNdEx := len(m.PastDelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PastDelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ValsetHijackIncidents) > 0 {
+		for iNdEx := len(m.ValsetHijackIncidents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetHijackIncidents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.PastEthSignatureCheckpoints) > 0 {
+		for iNdEx := len(m.PastEthSignatureCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PastEthSignatureCheckpoints[iNdEx])
+			copy(dAtA[i:], m.PastEthSignatureCheckpoints[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PastEthSignatureCheckpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.LastObservedValset != nil {
+		{
+			size, err := m.LastObservedValset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	{
+		size, err := m.LastObservedEthereumHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.LastEventNonces) > 0 {
+		for iNdEx := len(m.LastEventNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastEventNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size, err := m.GravityNonces.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.UnbatchedTransfers) > 0 {
+		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for iNdEx := len(m.Erc20ToDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20ToDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for iNdEx := len(m.DelegateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegateKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LogicCalls) > 0 {
+		for iNdEx := len(m.LogicCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LastObservedNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GravityNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GravityNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GravityNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastBatchId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBatchId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastTxPoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTxPoolId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastUnbondingBlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUnbondingBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastSlashedLogicCallBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedLogicCallBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastSlashedBatchBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedBatchBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastSlashedValsetNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastSlashedValsetNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LatestValsetNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestValsetNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastEventNonceByValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastEventNonceByValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastEventNonceByValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PastDelegateKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PastDelegateKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PastDelegateKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ContractSourceHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BridgeChainId != 0 {
+		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	}
+	if m.SignedValsetsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedValsetsWindow))
+	}
+	if m.SignedBatchesWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	}
+	if m.SignedLogicCallsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedLogicCallsWindow))
+	}
+	if m.TargetBatchTimeout != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBatchTimeout))
+	}
+	if m.AverageBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageBlockTime))
+	}
+	if m.AverageEthereumBlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.AverageEthereumBlockTime))
+	}
+	l = m.SlashFractionValset.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionBatch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionLogicCall.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.UnbondSlashingValsetsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.UnbondSlashingValsetsWindow))
+	}
+	l = m.SlashFractionBadEthSignature.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.ValsetReward.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.ResetBridgeState {
+		n += 3
+	}
+	if m.ResetBridgeNonce != 0 {
+		n += 2 + sovGenesis(uint64(m.ResetBridgeNonce))
+	}
+	if m.BridgeActive {
+		n += 3
+	}
+	if m.TransferStatusRetentionBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.TransferStatusRetentionBlocks))
+	}
+	if m.AttestationPowerPolicy != 0 {
+		n += 2 + sovGenesis(uint64(m.AttestationPowerPolicy))
+	}
+	if len(m.AttestationThresholds) > 0 {
+		for _, e := range m.AttestationThresholds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.OracleLivenessWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.OracleLivenessWindow))
+	}
+	l = m.SlashFractionOracleLiveness.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionConflictingClaim.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.ConfirmSigningWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.ConfirmSigningWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.BadSignatureEvidenceBountyFraction.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	l = m.BadSignatureEvidenceBounty.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.EthereumHeightHistoryBlocks != 0 {
+		n += 2 + sovGenesis(uint64(m.EthereumHeightHistoryBlocks))
+	}
+	if m.MinAverageBlockTime != 0 {
+		n += 2 + sovGenesis(uint64(m.MinAverageBlockTime))
+	}
+	if m.MaxAverageBlockTime != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxAverageBlockTime))
+	}
+	if m.MinAverageEthereumBlockTime != 0 {
+		n += 2 + sovGenesis(uint64(m.MinAverageEthereumBlockTime))
+	}
+	if m.MaxAverageEthereumBlockTime != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxAverageEthereumBlockTime))
+	}
+	if len(m.BatchSizeLimits) > 0 {
+		for _, e := range m.BatchSizeLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BatchSelectionPolicy != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchSelectionPolicy))
+	}
+	l = m.BatchAgeWeight.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.AutoBatchFeeThresholds) > 0 {
+		for _, e := range m.AutoBatchFeeThresholds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AutoBatchMaxTxAge != 0 {
+		n += 2 + sovGenesis(uint64(m.AutoBatchMaxTxAge))
+	}
+	return n
+}
+
+func (m *ClaimTypeThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClaimType != 0 {
+		n += 1 + sovGenesis(uint64(m.ClaimType))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastObservedNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedNonce))
+	}
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for _, e := range m.ValsetConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for _, e := range m.BatchConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogicCalls) > 0 {
+		for _, e := range m.LogicCalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for _, e := range m.LogicCallConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegateKeys) > 0 {
+		for _, e := range m.DelegateKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Erc20ToDenoms) > 0 {
+		for _, e := range m.Erc20ToDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbatchedTransfers) > 0 {
+		for _, e := range m.UnbatchedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.GravityNonces.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LastEventNonces) > 0 {
+		for _, e := range m.LastEventNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.LastObservedEthereumHeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.LastObservedValset != nil {
+		l = m.LastObservedValset.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PastEthSignatureCheckpoints) > 0 {
+		for _, b := range m.PastEthSignatureCheckpoints {
+			l = len(b)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValsetHijackIncidents) > 0 {
+		for _, e := range m.ValsetHijackIncidents {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PastDelegateKeys) > 0 {
+		for _, e := range m.PastDelegateKeys {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferStatuses) > 0 {
+		for _, e := range m.TransferStatuses {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DepositRecords) > 0 {
+		for _, e := range m.DepositRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DepositEscrows) > 0 {
+		for _, e := range m.DepositEscrows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgeEscrow) > 0 {
+		for _, e := range m.BridgeEscrow {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleLivenessSlashes) > 0 {
+		for _, e := range m.OracleLivenessSlashes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConflictingClaims) > 0 {
+		for _, e := range m.ConflictingClaims {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConfirmSigningInfos) > 0 {
+		for _, e := range m.ConfirmSigningInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BadSignatureEvidence) > 0 {
+		for _, b := range m.BadSignatureEvidence {
+			l = len(b)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumHeightVotes) > 0 {
+		for _, e := range m.EthereumHeightVotes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EthereumHeightSamples) > 0 {
+		for _, e := range m.EthereumHeightSamples {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferMinimums) > 0 {
+		for _, e := range m.TransferMinimums {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GravityNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LatestValsetNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LatestValsetNonce))
+	}
+	if m.LastSlashedValsetNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashedValsetNonce))
+	}
+	if m.LastSlashedBatchBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashedBatchBlock))
+	}
+	if m.LastSlashedLogicCallBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.LastSlashedLogicCallBlock))
+	}
+	if m.LastUnbondingBlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastUnbondingBlockHeight))
+	}
+	if m.LastTxPoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTxPoolId))
+	}
+	if m.LastBatchId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastBatchId))
+	}
+	return n
+}
+
+func (m *LastEventNonceByValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *PastDelegateKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozGenesis(x uint64) (n int) {
 	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			}
+			m.SignedValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+			}
+			m.SignedLogicCallsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+			}
+			m.TargetBatchTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			}
+			m.AverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+			}
+			m.UnbondSlashingValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetBridgeState", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetBridgeState = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetBridgeNonce", wireType)
+			}
+			m.ResetBridgeNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetBridgeNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeActive = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferStatusRetentionBlocks", wireType)
+			}
+			m.TransferStatusRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferStatusRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationPowerPolicy", wireType)
+			}
+			m.AttestationPowerPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationPowerPolicy |= AttestationPowerPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationThresholds = append(m.AttestationThresholds, ClaimTypeThreshold{})
+			if err := m.AttestationThresholds[len(m.AttestationThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleLivenessWindow", wireType)
+			}
+			m.OracleLivenessWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleLivenessWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionOracleLiveness", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionOracleLiveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingClaim", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionConflictingClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmSigningWindow", wireType)
+			}
+			m.ConfirmSigningWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmSigningWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadSignatureEvidenceBountyFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadSignatureEvidenceBountyFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadSignatureEvidenceBounty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadSignatureEvidenceBounty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeightHistoryBlocks", wireType)
+			}
+			m.EthereumHeightHistoryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumHeightHistoryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAverageBlockTime", wireType)
+			}
+			m.MinAverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAverageBlockTime", wireType)
+			}
+			m.MaxAverageBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAverageBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAverageEthereumBlockTime", wireType)
+			}
+			m.MinAverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAverageEthereumBlockTime", wireType)
+			}
+			m.MaxAverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSizeLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchSizeLimits = append(m.BatchSizeLimits, BatchSizeLimit{})
+			if err := m.BatchSizeLimits[len(m.BatchSizeLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSelectionPolicy", wireType)
+			}
+			m.BatchSelectionPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSelectionPolicy |= BatchSelectionPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchAgeWeight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BatchAgeWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoBatchFeeThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoBatchFeeThresholds = append(m.AutoBatchFeeThresholds, AutoBatchFeeThreshold{})
+			if err := m.AutoBatchFeeThresholds[len(m.AutoBatchFeeThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoBatchMaxTxAge", wireType)
+			}
+			m.AutoBatchMaxTxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoBatchMaxTxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimTypeThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimTypeThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimTypeThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			}
+			m.ClaimType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimType |= ClaimType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -951,30 +3697,289 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenesis
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedNonce", wireType)
+			}
+			m.LastObservedNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valsets = append(m.Valsets, Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetConfirms = append(m.ValsetConfirms, MsgValsetConfirm{})
+			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, OutgoingTxBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
+			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCalls = append(m.LogicCalls, OutgoingLogicCall{})
+			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
+			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -984,29 +3989,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GravityId = string(dAtA[iNdEx:postIndex])
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1016,29 +4023,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			m.DelegateKeys = append(m.DelegateKeys, MsgSetOrchestratorAddress{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1048,29 +4057,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
 			}
-			m.BridgeChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1080,16 +4091,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedValsetsWindow = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
+			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityNonces", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1099,16 +4125,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedBatchesWindow = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GravityNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNonces", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1118,16 +4158,166 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastEventNonces = append(m.LastEventNonces, LastEventNonceByValidator{})
+			if err := m.LastEventNonces[len(m.LastEventNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEthereumHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastObservedEthereumHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedValset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastObservedValset == nil {
+				m.LastObservedValset = &Valset{}
+			}
+			if err := m.LastObservedValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PastEthSignatureCheckpoints", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PastEthSignatureCheckpoints = append(m.PastEthSignatureCheckpoints, make([]byte, postIndex-iNdEx))
+			copy(m.PastEthSignatureCheckpoints[len(m.PastEthSignatureCheckpoints)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetHijackIncidents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedLogicCallsWindow = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetHijackIncidents = append(m.ValsetHijackIncidents, ValsetHijackIncident{})
+			if err := m.ValsetHijackIncidents[len(m.ValsetHijackIncidents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PastDelegateKeys", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1137,16 +4327,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBatchTimeout", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.TargetBatchTimeout = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PastDelegateKeys = append(m.PastDelegateKeys, PastDelegateKey{})
+			if err := m.PastDelegateKeys[len(m.PastDelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferStatuses", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1156,16 +4361,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetBatchTimeout |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageBlockTime = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferStatuses = append(m.TransferStatuses, TransferStatus{})
+			if err := m.TransferStatuses[len(m.TransferStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRecords", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1175,16 +4395,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageEthereumBlockTime = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRecords = append(m.DepositRecords, DepositRecord{})
+			if err := m.DepositRecords[len(m.DepositRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEscrows", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1194,16 +4429,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositEscrows = append(m.DepositEscrows, DepositEscrow{})
+			if err := m.DepositEscrows[len(m.DepositEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEscrow", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1213,30 +4463,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BridgeEscrow = append(m.BridgeEscrow, types.Coin{})
+			if err := m.BridgeEscrow[len(m.BridgeEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleLivenessSlashes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1246,30 +4497,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OracleLivenessSlashes = append(m.OracleLivenessSlashes, LastEventNonceByValidator{})
+			if err := m.OracleLivenessSlashes[len(m.OracleLivenessSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingClaims", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1279,30 +4531,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConflictingClaims = append(m.ConflictingClaims, ConflictingClaim{})
+			if err := m.ConflictingClaims[len(m.ConflictingClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmSigningInfos", wireType)
 			}
-			m.UnbondSlashingValsetsWindow = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1312,14 +4565,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfirmSigningInfos = append(m.ConfirmSigningInfos, ConfirmSigningInfo{})
+			if err := m.ConfirmSigningInfos[len(m.ConfirmSigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBadEthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadSignatureEvidence", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1346,13 +4614,12 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBadEthSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BadSignatureEvidence = append(m.BadSignatureEvidence, make([]byte, postIndex-iNdEx))
+			copy(m.BadSignatureEvidence[len(m.BadSignatureEvidence)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1379,15 +4646,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValsetReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetBridgeState", wireType)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeightVotes", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1397,17 +4665,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ResetBridgeState = bool(v != 0)
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetBridgeNonce", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.ResetBridgeNonce = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumHeightVotes = append(m.EthereumHeightVotes, EthereumHeightVote{})
+			if err := m.EthereumHeightVotes[len(m.EthereumHeightVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumHeightSamples", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1417,16 +4699,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResetBridgeNonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumHeightSamples = append(m.EthereumHeightSamples, EthereumHeightSample{})
+			if err := m.EthereumHeightSamples[len(m.EthereumHeightSamples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferMinimums", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1436,12 +4733,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BridgeActive = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferMinimums = append(m.TransferMinimums, TransferMinimum{})
+			if err := m.TransferMinimums[len(m.TransferMinimums)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1466,7 +4777,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *GravityNonces) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1489,17 +4800,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: GravityNonces: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GravityNonces: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestValsetNonce", wireType)
 			}
-			var msglen int
+			m.LatestValsetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1509,33 +4820,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LatestValsetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedValsetNonce", wireType)
 			}
-			m.LastObservedNonce = 0
+			m.LastSlashedValsetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1545,16 +4839,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastObservedNonce |= uint64(b&0x7F) << shift
+				m.LastSlashedValsetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedBatchBlock", wireType)
 			}
-			var msglen int
+			m.LastSlashedBatchBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1564,31 +4858,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastSlashedBatchBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Valsets = append(m.Valsets, Valset{})
-			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSlashedLogicCallBlock", wireType)
 			}
-			var msglen int
+			m.LastSlashedLogicCallBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1598,31 +4877,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastSlashedLogicCallBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValsetConfirms = append(m.ValsetConfirms, MsgValsetConfirm{})
-			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUnbondingBlockHeight", wireType)
 			}
-			var msglen int
+			m.LastUnbondingBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1632,31 +4896,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastUnbondingBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Batches = append(m.Batches, OutgoingTxBatch{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTxPoolId", wireType)
 			}
-			var msglen int
+			m.LastTxPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1666,31 +4915,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastTxPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
-			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBatchId", wireType)
 			}
-			var msglen int
+			m.LastBatchId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1700,31 +4934,69 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastBatchId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCalls = append(m.LogicCalls, OutgoingLogicCall{})
-			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastEventNonceByValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastEventNonceByValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastEventNonceByValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1734,31 +5006,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
-			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var msglen int
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1768,31 +5038,69 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PastDelegateKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 10:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PastDelegateKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PastDelegateKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1802,31 +5110,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, MsgSetOrchestratorAddress{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1836,31 +5142,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1870,25 +5174,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
-			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
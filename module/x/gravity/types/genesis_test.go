@@ -81,6 +81,13 @@ func TestGenesisStateValidate(t *testing.T) {
 			Erc20ToDenoms:      []ERC20ToDenom{},
 			UnbatchedTransfers: []OutgoingTransferTx{},
 		}, expErr: true},
+		"attestation threshold of one half":        {src: withAttestationThreshold(CLAIM_TYPE_VALSET_UPDATED, types.NewDecWithPrec(5, 1)), expErr: true},
+		"attestation threshold above one":          {src: withAttestationThreshold(CLAIM_TYPE_VALSET_UPDATED, types.NewDecWithPrec(11, 1)), expErr: true},
+		"attestation threshold without claim type": {src: withAttestationThreshold(CLAIM_TYPE_UNSPECIFIED, types.NewDecWithPrec(8, 1)), expErr: true},
+		"duplicate attestation threshold":          {src: withAttestationThreshold(CLAIM_TYPE_SEND_TO_COSMOS, types.NewDecWithPrec(8, 1)), expErr: true},
+		"evidence bounty":                          {src: withBadSignatureEvidenceBounty(types.Coin{Denom: "stake", Amount: types.NewInt(100)}), expErr: false},
+		"evidence bounty with bad denom":           {src: withBadSignatureEvidenceBounty(types.Coin{Denom: "1", Amount: types.NewInt(100)}), expErr: true},
+		"negative evidence bounty":                 {src: withBadSignatureEvidenceBounty(types.Coin{Denom: "stake", Amount: types.NewInt(-1)}), expErr: true},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -94,6 +101,27 @@ func TestGenesisStateValidate(t *testing.T) {
 	}
 }
 
+// withAttestationThreshold returns the default genesis with the attestation threshold of the
+// valset updated claim replaced by the given claim type and threshold
+func withAttestationThreshold(claimType ClaimType, threshold types.Dec) *GenesisState {
+	genesis := DefaultGenesisState()
+	thresholds := DefaultAttestationThresholds()
+	for i := range thresholds {
+		if thresholds[i].ClaimType == CLAIM_TYPE_VALSET_UPDATED {
+			thresholds[i] = ClaimTypeThreshold{ClaimType: claimType, Threshold: threshold}
+		}
+	}
+	genesis.Params.AttestationThresholds = thresholds
+	return genesis
+}
+
+// withBadSignatureEvidenceBounty returns the default genesis with the given fixed bad signature evidence bounty
+func withBadSignatureEvidenceBounty(bounty types.Coin) *GenesisState {
+	genesis := DefaultGenesisState()
+	genesis.Params.BadSignatureEvidenceBounty = bounty
+	return genesis
+}
+
 func TestStringToByteArray(t *testing.T) {
 	specs := map[string]struct {
 		testString string
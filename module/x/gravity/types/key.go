@@ -4,7 +4,9 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 const (
@@ -56,6 +58,44 @@ var (
 	// OutgoingTXPoolKey indexes the last nonce for the outgoing tx pool
 	OutgoingTXPoolKey = "OutgoingTXPoolKey"
 
+	// OutgoingTxByIDKey indexes the store key of the pool entry or batch holding a pending transfer by id
+	OutgoingTxByIDKey = "OutgoingTxByIDKey"
+
+	// OutgoingTxBySenderKey indexes the ids of pending transfers by cosmos sender
+	OutgoingTxBySenderKey = "OutgoingTxBySenderKey"
+
+	// OutgoingTxByDestinationKey indexes the ids of pending transfers by ethereum destination
+	OutgoingTxByDestinationKey = "OutgoingTxByDestinationKey"
+
+	// TransferStatusKey indexes the lifecycle status of outgoing transfers by id
+	TransferStatusKey = "TransferStatusKey"
+
+	// TransferStatusPruneKey indexes the ids of executed or cancelled transfers by the height
+	// their status was last updated at, so that expired statuses can be pruned in order
+	TransferStatusPruneKey = "TransferStatusPruneKey"
+
+	// DepositRecordKey indexes the records of observed deposits from Ethereum by event nonce
+	DepositRecordKey = "DepositRecordKey"
+
+	// DepositByReceiverKey indexes the event nonces of deposit records by cosmos receiver
+	DepositByReceiverKey = "DepositByReceiverKey"
+
+	// DepositBySenderKey indexes the event nonces of deposit records by ethereum sender
+	DepositBySenderKey = "DepositBySenderKey"
+
+	// DepositByTokenKey indexes the event nonces of deposit records by token contract
+	DepositByTokenKey = "DepositByTokenKey"
+
+	// DepositEscrowKey indexes the escrowed tokens of deposits which could not be credited by receiver
+	DepositEscrowKey = "DepositEscrowKey"
+
+	// BridgeEscrowKey indexes by denom the supply of cosmos originated tokens which is locked in the
+	// module account while it exists on Ethereum
+	BridgeEscrowKey = "BridgeEscrowKey"
+
+	// TransferMinimumKey indexes by denom the smallest amount and bridge fee of a transfer to Ethereum
+	TransferMinimumKey = "TransferMinimumKey"
+
 	// DenomiatorPrefix indexes token contract addresses from ETH on gravity
 	DenomiatorPrefix = "DenomiatorPrefix"
 
@@ -71,6 +111,34 @@ var (
 	// LastEventNonceByValidatorKey indexes lateset event nonce by validator
 	LastEventNonceByValidatorKey = "LastEventNonceByValidatorKey"
 
+	// OracleLivenessSlashKey indexes the event nonce a validator was last slashed for not claiming
+	OracleLivenessSlashKey = "OracleLivenessSlashKey"
+
+	// ConflictingClaimKey indexes by event nonce and validator the votes for attestations which lost
+	// to a different attestation at the same event nonce
+	ConflictingClaimKey = "ConflictingClaimKey"
+
+	// BadSignatureEvidenceKey indexes the hashes of the bad signature evidence which has already slashed a validator
+	BadSignatureEvidenceKey = "BadSignatureEvidenceKey"
+
+	// ConfirmSigningInfoKey indexes the confirmations a validator has missed in its signing window
+	ConfirmSigningInfoKey = "ConfirmSigningInfoKey"
+
+	// EthereumHeightVoteKey indexes the latest Ethereum block height claimed by each validator
+	EthereumHeightVoteKey = "EthereumHeightVoteKey"
+
+	// EthereumHeightSampleKey indexes the Ethereum heights observed in the calibration window by Cosmos block height
+	EthereumHeightSampleKey = "EthereumHeightSampleKey"
+
+	// SlashRecordKey indexes the records of gravity specific slashes by id
+	SlashRecordKey = "SlashRecordKey"
+
+	// SlashRecordByValidatorKey indexes the ids of slash records by validator
+	SlashRecordByValidatorKey = "SlashRecordByValidatorKey"
+
+	// SlashRecordByReasonKey indexes the ids of slash records by slash reason
+	SlashRecordByReasonKey = "SlashRecordByReasonKey"
+
 	// LastObservedEventNonceKey indexes the latest event nonce
 	LastObservedEventNonceKey = "LastObservedEventNonceKey"
 
@@ -83,6 +151,9 @@ var (
 	// KeyLastOutgoingBatchID indexes the lastBatchID
 	KeyLastOutgoingBatchID = SequenceKeyPrefix + "lastBatchId"
 
+	// KeyLastSlashRecordID indexes the lastSlashRecordID
+	KeyLastSlashRecordID = SequenceKeyPrefix + "lastSlashRecordId"
+
 	// KeyOrchestratorAddress indexes the validator keys for an orchestrator
 	KeyOrchestratorAddress = "KeyOrchestratorAddress"
 
@@ -124,6 +195,18 @@ var (
 
 	// PastEthSignatureCheckpointKey indexes eth signature checkpoints that have existed
 	PastEthSignatureCheckpointKey = "PastEthSignatureCheckpointKey"
+
+	// ValsetHijackIncidentKey indexes observed validator set updates which did not match
+	// the validator set created on Cosmos, by event nonce
+	ValsetHijackIncidentKey = "ValsetHijackIncidentKey"
+
+	// PastOrchestratorAddressKey indexes the validator keys for an orchestrator key
+	// which has been replaced by a delegate key rotation
+	PastOrchestratorAddressKey = "PastOrchestratorAddressKey"
+
+	// PastValidatorByEthAddressKey indexes the validator keys for an ethereum address
+	// which has been replaced by a delegate key rotation
+	PastValidatorByEthAddressKey = "PastValidatorByEthAddressKey"
 )
 
 // GetOrchestratorAddressKey returns the following key format
@@ -171,6 +254,14 @@ func GetValsetConfirmKey(nonce uint64, validator sdk.AccAddress) string {
 	return ValsetConfirmKey + convertByteArrToString(UInt64Bytes(nonce)) + string(validator.Bytes())
 }
 
+// GetValsetConfirmNoncePrefix returns the following key format
+// prefix   nonce
+// [0x0][0 0 0 0 0 0 0 1]
+// This prefix covers every confirm of a validator set, the nonce is encoded exactly as in GetValsetConfirmKey
+func GetValsetConfirmNoncePrefix(nonce uint64) string {
+	return ValsetConfirmKey + convertByteArrToString(UInt64Bytes(nonce))
+}
+
 // GetClaimKey returns the following key format
 // prefix type               cosmos-validator-address                       nonce                             attestation-details-hash
 // [0x0][0 0 0 1][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
@@ -220,6 +311,15 @@ func GetAttestationKey(eventNonce uint64, claimHash []byte) string {
 	return convertByteArrToString(key)
 }
 
+// GetAttestationNoncePrefix returns the following key format
+// prefix     nonce
+// [0x5][0 0 0 0 0 0 0 1]
+// The nonce is encoded exactly as in GetAttestationKey, which keeps attestation keys sorted by nonce.
+// This prefix covers the attestations of a nonce and starts the range of attestations from that nonce on
+func GetAttestationNoncePrefix(eventNonce uint64) string {
+	return OracleAttestationKey + convertByteArrToString(UInt64Bytes(eventNonce))
+}
+
 // GetOutgoingTxPoolContractPrefix returns the following key format
 // prefix	feeContract
 // [0x6][0xc783df8a850f42e7F7e57013759C285caa701eB6]
@@ -243,6 +343,126 @@ func GetOutgoingTxPoolKey(fee InternalERC20Token, id uint64) string {
 	return convertByteArrToString(r)
 }
 
+// GetOutgoingTxByIDKey returns the following key format
+// prefix     id
+// [0x0][0 0 0 0 0 0 0 1]
+func GetOutgoingTxByIDKey(id uint64) string {
+	return OutgoingTxByIDKey + string(UInt64Bytes(id))
+}
+
+// GetOutgoingTxBySenderPrefix returns the following key format
+// prefix     length  sender
+// [0x0][20][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+// This prefix is used for iterating over the pending transfers of a sender
+func GetOutgoingTxBySenderPrefix(sender sdk.AccAddress) string {
+	if err := sdk.VerifyAddressFormat(sender); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid sender address"))
+	}
+	return OutgoingTxBySenderKey + string(address.MustLengthPrefix(sender.Bytes()))
+}
+
+// GetOutgoingTxBySenderKey returns the following key format
+// prefix     length  sender                                          id
+// [0x0][20][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm][0 0 0 0 0 0 0 1]
+func GetOutgoingTxBySenderKey(sender sdk.AccAddress, id uint64) string {
+	return GetOutgoingTxBySenderPrefix(sender) + string(UInt64Bytes(id))
+}
+
+// GetOutgoingTxByDestinationPrefix returns the following key format
+// prefix     destination
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+// The destination is stored as its 20 address bytes so that lookups do not depend on checksum casing
+func GetOutgoingTxByDestinationPrefix(destination EthAddress) string {
+	return OutgoingTxByDestinationKey + string(gethcommon.HexToAddress(destination.GetAddress()).Bytes())
+}
+
+// GetOutgoingTxByDestinationKey returns the following key format
+// prefix     destination                                  id
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetOutgoingTxByDestinationKey(destination EthAddress, id uint64) string {
+	return GetOutgoingTxByDestinationPrefix(destination) + string(UInt64Bytes(id))
+}
+
+// GetTransferStatusKey returns the following key format
+// prefix     id
+// [0x0][0 0 0 0 0 0 0 1]
+func GetTransferStatusKey(id uint64) string {
+	return TransferStatusKey + string(UInt64Bytes(id))
+}
+
+// GetTransferStatusPruneKey returns the following key format
+// prefix     height              id
+// [0x0][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1]
+func GetTransferStatusPruneKey(height uint64, id uint64) string {
+	return TransferStatusPruneKey + string(UInt64Bytes(height)) + string(UInt64Bytes(id))
+}
+
+// GetDepositRecordKey returns the following key format
+// prefix     nonce
+// [0x0][0 0 0 0 0 0 0 1]
+func GetDepositRecordKey(eventNonce uint64) string {
+	return DepositRecordKey + string(UInt64Bytes(eventNonce))
+}
+
+// GetDepositByReceiverPrefix returns the following key format
+// prefix     length  receiver
+// [0x0][20][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+// This prefix is used for iterating over the deposits to a receiver
+func GetDepositByReceiverPrefix(receiver sdk.AccAddress) string {
+	return DepositByReceiverKey + string(address.MustLengthPrefix(receiver.Bytes()))
+}
+
+// GetDepositByReceiverKey returns the following key format
+// prefix     length  receiver                                        nonce
+// [0x0][20][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm][0 0 0 0 0 0 0 1]
+func GetDepositByReceiverKey(receiver sdk.AccAddress, eventNonce uint64) string {
+	return GetDepositByReceiverPrefix(receiver) + string(UInt64Bytes(eventNonce))
+}
+
+// GetDepositBySenderPrefix returns the following key format
+// prefix     sender
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+// The sender is stored as its 20 address bytes so that lookups do not depend on checksum casing
+func GetDepositBySenderPrefix(sender EthAddress) string {
+	return DepositBySenderKey + string(gethcommon.HexToAddress(sender.GetAddress()).Bytes())
+}
+
+// GetDepositBySenderKey returns the following key format
+// prefix     sender                                       nonce
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetDepositBySenderKey(sender EthAddress, eventNonce uint64) string {
+	return GetDepositBySenderPrefix(sender) + string(UInt64Bytes(eventNonce))
+}
+
+// GetDepositByTokenPrefix returns the following key format
+// prefix     token
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetDepositByTokenPrefix(token EthAddress) string {
+	return DepositByTokenKey + string(gethcommon.HexToAddress(token.GetAddress()).Bytes())
+}
+
+// GetDepositByTokenKey returns the following key format
+// prefix     token                                        nonce
+// [0x0][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetDepositByTokenKey(token EthAddress, eventNonce uint64) string {
+	return GetDepositByTokenPrefix(token) + string(UInt64Bytes(eventNonce))
+}
+
+// GetDepositEscrowPrefix returns the following key format
+// prefix     length  receiver
+// [0x0][20][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+// This prefix is used for iterating over the escrowed deposits of a receiver
+func GetDepositEscrowPrefix(receiver sdk.AccAddress) string {
+	return DepositEscrowKey + string(address.MustLengthPrefix(receiver.Bytes()))
+}
+
+// GetDepositEscrowKey returns the following key format
+// prefix     length  receiver                                        nonce
+// [0x0][20][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm][0 0 0 0 0 0 0 1]
+func GetDepositEscrowKey(receiver sdk.AccAddress, eventNonce uint64) string {
+	return GetDepositEscrowPrefix(receiver) + string(UInt64Bytes(eventNonce))
+}
+
 // GetOutgoingTxBatchKey returns the following key format
 // prefix     nonce                     eth-contract-address
 // [0xa][0 0 0 0 0 0 0 1][0xc783df8a850f42e7F7e57013759C285caa701eB6]
@@ -250,6 +470,14 @@ func GetOutgoingTxBatchKey(tokenContract EthAddress, nonce uint64) string {
 	return OutgoingTXBatchKey + tokenContract.GetAddress() + string(UInt64Bytes(nonce))
 }
 
+// GetBatchConfirmNoncePrefix returns the following key format
+// prefix           eth-contract-address                BatchNonce
+// [0xe1][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+// This prefix covers every confirm of a batch
+func GetBatchConfirmNoncePrefix(tokenContract EthAddress, batchNonce uint64) string {
+	return BatchConfirmKey + tokenContract.GetAddress() + string(UInt64Bytes(batchNonce))
+}
+
 // GetBatchConfirmKey returns the following key format
 // prefix           eth-contract-address                BatchNonce                       Validator-address
 // [0xe1][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
@@ -275,6 +503,104 @@ func GetLastEventNonceByValidatorKey(validator sdk.ValAddress) string {
 	return LastEventNonceByValidatorKey + string(validator.Bytes())
 }
 
+// GetOracleLivenessSlashKey returns the following key format
+// prefix              cosmos-validator
+// [0x0][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetOracleLivenessSlashKey(validator sdk.ValAddress) string {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return OracleLivenessSlashKey + string(validator.Bytes())
+}
+
+// GetConfirmSigningInfoKey returns the following key format
+// prefix              cosmos-validator
+// [0x0][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetConfirmSigningInfoKey(validator sdk.ValAddress) string {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return ConfirmSigningInfoKey + string(validator.Bytes())
+}
+
+// GetEthereumHeightVoteKey returns the following key format
+// prefix              cosmos-validator
+// [0x0][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetEthereumHeightVoteKey(validator sdk.ValAddress) string {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return EthereumHeightVoteKey + string(validator.Bytes())
+}
+
+// GetEthereumHeightSampleKey returns the following key format
+// prefix     cosmos-height
+// [0x0][0 0 0 0 0 0 0 1]
+func GetEthereumHeightSampleKey(cosmosBlockHeight uint64) string {
+	return EthereumHeightSampleKey + string(UInt64Bytes(cosmosBlockHeight))
+}
+
+// GetSlashRecordKey returns the following key format
+// prefix     id
+// [0x0][0 0 0 0 0 0 0 1]
+func GetSlashRecordKey(id uint64) string {
+	return SlashRecordKey + string(UInt64Bytes(id))
+}
+
+// GetSlashRecordByValidatorPrefix returns the following key format
+// prefix     length  cosmos-validator
+// [0x0][20][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+// This prefix is used for iterating over the slashes of a validator
+func GetSlashRecordByValidatorPrefix(validator sdk.ValAddress) string {
+	return SlashRecordByValidatorKey + string(address.MustLengthPrefix(validator.Bytes()))
+}
+
+// GetSlashRecordByValidatorKey returns the following key format
+// prefix     length  cosmos-validator                                    id
+// [0x0][20][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm][0 0 0 0 0 0 0 1]
+func GetSlashRecordByValidatorKey(validator sdk.ValAddress, id uint64) string {
+	return GetSlashRecordByValidatorPrefix(validator) + string(UInt64Bytes(id))
+}
+
+// GetSlashRecordByReasonPrefix returns the following key format
+// prefix     reason
+// [0x0][0 0 0 0 0 0 0 1]
+// This prefix is used for iterating over the slashes for a reason
+func GetSlashRecordByReasonPrefix(reason SlashReason) string {
+	return SlashRecordByReasonKey + string(UInt64Bytes(uint64(reason)))
+}
+
+// GetSlashRecordByReasonKey returns the following key format
+// prefix     reason              id
+// [0x0][0 0 0 0 0 0 0 1][0 0 0 0 0 0 0 1]
+func GetSlashRecordByReasonKey(reason SlashReason, id uint64) string {
+	return GetSlashRecordByReasonPrefix(reason) + string(UInt64Bytes(id))
+}
+
+// GetConflictingClaimKey returns the following key format
+// prefix     nonce                    cosmos-validator
+// [0x0][0 0 0 0 0 0 0 1][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetConflictingClaimKey(eventNonce uint64, validator sdk.ValAddress) string {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return ConflictingClaimKey + string(UInt64Bytes(eventNonce)) + string(validator.Bytes())
+}
+
+// GetBridgeEscrowKey returns the following key format
+// prefix     denom
+// [0x0][stake]
+func GetBridgeEscrowKey(denom string) string {
+	return BridgeEscrowKey + denom
+}
+
+// GetTransferMinimumKey returns the following key format
+// prefix     denom
+// [0x0][stake]
+func GetTransferMinimumKey(denom string) string {
+	return TransferMinimumKey + denom
+}
+
 func GetDenomToERC20Key(denom string) string {
 	return DenomToERC20Key + denom
 }
@@ -304,6 +630,37 @@ func GetPastEthSignatureCheckpointKey(checkpoint []byte) string {
 	return PastEthSignatureCheckpointKey + convertByteArrToString(checkpoint)
 }
 
+// GetBadSignatureEvidenceKey returns the following key format
+// prefix    evidence hash
+// [0x0][ hash bytes ]
+func GetBadSignatureEvidenceKey(hash []byte) string {
+	return BadSignatureEvidenceKey + string(hash)
+}
+
+// GetValsetHijackIncidentKey returns the following key format
+// prefix    nonce
+// [0x0][0 0 0 0 0 0 0 1]
+func GetValsetHijackIncidentKey(eventNonce uint64) string {
+	return ValsetHijackIncidentKey + string(UInt64Bytes(eventNonce))
+}
+
+// GetPastOrchestratorAddressKey returns the following key format
+// prefix
+// [0xe8][gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetPastOrchestratorAddressKey(orc sdk.AccAddress) string {
+	if err := sdk.VerifyAddressFormat(orc); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid orchestrator address"))
+	}
+	return PastOrchestratorAddressKey + string(orc.Bytes())
+}
+
+// GetPastValidatorByEthAddressKey returns the following key format
+// prefix              cosmos-validator
+// [0xf9][0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B]
+func GetPastValidatorByEthAddressKey(ethAddress EthAddress) string {
+	return PastValidatorByEthAddressKey + string([]byte(ethAddress.GetAddress()))
+}
+
 func convertByteArrToString(value []byte) string {
 	var ret strings.Builder
 	for i := 0; i < len(value); i++ {
@@ -12,6 +12,7 @@ import (
 //nolint: exhaustivestruct
 var (
 	_ sdk.Msg = &MsgSetOrchestratorAddress{}
+	_ sdk.Msg = &MsgRotateDelegateKeys{}
 	_ sdk.Msg = &MsgValsetConfirm{}
 	_ sdk.Msg = &MsgSendToEth{}
 	_ sdk.Msg = &MsgCancelSendToEth{}
@@ -23,15 +24,19 @@ var (
 	_ sdk.Msg = &MsgSendToCosmosClaim{}
 	_ sdk.Msg = &MsgBatchSendToEthClaim{}
 	_ sdk.Msg = &MsgValsetUpdatedClaim{}
+	_ sdk.Msg = &MsgEthereumHeightClaim{}
 	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
+	_ sdk.Msg = &MsgSubmitDoubleSignEvidence{}
+	_ sdk.Msg = &MsgClaimDepositEscrow{}
 )
 
 // NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress
-func NewMsgSetOrchestratorAddress(val sdk.ValAddress, oper sdk.AccAddress, eth EthAddress) *MsgSetOrchestratorAddress {
+func NewMsgSetOrchestratorAddress(val sdk.ValAddress, oper sdk.AccAddress, eth EthAddress, ethSignature []byte) *MsgSetOrchestratorAddress {
 	return &MsgSetOrchestratorAddress{
 		Validator:    val.String(),
 		Orchestrator: oper.String(),
 		EthAddress:   eth.GetAddress(),
+		EthSignature: hex.EncodeToString(ethSignature),
 	}
 }
 
@@ -43,6 +48,15 @@ func (msg *MsgSetOrchestratorAddress) Type() string { return "set_operator_addre
 
 // ValidateBasic performs stateless checks
 func (msg *MsgSetOrchestratorAddress) ValidateBasic() (err error) {
+	if err := msg.ValidateDelegateKeys(); err != nil {
+		return err
+	}
+	return validateEthSignature(msg.EthSignature)
+}
+
+// ValidateDelegateKeys checks the addresses only, the eth signature is not kept in
+// state so delegate keys exported to genesis do not carry it
+func (msg *MsgSetOrchestratorAddress) ValidateDelegateKeys() (err error) {
 	if _, err = sdk.ValAddressFromBech32(msg.Validator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Validator)
 	}
@@ -55,6 +69,17 @@ func (msg *MsgSetOrchestratorAddress) ValidateBasic() (err error) {
 	return nil
 }
 
+// validateEthSignature checks that a hex encoded ethereum signature is present
+func validateEthSignature(signature string) error {
+	if signature == "" {
+		return sdkerrors.Wrap(ErrEmpty, "ethereum signature")
+	}
+	if _, err := hex.DecodeString(signature); err != nil {
+		return sdkerrors.Wrapf(ErrInvalid, "could not hex decode ethereum signature: %s", signature)
+	}
+	return nil
+}
+
 // GetSignBytes encodes the message for signing
 func (msg *MsgSetOrchestratorAddress) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
@@ -69,6 +94,66 @@ func (msg *MsgSetOrchestratorAddress) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(acc)}
 }
 
+// NewMsgRotateDelegateKeys returns a new msgRotateDelegateKeys, pass a nil orchestrator
+// or eth address to keep the current one, the signature is only needed for a new eth address
+func NewMsgRotateDelegateKeys(val sdk.ValAddress, orch sdk.AccAddress, eth *EthAddress, ethSignature []byte) *MsgRotateDelegateKeys {
+	msg := &MsgRotateDelegateKeys{
+		Validator: val.String(),
+	}
+	if orch != nil {
+		msg.NewOrchestrator = orch.String()
+	}
+	if eth != nil {
+		msg.NewEthAddress = eth.GetAddress()
+		msg.EthSignature = hex.EncodeToString(ethSignature)
+	}
+	return msg
+}
+
+// Route should return the name of the module
+func (msg *MsgRotateDelegateKeys) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgRotateDelegateKeys) Type() string { return "rotate_delegate_keys" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgRotateDelegateKeys) ValidateBasic() (err error) {
+	if _, err = sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Validator)
+	}
+	if msg.NewOrchestrator == "" && msg.NewEthAddress == "" {
+		return sdkerrors.Wrap(ErrEmpty, "no delegate key to rotate")
+	}
+	if msg.NewOrchestrator != "" {
+		if _, err = sdk.AccAddressFromBech32(msg.NewOrchestrator); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.NewOrchestrator)
+		}
+	}
+	if msg.NewEthAddress != "" {
+		if err := ValidateEthAddress(msg.NewEthAddress); err != nil {
+			return sdkerrors.Wrap(err, "ethereum address")
+		}
+		if err := validateEthSignature(msg.EthSignature); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRotateDelegateKeys) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgRotateDelegateKeys) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(acc)}
+}
+
 // NewMsgValsetConfirm returns a new msgValsetConfirm
 func NewMsgValsetConfirm(
 	nonce uint64,
@@ -658,6 +743,68 @@ func (b *MsgValsetUpdatedClaim) ClaimHash() ([]byte, error) {
 	return tmhash.Sum([]byte(path)), nil
 }
 
+// EthereumClaim implementation for MsgEthereumHeightClaim
+// ======================================================
+
+// GetType returns the type of the claim
+func (e *MsgEthereumHeightClaim) GetType() ClaimType {
+	return CLAIM_TYPE_ETHEREUM_HEIGHT
+}
+
+// GetEventNonce returns zero, a height heartbeat is not an event of the Gravity contract and is never
+// attested to, the heights voted by the validators are tallied on their own
+func (e *MsgEthereumHeightClaim) GetEventNonce() uint64 {
+	return 0
+}
+
+// ValidateBasic performs stateless checks
+func (e *MsgEthereumHeightClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(e.Orchestrator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, e.Orchestrator)
+	}
+	if e.BlockHeight == 0 {
+		return fmt.Errorf("block height == 0")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgEthereumHeightClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgEthereumHeightClaim) GetClaimer() sdk.AccAddress {
+	err := msg.ValidateBasic()
+	if err != nil {
+		panic("MsgEthereumHeightClaim failed ValidateBasic! Should have been handled earlier")
+	}
+
+	val, _ := sdk.AccAddressFromBech32(msg.Orchestrator)
+	return val
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgEthereumHeightClaim) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{acc}
+}
+
+// Type should return the action
+func (msg MsgEthereumHeightClaim) Type() string { return "Ethereum_Height_Claim" }
+
+// Route should return the name of the module
+func (msg MsgEthereumHeightClaim) Route() string { return RouterKey }
+
+// ClaimHash implements BridgeDeposit.Hash, the block height is all there is to agree on
+func (b *MsgEthereumHeightClaim) ClaimHash() ([]byte, error) {
+	path := fmt.Sprintf("%d", b.BlockHeight)
+	return tmhash.Sum([]byte(path)), nil
+}
+
 // NewMsgCancelSendToEth returns a new msgSetOrchestratorAddress
 func NewMsgCancelSendToEth(user sdk.AccAddress, id uint64) *MsgCancelSendToEth {
 	return &MsgCancelSendToEth{
@@ -726,3 +873,75 @@ func (msg MsgSubmitBadSignatureEvidence) Type() string { return "Submit_Bad_Sign
 
 // Route should return the name of the module
 func (msg MsgSubmitBadSignatureEvidence) Route() string { return RouterKey }
+
+// MsgSubmitDoubleSignEvidence
+// ======================================================
+
+// ValidateBasic performs stateless checks
+func (e *MsgSubmitDoubleSignEvidence) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(e.Sender); err != nil {
+		return err
+	}
+	if e.SubjectA == nil || e.SubjectB == nil {
+		return sdkerrors.Wrap(ErrInvalid, "both subjects are required")
+	}
+	if e.SignatureA == "" || e.SignatureB == "" {
+		return sdkerrors.Wrap(ErrInvalid, "both signatures are required")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSubmitDoubleSignEvidence) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSubmitDoubleSignEvidence) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic("Invalid signer for MsgSubmitDoubleSignEvidence")
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// Type should return the action
+func (msg MsgSubmitDoubleSignEvidence) Type() string { return "Submit_Double_Sign_Evidence" }
+
+// Route should return the name of the module
+func (msg MsgSubmitDoubleSignEvidence) Route() string { return RouterKey }
+
+// NewMsgClaimDepositEscrow returns a new msgClaimDepositEscrow
+func NewMsgClaimDepositEscrow(receiver sdk.AccAddress) *MsgClaimDepositEscrow {
+	return &MsgClaimDepositEscrow{
+		Receiver: receiver.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgClaimDepositEscrow) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgClaimDepositEscrow) Type() string { return "claim_deposit_escrow" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgClaimDepositEscrow) ValidateBasic() (err error) {
+	if _, err = sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Receiver)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgClaimDepositEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgClaimDepositEscrow) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
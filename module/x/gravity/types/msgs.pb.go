@@ -46,10 +46,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // ETH_ADDRESS
 // This is a hex encoded 0x Ethereum public key that will be used by this validator
 // on Ethereum
+// ETH_SIGNATURE
+// This is a hex encoded signature by the Ethereum key over a DelegateKeysSignMsg
+// proving that the validator controls the Ethereum address
 type MsgSetOrchestratorAddress struct {
 	Validator    string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
 	EthAddress   string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	EthSignature string `protobuf:"bytes,4,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
 }
 
 func (m *MsgSetOrchestratorAddress) Reset()         { *m = MsgSetOrchestratorAddress{} }
@@ -106,6 +110,13 @@ func (m *MsgSetOrchestratorAddress) GetEthAddress() string {
 	return ""
 }
 
+func (m *MsgSetOrchestratorAddress) GetEthSignature() string {
+	if m != nil {
+		return m.EthSignature
+	}
+	return ""
+}
+
 type MsgSetOrchestratorAddressResponse struct {
 }
 
@@ -142,6 +153,126 @@ func (m *MsgSetOrchestratorAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetOrchestratorAddressResponse proto.InternalMessageInfo
 
+// MsgRotateDelegateKeys
+// this message allows a validator that has already set its delegate keys
+// to replace its orchestrator address and/or its Ethereum address. The
+// replaced keys are kept in a historical mapping so that confirms and
+// attestations made with them still resolve to the validator
+// VALIDATOR
+// The validator field is a cosmosvaloper1... string (i.e. sdk.ValAddress)
+// that has previously set delegate keys with MsgSetOrchestratorAddress
+// NEW_ORCHESTRATOR
+// The new cosmos1... orchestrator address, leave empty to keep the current one
+// NEW_ETH_ADDRESS
+// The new hex encoded 0x Ethereum address, leave empty to keep the current one.
+// Changing the Ethereum address triggers a new validator set update
+// ETH_SIGNATURE
+// Required when the Ethereum address changes, a hex encoded signature by the new
+// Ethereum key over a DelegateKeysSignMsg for the orchestrator used after the rotation
+type MsgRotateDelegateKeys struct {
+	Validator       string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	NewOrchestrator string `protobuf:"bytes,2,opt,name=new_orchestrator,json=newOrchestrator,proto3" json:"new_orchestrator,omitempty"`
+	NewEthAddress   string `protobuf:"bytes,3,opt,name=new_eth_address,json=newEthAddress,proto3" json:"new_eth_address,omitempty"`
+	EthSignature    string `protobuf:"bytes,4,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
+}
+
+func (m *MsgRotateDelegateKeys) Reset()         { *m = MsgRotateDelegateKeys{} }
+func (m *MsgRotateDelegateKeys) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDelegateKeys) ProtoMessage()    {}
+func (*MsgRotateDelegateKeys) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{2}
+}
+func (m *MsgRotateDelegateKeys) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateDelegateKeys) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDelegateKeys.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateDelegateKeys) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDelegateKeys.Merge(m, src)
+}
+func (m *MsgRotateDelegateKeys) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateDelegateKeys) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDelegateKeys.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateDelegateKeys proto.InternalMessageInfo
+
+func (m *MsgRotateDelegateKeys) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MsgRotateDelegateKeys) GetNewOrchestrator() string {
+	if m != nil {
+		return m.NewOrchestrator
+	}
+	return ""
+}
+
+func (m *MsgRotateDelegateKeys) GetNewEthAddress() string {
+	if m != nil {
+		return m.NewEthAddress
+	}
+	return ""
+}
+
+func (m *MsgRotateDelegateKeys) GetEthSignature() string {
+	if m != nil {
+		return m.EthSignature
+	}
+	return ""
+}
+
+type MsgRotateDelegateKeysResponse struct {
+}
+
+func (m *MsgRotateDelegateKeysResponse) Reset()         { *m = MsgRotateDelegateKeysResponse{} }
+func (m *MsgRotateDelegateKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateDelegateKeysResponse) ProtoMessage()    {}
+func (*MsgRotateDelegateKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{3}
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateDelegateKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateDelegateKeysResponse.Merge(m, src)
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateDelegateKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateDelegateKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateDelegateKeysResponse proto.InternalMessageInfo
+
 // MsgValsetConfirm
 // this is the message sent by the validators when they wish to submit their
 // signatures over the validator set at a given block height. A validator must
@@ -168,7 +299,7 @@ func (m *MsgValsetConfirm) Reset()         { *m = MsgValsetConfirm{} }
 func (m *MsgValsetConfirm) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetConfirm) ProtoMessage()    {}
 func (*MsgValsetConfirm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{2}
+	return fileDescriptor_2f8523f2f6feb451, []int{4}
 }
 func (m *MsgValsetConfirm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,7 +363,7 @@ func (m *MsgValsetConfirmResponse) Reset()         { *m = MsgValsetConfirmRespon
 func (m *MsgValsetConfirmResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetConfirmResponse) ProtoMessage()    {}
 func (*MsgValsetConfirmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{3}
+	return fileDescriptor_2f8523f2f6feb451, []int{5}
 }
 func (m *MsgValsetConfirmResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,7 +415,7 @@ func (m *MsgSendToEth) Reset()         { *m = MsgSendToEth{} }
 func (m *MsgSendToEth) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToEth) ProtoMessage()    {}
 func (*MsgSendToEth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{4}
+	return fileDescriptor_2f8523f2f6feb451, []int{6}
 }
 func (m *MsgSendToEth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -348,7 +479,7 @@ func (m *MsgSendToEthResponse) Reset()         { *m = MsgSendToEthResponse{} }
 func (m *MsgSendToEthResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToEthResponse) ProtoMessage()    {}
 func (*MsgSendToEthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{5}
+	return fileDescriptor_2f8523f2f6feb451, []int{7}
 }
 func (m *MsgSendToEthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -395,7 +526,7 @@ func (m *MsgRequestBatch) Reset()         { *m = MsgRequestBatch{} }
 func (m *MsgRequestBatch) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatch) ProtoMessage()    {}
 func (*MsgRequestBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{6}
+	return fileDescriptor_2f8523f2f6feb451, []int{8}
 }
 func (m *MsgRequestBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -445,7 +576,7 @@ func (m *MsgRequestBatchResponse) Reset()         { *m = MsgRequestBatchResponse
 func (m *MsgRequestBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatchResponse) ProtoMessage()    {}
 func (*MsgRequestBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{7}
+	return fileDescriptor_2f8523f2f6feb451, []int{9}
 }
 func (m *MsgRequestBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -494,7 +625,7 @@ func (m *MsgConfirmBatch) Reset()         { *m = MsgConfirmBatch{} }
 func (m *MsgConfirmBatch) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmBatch) ProtoMessage()    {}
 func (*MsgConfirmBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{8}
+	return fileDescriptor_2f8523f2f6feb451, []int{10}
 }
 func (m *MsgConfirmBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +696,7 @@ func (m *MsgConfirmBatchResponse) Reset()         { *m = MsgConfirmBatchResponse
 func (m *MsgConfirmBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmBatchResponse) ProtoMessage()    {}
 func (*MsgConfirmBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{9}
+	return fileDescriptor_2f8523f2f6feb451, []int{11}
 }
 func (m *MsgConfirmBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -614,7 +745,7 @@ func (m *MsgConfirmLogicCall) Reset()         { *m = MsgConfirmLogicCall{} }
 func (m *MsgConfirmLogicCall) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmLogicCall) ProtoMessage()    {}
 func (*MsgConfirmLogicCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{10}
+	return fileDescriptor_2f8523f2f6feb451, []int{12}
 }
 func (m *MsgConfirmLogicCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,7 +816,7 @@ func (m *MsgConfirmLogicCallResponse) Reset()         { *m = MsgConfirmLogicCall
 func (m *MsgConfirmLogicCallResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmLogicCallResponse) ProtoMessage()    {}
 func (*MsgConfirmLogicCallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{11}
+	return fileDescriptor_2f8523f2f6feb451, []int{13}
 }
 func (m *MsgConfirmLogicCallResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +864,7 @@ func (m *MsgSendToCosmosClaim) Reset()         { *m = MsgSendToCosmosClaim{} }
 func (m *MsgSendToCosmosClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToCosmosClaim) ProtoMessage()    {}
 func (*MsgSendToCosmosClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{12}
+	return fileDescriptor_2f8523f2f6feb451, []int{14}
 }
 func (m *MsgSendToCosmosClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +942,7 @@ func (m *MsgSendToCosmosClaimResponse) Reset()         { *m = MsgSendToCosmosCla
 func (m *MsgSendToCosmosClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToCosmosClaimResponse) ProtoMessage()    {}
 func (*MsgSendToCosmosClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{13}
+	return fileDescriptor_2f8523f2f6feb451, []int{15}
 }
 func (m *MsgSendToCosmosClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -854,7 +985,7 @@ func (m *MsgBatchSendToEthClaim) Reset()         { *m = MsgBatchSendToEthClaim{}
 func (m *MsgBatchSendToEthClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgBatchSendToEthClaim) ProtoMessage()    {}
 func (*MsgBatchSendToEthClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{14}
+	return fileDescriptor_2f8523f2f6feb451, []int{16}
 }
 func (m *MsgBatchSendToEthClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,7 +1056,7 @@ func (m *MsgBatchSendToEthClaimResponse) Reset()         { *m = MsgBatchSendToEt
 func (m *MsgBatchSendToEthClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBatchSendToEthClaimResponse) ProtoMessage()    {}
 func (*MsgBatchSendToEthClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{15}
+	return fileDescriptor_2f8523f2f6feb451, []int{17}
 }
 func (m *MsgBatchSendToEthClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -972,7 +1103,7 @@ func (m *MsgERC20DeployedClaim) Reset()         { *m = MsgERC20DeployedClaim{} }
 func (m *MsgERC20DeployedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgERC20DeployedClaim) ProtoMessage()    {}
 func (*MsgERC20DeployedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{16}
+	return fileDescriptor_2f8523f2f6feb451, []int{18}
 }
 func (m *MsgERC20DeployedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1064,7 +1195,7 @@ func (m *MsgERC20DeployedClaimResponse) Reset()         { *m = MsgERC20DeployedC
 func (m *MsgERC20DeployedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgERC20DeployedClaimResponse) ProtoMessage()    {}
 func (*MsgERC20DeployedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{17}
+	return fileDescriptor_2f8523f2f6feb451, []int{19}
 }
 func (m *MsgERC20DeployedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1107,7 +1238,7 @@ func (m *MsgLogicCallExecutedClaim) Reset()         { *m = MsgLogicCallExecutedC
 func (m *MsgLogicCallExecutedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgLogicCallExecutedClaim) ProtoMessage()    {}
 func (*MsgLogicCallExecutedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{18}
+	return fileDescriptor_2f8523f2f6feb451, []int{20}
 }
 func (m *MsgLogicCallExecutedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1178,7 +1309,7 @@ func (m *MsgLogicCallExecutedClaimResponse) Reset()         { *m = MsgLogicCallE
 func (m *MsgLogicCallExecutedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLogicCallExecutedClaimResponse) ProtoMessage()    {}
 func (*MsgLogicCallExecutedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{19}
+	return fileDescriptor_2f8523f2f6feb451, []int{21}
 }
 func (m *MsgLogicCallExecutedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1207,6 +1338,100 @@ func (m *MsgLogicCallExecutedClaimResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLogicCallExecutedClaimResponse proto.InternalMessageInfo
 
+// This is a heartbeat that informs the Cosmos module of the
+// latest Ethereum block height the orchestrator has seen, it
+// is submitted periodically so the observed Ethereum height
+// advances when no other events happen on Ethereum.
+// Unlike the other claims it has no event nonce, the heights
+// voted by the validators are tallied in the end blocker
+type MsgEthereumHeightClaim struct {
+	BlockHeight  uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+}
+
+func (m *MsgEthereumHeightClaim) Reset()         { *m = MsgEthereumHeightClaim{} }
+func (m *MsgEthereumHeightClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumHeightClaim) ProtoMessage()    {}
+func (*MsgEthereumHeightClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{22}
+}
+func (m *MsgEthereumHeightClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEthereumHeightClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEthereumHeightClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEthereumHeightClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumHeightClaim.Merge(m, src)
+}
+func (m *MsgEthereumHeightClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEthereumHeightClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumHeightClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEthereumHeightClaim proto.InternalMessageInfo
+
+func (m *MsgEthereumHeightClaim) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *MsgEthereumHeightClaim) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
+	}
+	return ""
+}
+
+type MsgEthereumHeightClaimResponse struct {
+}
+
+func (m *MsgEthereumHeightClaimResponse) Reset()         { *m = MsgEthereumHeightClaimResponse{} }
+func (m *MsgEthereumHeightClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEthereumHeightClaimResponse) ProtoMessage()    {}
+func (*MsgEthereumHeightClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{23}
+}
+func (m *MsgEthereumHeightClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEthereumHeightClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEthereumHeightClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEthereumHeightClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEthereumHeightClaimResponse.Merge(m, src)
+}
+func (m *MsgEthereumHeightClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEthereumHeightClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEthereumHeightClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEthereumHeightClaimResponse proto.InternalMessageInfo
+
 // This informs the Cosmos module that a validator
 // set has been updated.
 type MsgValsetUpdatedClaim struct {
@@ -1223,7 +1448,7 @@ func (m *MsgValsetUpdatedClaim) Reset()         { *m = MsgValsetUpdatedClaim{} }
 func (m *MsgValsetUpdatedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetUpdatedClaim) ProtoMessage()    {}
 func (*MsgValsetUpdatedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{20}
+	return fileDescriptor_2f8523f2f6feb451, []int{24}
 }
 func (m *MsgValsetUpdatedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1301,7 +1526,7 @@ func (m *MsgValsetUpdatedClaimResponse) Reset()         { *m = MsgValsetUpdatedC
 func (m *MsgValsetUpdatedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetUpdatedClaimResponse) ProtoMessage()    {}
 func (*MsgValsetUpdatedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{21}
+	return fileDescriptor_2f8523f2f6feb451, []int{25}
 }
 func (m *MsgValsetUpdatedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1342,7 +1567,7 @@ func (m *MsgCancelSendToEth) Reset()         { *m = MsgCancelSendToEth{} }
 func (m *MsgCancelSendToEth) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSendToEth) ProtoMessage()    {}
 func (*MsgCancelSendToEth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{22}
+	return fileDescriptor_2f8523f2f6feb451, []int{26}
 }
 func (m *MsgCancelSendToEth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1392,7 +1617,7 @@ func (m *MsgCancelSendToEthResponse) Reset()         { *m = MsgCancelSendToEthRe
 func (m *MsgCancelSendToEthResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSendToEthResponse) ProtoMessage()    {}
 func (*MsgCancelSendToEthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{23}
+	return fileDescriptor_2f8523f2f6feb451, []int{27}
 }
 func (m *MsgCancelSendToEthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1435,7 +1660,7 @@ func (m *MsgSubmitBadSignatureEvidence) Reset()         { *m = MsgSubmitBadSigna
 func (m *MsgSubmitBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidence) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{24}
+	return fileDescriptor_2f8523f2f6feb451, []int{28}
 }
 func (m *MsgSubmitBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1492,7 +1717,7 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Reset()         { *m = MsgSubmit
 func (m *MsgSubmitBadSignatureEvidenceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidenceResponse) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{25}
+	return fileDescriptor_2f8523f2f6feb451, []int{29}
 }
 func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1521,173 +1746,405 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSetOrchestratorAddress)(nil), "gravity.v1.MsgSetOrchestratorAddress")
-	proto.RegisterType((*MsgSetOrchestratorAddressResponse)(nil), "gravity.v1.MsgSetOrchestratorAddressResponse")
-	proto.RegisterType((*MsgValsetConfirm)(nil), "gravity.v1.MsgValsetConfirm")
-	proto.RegisterType((*MsgValsetConfirmResponse)(nil), "gravity.v1.MsgValsetConfirmResponse")
-	proto.RegisterType((*MsgSendToEth)(nil), "gravity.v1.MsgSendToEth")
-	proto.RegisterType((*MsgSendToEthResponse)(nil), "gravity.v1.MsgSendToEthResponse")
-	proto.RegisterType((*MsgRequestBatch)(nil), "gravity.v1.MsgRequestBatch")
-	proto.RegisterType((*MsgRequestBatchResponse)(nil), "gravity.v1.MsgRequestBatchResponse")
-	proto.RegisterType((*MsgConfirmBatch)(nil), "gravity.v1.MsgConfirmBatch")
-	proto.RegisterType((*MsgConfirmBatchResponse)(nil), "gravity.v1.MsgConfirmBatchResponse")
-	proto.RegisterType((*MsgConfirmLogicCall)(nil), "gravity.v1.MsgConfirmLogicCall")
-	proto.RegisterType((*MsgConfirmLogicCallResponse)(nil), "gravity.v1.MsgConfirmLogicCallResponse")
-	proto.RegisterType((*MsgSendToCosmosClaim)(nil), "gravity.v1.MsgSendToCosmosClaim")
-	proto.RegisterType((*MsgSendToCosmosClaimResponse)(nil), "gravity.v1.MsgSendToCosmosClaimResponse")
-	proto.RegisterType((*MsgBatchSendToEthClaim)(nil), "gravity.v1.MsgBatchSendToEthClaim")
-	proto.RegisterType((*MsgBatchSendToEthClaimResponse)(nil), "gravity.v1.MsgBatchSendToEthClaimResponse")
-	proto.RegisterType((*MsgERC20DeployedClaim)(nil), "gravity.v1.MsgERC20DeployedClaim")
-	proto.RegisterType((*MsgERC20DeployedClaimResponse)(nil), "gravity.v1.MsgERC20DeployedClaimResponse")
-	proto.RegisterType((*MsgLogicCallExecutedClaim)(nil), "gravity.v1.MsgLogicCallExecutedClaim")
-	proto.RegisterType((*MsgLogicCallExecutedClaimResponse)(nil), "gravity.v1.MsgLogicCallExecutedClaimResponse")
-	proto.RegisterType((*MsgValsetUpdatedClaim)(nil), "gravity.v1.MsgValsetUpdatedClaim")
-	proto.RegisterType((*MsgValsetUpdatedClaimResponse)(nil), "gravity.v1.MsgValsetUpdatedClaimResponse")
-	proto.RegisterType((*MsgCancelSendToEth)(nil), "gravity.v1.MsgCancelSendToEth")
-	proto.RegisterType((*MsgCancelSendToEthResponse)(nil), "gravity.v1.MsgCancelSendToEthResponse")
-	proto.RegisterType((*MsgSubmitBadSignatureEvidence)(nil), "gravity.v1.MsgSubmitBadSignatureEvidence")
-	proto.RegisterType((*MsgSubmitBadSignatureEvidenceResponse)(nil), "gravity.v1.MsgSubmitBadSignatureEvidenceResponse")
+// This call allows anyone to submit evidence that a validator has
+// signed two different checkpoints for the same valset nonce, batch
+// nonce of a token, or logic call invalidation nonce. Either of the
+// signatures may be legitimate on its own, together they show that
+// the Ethereum key of the validator equivocated.
+// The subjects must both be batches, valsets, or logic calls.
+type MsgSubmitDoubleSignEvidence struct {
+	SubjectA   *types1.Any `protobuf:"bytes,1,opt,name=subject_a,json=subjectA,proto3" json:"subject_a,omitempty"`
+	SignatureA string      `protobuf:"bytes,2,opt,name=signature_a,json=signatureA,proto3" json:"signature_a,omitempty"`
+	SubjectB   *types1.Any `protobuf:"bytes,3,opt,name=subject_b,json=subjectB,proto3" json:"subject_b,omitempty"`
+	SignatureB string      `protobuf:"bytes,4,opt,name=signature_b,json=signatureB,proto3" json:"signature_b,omitempty"`
+	Sender     string      `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgSubmitDoubleSignEvidence) Reset()         { *m = MsgSubmitDoubleSignEvidence{} }
+func (m *MsgSubmitDoubleSignEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitDoubleSignEvidence) ProtoMessage()    {}
+func (*MsgSubmitDoubleSignEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{30}
+}
+func (m *MsgSubmitDoubleSignEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
-
-var fileDescriptor_2f8523f2f6feb451 = []byte{
-	// 1573 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6f, 0xdc, 0xc4,
-	0x17, 0x8f, 0x93, 0x4d, 0xd2, 0xbc, 0xcd, 0x8f, 0xd6, 0x4d, 0xd3, 0x8d, 0x93, 0x6e, 0x12, 0xb7,
-	0xf9, 0xd1, 0x6f, 0xbf, 0xd9, 0x6d, 0xc2, 0x81, 0x03, 0x12, 0xa8, 0x9b, 0xa6, 0xa2, 0x12, 0x29,
-	0xd2, 0xa6, 0xf4, 0x80, 0x90, 0xac, 0x59, 0x7b, 0xea, 0x35, 0xb5, 0x3d, 0xc1, 0x9e, 0xdd, 0x36,
-	0x97, 0x4a, 0xc0, 0x09, 0x95, 0x03, 0x3f, 0x4e, 0x48, 0xf0, 0x27, 0x20, 0x2e, 0x9c, 0xb8, 0x70,
-	0xad, 0x38, 0xa0, 0x4a, 0x1c, 0x40, 0x20, 0x55, 0xa8, 0xe5, 0x0f, 0x41, 0x9e, 0x19, 0x4f, 0x66,
-	0x6d, 0x6f, 0xb2, 0xa0, 0x70, 0xca, 0xce, 0x9b, 0x37, 0xf3, 0x3e, 0xef, 0xf3, 0xde, 0xbc, 0xf7,
-	0x62, 0xb8, 0xe0, 0x46, 0xa8, 0xeb, 0xd1, 0xc3, 0x7a, 0x77, 0xab, 0x1e, 0xc4, 0x6e, 0x5c, 0x3b,
-	0x88, 0x08, 0x25, 0x3a, 0x08, 0x71, 0xad, 0xbb, 0x65, 0x54, 0x6d, 0x12, 0x07, 0x24, 0xae, 0xb7,
-	0x50, 0x8c, 0xeb, 0xdd, 0xad, 0x16, 0xa6, 0x68, 0xab, 0x6e, 0x13, 0x2f, 0xe4, 0xba, 0xc6, 0xac,
-	0x4b, 0x5c, 0xc2, 0x7e, 0xd6, 0x93, 0x5f, 0x42, 0xba, 0xe8, 0x12, 0xe2, 0xfa, 0xb8, 0x8e, 0x0e,
-	0xbc, 0x3a, 0x0a, 0x43, 0x42, 0x11, 0xf5, 0x48, 0x28, 0xee, 0x37, 0xe6, 0x14, 0xb3, 0xf4, 0xf0,
-	0x00, 0xa7, 0xf2, 0x79, 0x71, 0x8a, 0xad, 0x5a, 0x9d, 0xfb, 0x75, 0x14, 0x1e, 0xa6, 0x5b, 0x1c,
-	0x86, 0xc5, 0x2d, 0xf1, 0x05, 0xdf, 0x32, 0x1f, 0xc3, 0xfc, 0x5e, 0xec, 0xee, 0x63, 0xfa, 0x76,
-	0x64, 0xb7, 0x71, 0x4c, 0x23, 0x44, 0x49, 0x74, 0xc3, 0x71, 0x22, 0x1c, 0xc7, 0xfa, 0x22, 0x4c,
-	0x74, 0x91, 0xef, 0x39, 0x89, 0xac, 0xa2, 0x2d, 0x6b, 0x1b, 0x13, 0xcd, 0x23, 0x81, 0x6e, 0xc2,
-	0x24, 0x51, 0x0e, 0x55, 0x86, 0x99, 0x42, 0x8f, 0x4c, 0x5f, 0x82, 0x32, 0xa6, 0x6d, 0x0b, 0xf1,
-	0x0b, 0x2b, 0x23, 0x4c, 0x05, 0x30, 0x6d, 0x0b, 0x13, 0xe6, 0x65, 0x58, 0xe9, 0x6b, 0xbf, 0x89,
-	0xe3, 0x03, 0x12, 0xc6, 0xd8, 0x7c, 0xa2, 0xc1, 0xd9, 0xbd, 0xd8, 0xbd, 0x87, 0xfc, 0x18, 0xd3,
-	0x1d, 0x12, 0xde, 0xf7, 0xa2, 0x40, 0x9f, 0x85, 0xd1, 0x90, 0x84, 0x36, 0x66, 0xc0, 0x4a, 0x4d,
-	0xbe, 0x38, 0x15, 0x50, 0x89, 0xdf, 0xb1, 0xe7, 0x86, 0x88, 0x76, 0x22, 0x5c, 0x29, 0x71, 0xbf,
-	0xa5, 0xc0, 0x34, 0xa0, 0x92, 0x05, 0x23, 0x91, 0xfe, 0xa0, 0xc1, 0x24, 0xf3, 0x27, 0x74, 0xee,
-	0x92, 0x5d, 0xda, 0xd6, 0xe7, 0x60, 0x2c, 0xc6, 0xa1, 0x83, 0x53, 0xfe, 0xc4, 0x4a, 0x9f, 0x87,
-	0x33, 0x09, 0x06, 0x07, 0xc7, 0x54, 0x60, 0x1c, 0xc7, 0xb4, 0x7d, 0x13, 0xc7, 0x54, 0x7f, 0x15,
-	0xc6, 0x50, 0x40, 0x3a, 0x21, 0x65, 0xc8, 0xca, 0xdb, 0xf3, 0x35, 0x11, 0xb1, 0x24, 0x8b, 0x6a,
-	0x22, 0x8b, 0x6a, 0x3b, 0xc4, 0x0b, 0x1b, 0xa5, 0xa7, 0xcf, 0x97, 0x86, 0x9a, 0x42, 0x5d, 0x7f,
-	0x1d, 0xa0, 0x15, 0x79, 0x8e, 0x8b, 0xad, 0xfb, 0x98, 0xe3, 0x1e, 0xe0, 0xf0, 0x04, 0x3f, 0x72,
-	0x0b, 0x63, 0x73, 0x0e, 0x66, 0x55, 0xec, 0xd2, 0xa9, 0x37, 0x60, 0x66, 0x2f, 0x76, 0x9b, 0xf8,
-	0x83, 0x0e, 0x8e, 0x69, 0x03, 0x51, 0xbb, 0xbf, 0x5b, 0xb3, 0x30, 0xea, 0xe0, 0x90, 0x04, 0xc2,
-	0x27, 0xbe, 0x30, 0xe7, 0xe1, 0x62, 0xe6, 0x02, 0x79, 0xf7, 0x77, 0x1a, 0xbb, 0x5c, 0xf0, 0xc8,
-	0x2f, 0x2f, 0x8e, 0xec, 0x2a, 0x4c, 0x53, 0xf2, 0x00, 0x87, 0x96, 0x4d, 0x42, 0x1a, 0x21, 0x3b,
-	0xe5, 0x6d, 0x8a, 0x49, 0x77, 0x84, 0x50, 0xbf, 0x04, 0x49, 0x24, 0xad, 0x24, 0x5c, 0x38, 0x12,
-	0xb1, 0x9d, 0xc0, 0xb4, 0xbd, 0xcf, 0x04, 0xb9, 0xfc, 0x28, 0x15, 0xe4, 0x47, 0x4f, 0xf8, 0x47,
-	0xb3, 0xe1, 0xe7, 0xce, 0xa8, 0x80, 0xa5, 0x33, 0x3f, 0x6b, 0x70, 0xfe, 0x68, 0xef, 0x2d, 0xe2,
-	0x7a, 0xf6, 0x0e, 0xf2, 0x7d, 0x7d, 0x1d, 0x66, 0xbc, 0x50, 0x3c, 0x1c, 0x8f, 0x84, 0x96, 0xe7,
-	0x08, 0xda, 0xa6, 0x55, 0xf1, 0x6d, 0x47, 0xdf, 0x04, 0xbd, 0x47, 0x91, 0xd3, 0x30, 0xcc, 0x68,
-	0x38, 0xa7, 0xee, 0xdc, 0x61, 0x94, 0xfc, 0xe7, 0xbe, 0x5e, 0x82, 0x85, 0x02, 0x7f, 0xa4, 0xbf,
-	0x3f, 0x0e, 0x2b, 0x19, 0xb3, 0xc3, 0xf2, 0x6c, 0xc7, 0x47, 0x5e, 0xc0, 0x5e, 0x58, 0x17, 0x87,
-	0xd4, 0x52, 0xe3, 0x08, 0x4c, 0xc4, 0x91, 0xaf, 0xc0, 0x64, 0xcb, 0x27, 0xf6, 0x03, 0xab, 0x8d,
-	0x3d, 0xb7, 0x4d, 0x85, 0x8b, 0x65, 0x26, 0x7b, 0x93, 0x89, 0x0a, 0xe2, 0x3d, 0x52, 0x14, 0xef,
-	0x5b, 0xf2, 0xb5, 0x30, 0xf7, 0x1a, 0xb5, 0x24, 0xab, 0x7f, 0x7f, 0xbe, 0xb4, 0xe6, 0x7a, 0xb4,
-	0xdd, 0x69, 0xd5, 0x6c, 0x12, 0x88, 0x8a, 0x27, 0xfe, 0x6c, 0xc6, 0xce, 0x03, 0x51, 0x38, 0x6f,
-	0x87, 0x54, 0x3e, 0x9e, 0x75, 0x98, 0xc1, 0xb4, 0x8d, 0x23, 0xdc, 0x09, 0x2c, 0x91, 0xda, 0x9c,
-	0x8e, 0xe9, 0x54, 0xbc, 0xcf, 0x53, 0x7c, 0x1d, 0x66, 0x44, 0x39, 0x8d, 0xb0, 0x8d, 0xbd, 0x2e,
-	0x8e, 0x2a, 0x63, 0x5c, 0x91, 0x8b, 0x9b, 0x42, 0x9a, 0xa3, 0x7f, 0x3c, 0x4f, 0xbf, 0x59, 0x85,
-	0xc5, 0x22, 0x02, 0x25, 0xc3, 0x4f, 0x35, 0x98, 0xdb, 0x8b, 0x5d, 0x96, 0x66, 0xf2, 0x61, 0x9e,
-	0x1e, 0xc7, 0x4b, 0x50, 0x6e, 0x25, 0x57, 0x8b, 0x3b, 0x46, 0xf8, 0x1d, 0x4c, 0x74, 0xa7, 0xcf,
-	0xa3, 0x2b, 0x15, 0x05, 0x21, 0xeb, 0xea, 0x68, 0x81, 0xab, 0xcb, 0x50, 0x2d, 0xf6, 0x44, 0x3a,
-	0xfb, 0xf9, 0x30, 0x5c, 0xd8, 0x8b, 0xdd, 0xdd, 0xe6, 0xce, 0xf6, 0xf5, 0x9b, 0xf8, 0xc0, 0x27,
-	0x87, 0xd8, 0x39, 0x3d, 0x5f, 0x57, 0x60, 0x52, 0xc4, 0x8d, 0x57, 0x28, 0x9e, 0x4d, 0x65, 0x2e,
-	0xbb, 0x99, 0x88, 0x06, 0xf5, 0x56, 0x87, 0x52, 0x88, 0x82, 0xf4, 0xb9, 0xb0, 0xdf, 0xac, 0x20,
-	0x1e, 0x06, 0x2d, 0xe2, 0x8b, 0x64, 0x10, 0x2b, 0xdd, 0x80, 0x33, 0x0e, 0xb6, 0xbd, 0x00, 0xf9,
-	0x31, 0x4b, 0x80, 0x52, 0x53, 0xae, 0x73, 0xac, 0x9d, 0x29, 0x60, 0x6d, 0x09, 0x2e, 0x15, 0x52,
-	0x22, 0x49, 0xfb, 0x43, 0x63, 0x1d, 0x5c, 0x3e, 0xce, 0xdd, 0x47, 0xd8, 0xee, 0xd0, 0xd3, 0x24,
-	0xae, 0xa0, 0x7a, 0x25, 0xdc, 0x4d, 0x0e, 0x58, 0xbd, 0x4a, 0xfd, 0xaa, 0xd7, 0x20, 0x49, 0xc3,
-	0xc7, 0x83, 0x62, 0xe7, 0x24, 0x05, 0xbf, 0xf2, 0xbc, 0xe1, 0x1d, 0xf9, 0x9d, 0x03, 0x07, 0xfd,
-	0x23, 0xf7, 0xbb, 0xec, 0x58, 0x4f, 0xa9, 0x2d, 0x73, 0x59, 0x31, 0x43, 0x23, 0x79, 0x86, 0x5e,
-	0x83, 0xf1, 0x00, 0x07, 0x2d, 0x1c, 0xc5, 0x95, 0xd2, 0xf2, 0xc8, 0x46, 0x79, 0x7b, 0xa1, 0x76,
-	0x34, 0x04, 0xd6, 0x1a, 0xac, 0xc1, 0xde, 0x4b, 0xe7, 0x26, 0xd1, 0x77, 0xd3, 0x13, 0xfa, 0x3e,
-	0x4c, 0x45, 0xf8, 0x21, 0x8a, 0x1c, 0x4b, 0xd4, 0xb1, 0xd1, 0x7f, 0x55, 0xc7, 0x26, 0xf9, 0x25,
-	0x37, 0x78, 0x35, 0x5b, 0x01, 0xb1, 0xb6, 0x58, 0xea, 0x8a, 0xa4, 0x2c, 0x73, 0xd9, 0xdd, 0x44,
-	0x34, 0x50, 0x79, 0xe2, 0xd9, 0x97, 0x27, 0x56, 0x52, 0xbf, 0x0f, 0x7a, 0xd2, 0x20, 0x50, 0x68,
-	0x63, 0xff, 0x68, 0xe8, 0x49, 0xde, 0x51, 0x84, 0xc2, 0x18, 0xd9, 0x6a, 0xbb, 0x2b, 0x35, 0xa7,
-	0x14, 0xe9, 0x6d, 0x47, 0x19, 0x22, 0x86, 0xd5, 0x21, 0xc2, 0x5c, 0x04, 0x23, 0x7f, 0xa9, 0x34,
-	0xf9, 0x95, 0xc6, 0x40, 0xed, 0x77, 0x5a, 0x81, 0x47, 0x1b, 0xc8, 0xd9, 0x4f, 0xbb, 0xd5, 0x6e,
-	0xd7, 0x73, 0x70, 0x12, 0xb1, 0x06, 0x8c, 0xc7, 0x9d, 0xd6, 0xfb, 0xd8, 0xa6, 0xcc, 0x6e, 0x79,
-	0x7b, 0xb6, 0xc6, 0x67, 0xe3, 0x5a, 0x3a, 0x1b, 0xd7, 0x6e, 0x84, 0x87, 0x0d, 0xfd, 0xa7, 0xef,
-	0x37, 0xa7, 0x77, 0xd3, 0xe2, 0x9e, 0xb4, 0x4c, 0xa7, 0x99, 0x1e, 0xec, 0xed, 0x8b, 0xc3, 0x99,
-	0xbe, 0xa8, 0x20, 0x1f, 0xe9, 0x41, 0xbe, 0x0e, 0xab, 0xc7, 0x42, 0x4b, 0x9d, 0xd8, 0xfe, 0x78,
-	0x1a, 0x46, 0xf6, 0x62, 0x57, 0x7f, 0x08, 0x53, 0xbd, 0x53, 0xed, 0xa2, 0x9a, 0x39, 0xd9, 0x31,
-	0xd3, 0xb8, 0x72, 0xdc, 0xae, 0x64, 0xc8, 0xfc, 0xe8, 0x97, 0xbf, 0xbe, 0x1c, 0x5e, 0x34, 0x8d,
-	0xba, 0xf2, 0xaf, 0x82, 0x48, 0x73, 0x5b, 0xd8, 0x69, 0xc3, 0xc4, 0x51, 0xbc, 0x2a, 0x99, 0x6b,
-	0xe5, 0x8e, 0xb1, 0xdc, 0x6f, 0x47, 0x1a, 0x5b, 0x62, 0xc6, 0xe6, 0xcd, 0x8b, 0xaa, 0xb1, 0x84,
-	0x0e, 0x8b, 0x12, 0x0b, 0xd3, 0xb6, 0x1e, 0xc3, 0x64, 0xcf, 0xe8, 0xb8, 0x90, 0xb9, 0x52, 0xdd,
-	0x34, 0x2e, 0x1f, 0xb3, 0x29, 0x4d, 0xae, 0x30, 0x93, 0x0b, 0xe6, 0xbc, 0x6a, 0x32, 0xe2, 0x9a,
-	0x16, 0x6b, 0x5e, 0x89, 0xd1, 0x9e, 0x91, 0x32, 0x6b, 0x54, 0xdd, 0xcc, 0x19, 0x2d, 0x9c, 0xed,
-	0x0a, 0x8d, 0x0a, 0x36, 0x85, 0xd1, 0xc7, 0x70, 0x36, 0x37, 0xfa, 0x2d, 0x15, 0xdf, 0x2d, 0x15,
-	0x8c, 0xf5, 0x13, 0x14, 0x24, 0x80, 0x65, 0x06, 0xc0, 0x30, 0x2b, 0x39, 0x00, 0x81, 0xe5, 0x27,
-	0xda, 0xfa, 0x27, 0x1a, 0x9c, 0xcb, 0xcf, 0x62, 0xc5, 0x21, 0x54, 0x34, 0x8c, 0x8d, 0x93, 0x34,
-	0x24, 0x86, 0x0d, 0x86, 0xc1, 0x34, 0x97, 0x8b, 0x82, 0x2d, 0xba, 0xab, 0xcd, 0xac, 0x7e, 0xa1,
-	0xc1, 0xf9, 0xa2, 0xa9, 0xc5, 0xcc, 0xd8, 0x2a, 0xd0, 0x31, 0xfe, 0x77, 0xb2, 0x8e, 0x44, 0x74,
-	0x8d, 0x21, 0x5a, 0x35, 0x2f, 0xab, 0x88, 0xf8, 0x4c, 0xa3, 0x24, 0xa1, 0x00, 0xf5, 0x44, 0x83,
-	0x73, 0x6a, 0x31, 0xe3, 0x90, 0x56, 0x0a, 0x1f, 0x95, 0x5a, 0xee, 0x8c, 0xab, 0x27, 0xaa, 0x1c,
-	0x4f, 0x91, 0x78, 0x7c, 0x1d, 0x7e, 0x40, 0xa0, 0xf9, 0x54, 0x03, 0xbd, 0x60, 0xd6, 0xc9, 0xc2,
-	0xc9, 0xab, 0xe4, 0xe0, 0x1c, 0x33, 0x1e, 0x14, 0xc2, 0xc1, 0x91, 0xbd, 0x7d, 0xdd, 0x72, 0xc4,
-	0x01, 0x01, 0xe7, 0x1b, 0x0d, 0xe6, 0xfa, 0x4c, 0x11, 0xab, 0x19, 0x7b, 0xc5, 0x6a, 0xc6, 0xe6,
-	0x40, 0x6a, 0x12, 0xda, 0x26, 0x83, 0xb6, 0x6e, 0xae, 0xaa, 0xd0, 0x58, 0x26, 0x5b, 0x36, 0xf2,
-	0x7d, 0x0b, 0x8b, 0x53, 0x02, 0xdf, 0xd7, 0x1a, 0xcc, 0xf5, 0xf9, 0x4e, 0xb1, 0x9a, 0x4b, 0xe0,
-	0x22, 0xb5, 0x1c, 0xbe, 0x13, 0xbe, 0x3a, 0xfc, 0x9f, 0xe1, 0x5b, 0x33, 0xaf, 0xf4, 0x26, 0x3b,
-	0xb5, 0xd4, 0x16, 0x99, 0x7e, 0x45, 0xd0, 0x3f, 0xd4, 0x60, 0x26, 0xdb, 0x07, 0xab, 0xd9, 0xb7,
-	0xdd, 0xbb, 0x6f, 0xac, 0x1d, 0xbf, 0x2f, 0x91, 0xac, 0x31, 0x24, 0xcb, 0x66, 0xb5, 0xe7, 0xe9,
-	0x33, 0x65, 0x35, 0xcb, 0xf5, 0x6f, 0x35, 0x30, 0x8e, 0xe9, 0x8b, 0xd9, 0xb4, 0xe9, 0xaf, 0x6a,
-	0x6c, 0x0d, 0xac, 0x2a, 0x41, 0x6e, 0x31, 0x90, 0xd7, 0xcc, 0xab, 0x3d, 0x74, 0xb1, 0x73, 0x56,
-	0x0b, 0x39, 0x96, 0xec, 0x9e, 0x16, 0x16, 0x47, 0x1b, 0xef, 0x3d, 0x7d, 0x51, 0xd5, 0x9e, 0xbd,
-	0xa8, 0x6a, 0x7f, 0xbe, 0xa8, 0x6a, 0x9f, 0xbd, 0xac, 0x0e, 0x3d, 0x7b, 0x59, 0x1d, 0xfa, 0xed,
-	0x65, 0x75, 0xe8, 0xdd, 0x86, 0x32, 0xf5, 0x20, 0x9f, 0xb6, 0x31, 0xda, 0x0c, 0x31, 0x4d, 0x27,
-	0x1f, 0x61, 0x60, 0x93, 0x7f, 0xba, 0xa8, 0x07, 0xc4, 0xe9, 0xf8, 0xb8, 0xfe, 0x48, 0x1a, 0x66,
-	0x53, 0x51, 0x6b, 0x8c, 0x75, 0xfb, 0x57, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xc8, 0xbc,
-	0xf6, 0xa8, 0x13, 0x00, 0x00,
+func (m *MsgSubmitDoubleSignEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitDoubleSignEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitDoubleSignEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitDoubleSignEvidence.Merge(m, src)
+}
+func (m *MsgSubmitDoubleSignEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitDoubleSignEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitDoubleSignEvidence.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgSubmitDoubleSignEvidence proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSubmitDoubleSignEvidence) GetSubjectA() *types1.Any {
+	if m != nil {
+		return m.SubjectA
+	}
+	return nil
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	ValsetConfirm(ctx context.Context, in *MsgValsetConfirm, opts ...grpc.CallOption) (*MsgValsetConfirmResponse, error)
-	SendToEth(ctx context.Context, in *MsgSendToEth, opts ...grpc.CallOption) (*MsgSendToEthResponse, error)
-	RequestBatch(ctx context.Context, in *MsgRequestBatch, opts ...grpc.CallOption) (*MsgRequestBatchResponse, error)
-	ConfirmBatch(ctx context.Context, in *MsgConfirmBatch, opts ...grpc.CallOption) (*MsgConfirmBatchResponse, error)
-	ConfirmLogicCall(ctx context.Context, in *MsgConfirmLogicCall, opts ...grpc.CallOption) (*MsgConfirmLogicCallResponse, error)
-	SendToCosmosClaim(ctx context.Context, in *MsgSendToCosmosClaim, opts ...grpc.CallOption) (*MsgSendToCosmosClaimResponse, error)
-	BatchSendToEthClaim(ctx context.Context, in *MsgBatchSendToEthClaim, opts ...grpc.CallOption) (*MsgBatchSendToEthClaimResponse, error)
-	ValsetUpdateClaim(ctx context.Context, in *MsgValsetUpdatedClaim, opts ...grpc.CallOption) (*MsgValsetUpdatedClaimResponse, error)
-	ERC20DeployedClaim(ctx context.Context, in *MsgERC20DeployedClaim, opts ...grpc.CallOption) (*MsgERC20DeployedClaimResponse, error)
-	LogicCallExecutedClaim(ctx context.Context, in *MsgLogicCallExecutedClaim, opts ...grpc.CallOption) (*MsgLogicCallExecutedClaimResponse, error)
-	SetOrchestratorAddress(ctx context.Context, in *MsgSetOrchestratorAddress, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressResponse, error)
-	CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error)
-	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
+func (m *MsgSubmitDoubleSignEvidence) GetSignatureA() string {
+	if m != nil {
+		return m.SignatureA
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSubmitDoubleSignEvidence) GetSubjectB() *types1.Any {
+	if m != nil {
+		return m.SubjectB
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSubmitDoubleSignEvidence) GetSignatureB() string {
+	if m != nil {
+		return m.SignatureB
+	}
+	return ""
+}
+
+func (m *MsgSubmitDoubleSignEvidence) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type MsgSubmitDoubleSignEvidenceResponse struct {
+}
+
+func (m *MsgSubmitDoubleSignEvidenceResponse) Reset()         { *m = MsgSubmitDoubleSignEvidenceResponse{} }
+func (m *MsgSubmitDoubleSignEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitDoubleSignEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitDoubleSignEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{31}
+}
+func (m *MsgSubmitDoubleSignEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitDoubleSignEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitDoubleSignEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitDoubleSignEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitDoubleSignEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSubmitDoubleSignEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitDoubleSignEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitDoubleSignEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitDoubleSignEvidenceResponse proto.InternalMessageInfo
+
+// MsgClaimDepositEscrow
+// this message allows the receiver of deposits from Ethereum which could not be
+// credited to it, for example because it was a blocked address at the time, to
+// claim the escrowed tokens once it is able to receive them
+type MsgClaimDepositEscrow struct {
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *MsgClaimDepositEscrow) Reset()         { *m = MsgClaimDepositEscrow{} }
+func (m *MsgClaimDepositEscrow) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimDepositEscrow) ProtoMessage()    {}
+func (*MsgClaimDepositEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{32}
+}
+func (m *MsgClaimDepositEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimDepositEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimDepositEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimDepositEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimDepositEscrow.Merge(m, src)
+}
+func (m *MsgClaimDepositEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimDepositEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimDepositEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimDepositEscrow proto.InternalMessageInfo
+
+func (m *MsgClaimDepositEscrow) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+type MsgClaimDepositEscrowResponse struct {
+}
+
+func (m *MsgClaimDepositEscrowResponse) Reset()         { *m = MsgClaimDepositEscrowResponse{} }
+func (m *MsgClaimDepositEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimDepositEscrowResponse) ProtoMessage()    {}
+func (*MsgClaimDepositEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{33}
+}
+func (m *MsgClaimDepositEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimDepositEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimDepositEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimDepositEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimDepositEscrowResponse.Merge(m, src)
+}
+func (m *MsgClaimDepositEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimDepositEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimDepositEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimDepositEscrowResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetOrchestratorAddress)(nil), "gravity.v1.MsgSetOrchestratorAddress")
+	proto.RegisterType((*MsgSetOrchestratorAddressResponse)(nil), "gravity.v1.MsgSetOrchestratorAddressResponse")
+	proto.RegisterType((*MsgRotateDelegateKeys)(nil), "gravity.v1.MsgRotateDelegateKeys")
+	proto.RegisterType((*MsgRotateDelegateKeysResponse)(nil), "gravity.v1.MsgRotateDelegateKeysResponse")
+	proto.RegisterType((*MsgValsetConfirm)(nil), "gravity.v1.MsgValsetConfirm")
+	proto.RegisterType((*MsgValsetConfirmResponse)(nil), "gravity.v1.MsgValsetConfirmResponse")
+	proto.RegisterType((*MsgSendToEth)(nil), "gravity.v1.MsgSendToEth")
+	proto.RegisterType((*MsgSendToEthResponse)(nil), "gravity.v1.MsgSendToEthResponse")
+	proto.RegisterType((*MsgRequestBatch)(nil), "gravity.v1.MsgRequestBatch")
+	proto.RegisterType((*MsgRequestBatchResponse)(nil), "gravity.v1.MsgRequestBatchResponse")
+	proto.RegisterType((*MsgConfirmBatch)(nil), "gravity.v1.MsgConfirmBatch")
+	proto.RegisterType((*MsgConfirmBatchResponse)(nil), "gravity.v1.MsgConfirmBatchResponse")
+	proto.RegisterType((*MsgConfirmLogicCall)(nil), "gravity.v1.MsgConfirmLogicCall")
+	proto.RegisterType((*MsgConfirmLogicCallResponse)(nil), "gravity.v1.MsgConfirmLogicCallResponse")
+	proto.RegisterType((*MsgSendToCosmosClaim)(nil), "gravity.v1.MsgSendToCosmosClaim")
+	proto.RegisterType((*MsgSendToCosmosClaimResponse)(nil), "gravity.v1.MsgSendToCosmosClaimResponse")
+	proto.RegisterType((*MsgBatchSendToEthClaim)(nil), "gravity.v1.MsgBatchSendToEthClaim")
+	proto.RegisterType((*MsgBatchSendToEthClaimResponse)(nil), "gravity.v1.MsgBatchSendToEthClaimResponse")
+	proto.RegisterType((*MsgERC20DeployedClaim)(nil), "gravity.v1.MsgERC20DeployedClaim")
+	proto.RegisterType((*MsgERC20DeployedClaimResponse)(nil), "gravity.v1.MsgERC20DeployedClaimResponse")
+	proto.RegisterType((*MsgLogicCallExecutedClaim)(nil), "gravity.v1.MsgLogicCallExecutedClaim")
+	proto.RegisterType((*MsgLogicCallExecutedClaimResponse)(nil), "gravity.v1.MsgLogicCallExecutedClaimResponse")
+	proto.RegisterType((*MsgEthereumHeightClaim)(nil), "gravity.v1.MsgEthereumHeightClaim")
+	proto.RegisterType((*MsgEthereumHeightClaimResponse)(nil), "gravity.v1.MsgEthereumHeightClaimResponse")
+	proto.RegisterType((*MsgValsetUpdatedClaim)(nil), "gravity.v1.MsgValsetUpdatedClaim")
+	proto.RegisterType((*MsgValsetUpdatedClaimResponse)(nil), "gravity.v1.MsgValsetUpdatedClaimResponse")
+	proto.RegisterType((*MsgCancelSendToEth)(nil), "gravity.v1.MsgCancelSendToEth")
+	proto.RegisterType((*MsgCancelSendToEthResponse)(nil), "gravity.v1.MsgCancelSendToEthResponse")
+	proto.RegisterType((*MsgSubmitBadSignatureEvidence)(nil), "gravity.v1.MsgSubmitBadSignatureEvidence")
+	proto.RegisterType((*MsgSubmitBadSignatureEvidenceResponse)(nil), "gravity.v1.MsgSubmitBadSignatureEvidenceResponse")
+	proto.RegisterType((*MsgSubmitDoubleSignEvidence)(nil), "gravity.v1.MsgSubmitDoubleSignEvidence")
+	proto.RegisterType((*MsgSubmitDoubleSignEvidenceResponse)(nil), "gravity.v1.MsgSubmitDoubleSignEvidenceResponse")
+	proto.RegisterType((*MsgClaimDepositEscrow)(nil), "gravity.v1.MsgClaimDepositEscrow")
+	proto.RegisterType((*MsgClaimDepositEscrowResponse)(nil), "gravity.v1.MsgClaimDepositEscrowResponse")
+}
+
+func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
+
+var fileDescriptor_2f8523f2f6feb451 = []byte{
+	// 1862 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x24, 0x47,
+	0x15, 0xdf, 0xb6, 0xc7, 0xbb, 0xeb, 0x37, 0xf6, 0x7a, 0xb7, 0xd7, 0x71, 0xc6, 0x6d, 0xef, 0xd8,
+	0x6e, 0xc7, 0xf6, 0x3a, 0xc1, 0x33, 0xb1, 0x73, 0xe0, 0x80, 0x04, 0xf2, 0xd8, 0x8e, 0x58, 0x81,
+	0x83, 0x34, 0x0e, 0x39, 0x20, 0xa4, 0x56, 0x4d, 0xf7, 0xdb, 0x9e, 0x66, 0xbb, 0xbb, 0x4c, 0x77,
+	0xcd, 0x38, 0xbe, 0x20, 0x81, 0xc4, 0x01, 0x85, 0x43, 0x80, 0x13, 0x12, 0x9c, 0x10, 0xc7, 0x88,
+	0x0b, 0x27, 0x2e, 0x5c, 0x57, 0x1c, 0x50, 0x24, 0x0e, 0x20, 0x40, 0x11, 0xda, 0xe5, 0x0f, 0x41,
+	0x5d, 0x55, 0x5d, 0xee, 0xaf, 0xf9, 0xd8, 0x68, 0x39, 0xcd, 0xd4, 0xab, 0x5f, 0xd5, 0xfb, 0xbd,
+	0xaf, 0xaa, 0x57, 0x0d, 0x6f, 0xb8, 0x11, 0x19, 0x7a, 0xec, 0xba, 0x3d, 0x3c, 0x6c, 0x07, 0xb1,
+	0x1b, 0xb7, 0x2e, 0x23, 0xca, 0xa8, 0x0e, 0x52, 0xdc, 0x1a, 0x1e, 0x1a, 0x4d, 0x9b, 0xc6, 0x01,
+	0x8d, 0xdb, 0x3d, 0x12, 0x63, 0x7b, 0x78, 0xd8, 0x43, 0x46, 0x0e, 0xdb, 0x36, 0xf5, 0x42, 0x81,
+	0x35, 0x96, 0x5d, 0xea, 0x52, 0xfe, 0xb7, 0x9d, 0xfc, 0x93, 0xd2, 0x75, 0x97, 0x52, 0xd7, 0xc7,
+	0x36, 0xb9, 0xf4, 0xda, 0x24, 0x0c, 0x29, 0x23, 0xcc, 0xa3, 0xa1, 0xdc, 0xdf, 0x58, 0xc9, 0xa8,
+	0x65, 0xd7, 0x97, 0x98, 0xca, 0x57, 0xe5, 0x2a, 0x3e, 0xea, 0x0d, 0x9e, 0xb6, 0x49, 0x78, 0x9d,
+	0x4e, 0x09, 0x1a, 0x96, 0xd0, 0x24, 0x06, 0x62, 0xca, 0xfc, 0x9d, 0x06, 0xab, 0xe7, 0xb1, 0x7b,
+	0x81, 0xec, 0x3b, 0x91, 0xdd, 0xc7, 0x98, 0x45, 0x84, 0xd1, 0xe8, 0xd8, 0x71, 0x22, 0x8c, 0x63,
+	0x7d, 0x1d, 0xe6, 0x87, 0xc4, 0xf7, 0x9c, 0x44, 0xd6, 0xd0, 0x36, 0xb5, 0xc7, 0xf3, 0xdd, 0x1b,
+	0x81, 0x6e, 0xc2, 0x02, 0xcd, 0x2c, 0x6a, 0xcc, 0x70, 0x40, 0x4e, 0xa6, 0x6f, 0x40, 0x1d, 0x59,
+	0xdf, 0x22, 0x62, 0xc3, 0xc6, 0x2c, 0x87, 0x00, 0xb2, 0x7e, 0xaa, 0x62, 0x1b, 0x16, 0x13, 0x40,
+	0xec, 0xb9, 0x21, 0x61, 0x83, 0x08, 0x1b, 0x35, 0xb1, 0x0b, 0xb2, 0xfe, 0x45, 0x2a, 0x33, 0xb7,
+	0x61, 0x6b, 0x24, 0xc9, 0x2e, 0xc6, 0x97, 0x34, 0x8c, 0xd1, 0xfc, 0x4c, 0x83, 0x37, 0xce, 0x63,
+	0xb7, 0x9b, 0xf8, 0x0b, 0x4f, 0xd1, 0x47, 0x97, 0x30, 0xfc, 0x16, 0x5e, 0x4f, 0x32, 0x63, 0x1f,
+	0xee, 0x87, 0x78, 0x65, 0x55, 0x98, 0xb2, 0x14, 0xe2, 0x55, 0x56, 0xa3, 0xbe, 0x0b, 0x89, 0xc8,
+	0x2a, 0x5b, 0xb4, 0x18, 0xe2, 0xd5, 0xd9, 0x2b, 0x1a, 0xb5, 0x01, 0x8f, 0x2a, 0xe9, 0x2a, 0x83,
+	0x3e, 0xd1, 0xe0, 0xfe, 0x79, 0xec, 0x7e, 0x44, 0xfc, 0x18, 0xd9, 0x09, 0x0d, 0x9f, 0x7a, 0x51,
+	0xa0, 0x2f, 0xc3, 0x5c, 0x48, 0x43, 0x1b, 0xb9, 0x1d, 0xb5, 0xae, 0x18, 0xbc, 0x9e, 0x50, 0xac,
+	0xc3, 0x7c, 0x91, 0xf1, 0x8d, 0xc0, 0x34, 0xa0, 0x51, 0x24, 0xa3, 0x98, 0xfe, 0x49, 0x83, 0x05,
+	0x1e, 0xa0, 0xd0, 0xf9, 0x90, 0x9e, 0xb1, 0xbe, 0xbe, 0x02, 0xb7, 0x63, 0x0c, 0x1d, 0x4c, 0xdd,
+	0x2d, 0x47, 0xfa, 0x2a, 0xdc, 0x4d, 0x38, 0x38, 0x18, 0x33, 0xc9, 0xf1, 0x0e, 0xb2, 0xfe, 0x29,
+	0xc6, 0x4c, 0xff, 0x2a, 0xdc, 0x26, 0x01, 0x1d, 0x84, 0x8c, 0x33, 0xab, 0x1f, 0xad, 0xb6, 0x64,
+	0xa2, 0x26, 0xc5, 0xd3, 0x92, 0xc5, 0xd3, 0x3a, 0xa1, 0x5e, 0xd8, 0xa9, 0x3d, 0xff, 0x62, 0xe3,
+	0x56, 0x57, 0xc2, 0xf5, 0xaf, 0x03, 0xf4, 0x22, 0xcf, 0x71, 0xd1, 0x7a, 0x8a, 0x82, 0xf7, 0x14,
+	0x8b, 0xe7, 0xc5, 0x92, 0xf7, 0x11, 0xcd, 0x15, 0x58, 0xce, 0x72, 0x57, 0x46, 0x7d, 0x03, 0x96,
+	0x92, 0xf8, 0xe0, 0x0f, 0x07, 0x18, 0xb3, 0x0e, 0x61, 0xf6, 0x68, 0xb3, 0x96, 0x61, 0xce, 0xc1,
+	0x90, 0x06, 0xd2, 0x26, 0x31, 0x30, 0x57, 0xe1, 0xcd, 0xc2, 0x06, 0x6a, 0xef, 0x3f, 0x68, 0x7c,
+	0x73, 0xe9, 0x47, 0xb1, 0x79, 0x75, 0x64, 0x77, 0xe0, 0x1e, 0xa3, 0xcf, 0x30, 0xb4, 0x6c, 0x1a,
+	0xb2, 0x88, 0xd8, 0xa9, 0xdf, 0x16, 0xb9, 0xf4, 0x44, 0x0a, 0xf5, 0x47, 0x00, 0x69, 0xc6, 0x61,
+	0x24, 0x63, 0x3b, 0x2f, 0xd3, 0x0d, 0xcb, 0xa5, 0x5a, 0xab, 0xc8, 0x8f, 0x5c, 0xf8, 0xe7, 0x8a,
+	0xe1, 0x17, 0xc6, 0x64, 0x09, 0x2b, 0x63, 0xfe, 0xaa, 0xc1, 0xc3, 0x9b, 0xb9, 0x6f, 0x53, 0xd7,
+	0xb3, 0x4f, 0x88, 0xef, 0xeb, 0x7b, 0xb0, 0xe4, 0x85, 0xb2, 0xce, 0x3c, 0x1a, 0x5a, 0x9e, 0x23,
+	0xdd, 0x76, 0x2f, 0x2b, 0x7e, 0xe2, 0xe8, 0x07, 0xa0, 0xe7, 0x80, 0xc2, 0x0d, 0x33, 0xdc, 0x0d,
+	0x0f, 0xb2, 0x33, 0x1f, 0x70, 0x97, 0xfc, 0xdf, 0x6d, 0x7d, 0x04, 0x6b, 0x15, 0xf6, 0x28, 0x7b,
+	0xff, 0x3c, 0x93, 0xc9, 0x98, 0x13, 0x9e, 0x67, 0x27, 0x3e, 0xf1, 0x02, 0x5e, 0x61, 0x43, 0x0c,
+	0x99, 0x95, 0x8d, 0x23, 0x70, 0x91, 0x60, 0xbe, 0x05, 0x0b, 0x3d, 0x9f, 0xda, 0xcf, 0xac, 0x3e,
+	0x7a, 0x6e, 0x9f, 0x49, 0x13, 0xeb, 0x5c, 0xf6, 0x4d, 0x2e, 0xaa, 0x88, 0xf7, 0x6c, 0x55, 0xbc,
+	0xdf, 0x57, 0xd5, 0xc2, 0xcd, 0xeb, 0xb4, 0x92, 0xac, 0xfe, 0xe7, 0x17, 0x1b, 0xbb, 0xae, 0xc7,
+	0xfa, 0x83, 0x5e, 0xcb, 0xa6, 0x81, 0x3c, 0xe8, 0xe5, 0xcf, 0x41, 0xec, 0x3c, 0x93, 0xf7, 0xc5,
+	0x93, 0x90, 0xa9, 0xe2, 0xd9, 0x83, 0x25, 0x64, 0x7d, 0x8c, 0x70, 0x10, 0x58, 0x32, 0xb5, 0x85,
+	0x3b, 0xee, 0xa5, 0xe2, 0x0b, 0x91, 0xe2, 0x7b, 0xb0, 0x24, 0x6f, 0x91, 0x08, 0x6d, 0xf4, 0x86,
+	0x18, 0x35, 0x6e, 0x0b, 0xa0, 0x10, 0x77, 0xa5, 0xb4, 0xe4, 0xfe, 0x3b, 0x65, 0xf7, 0x9b, 0x4d,
+	0x58, 0xaf, 0x72, 0xa0, 0xf2, 0xf0, 0x73, 0x0d, 0x56, 0xce, 0x63, 0x97, 0xa7, 0x99, 0x2a, 0xcc,
+	0xd7, 0xe7, 0xe3, 0x0d, 0xa8, 0xf7, 0x92, 0xad, 0xe5, 0x1e, 0xb3, 0x62, 0x0f, 0x2e, 0xfa, 0x60,
+	0x44, 0xd1, 0xd5, 0xaa, 0x82, 0x50, 0x34, 0x75, 0xae, 0xc2, 0xd4, 0x4d, 0x68, 0x56, 0x5b, 0xa2,
+	0x8c, 0xfd, 0xc5, 0x0c, 0xbf, 0xb7, 0xce, 0xba, 0x27, 0x47, 0xef, 0x9e, 0xe2, 0xa5, 0x4f, 0xaf,
+	0xd1, 0x79, 0x7d, 0xb6, 0x6e, 0xc1, 0x82, 0x8c, 0x9b, 0x38, 0xa1, 0x44, 0x36, 0xd5, 0x85, 0xec,
+	0x34, 0x11, 0x4d, 0x6b, 0xad, 0x0e, 0xb5, 0x90, 0x04, 0x69, 0xb9, 0xf0, 0xff, 0xfc, 0x40, 0xbc,
+	0x0e, 0x7a, 0xd4, 0x97, 0xc9, 0x20, 0x47, 0xba, 0x01, 0x77, 0x1d, 0xb4, 0xbd, 0x80, 0xf8, 0x31,
+	0x4f, 0x80, 0x5a, 0x57, 0x8d, 0x4b, 0x5e, 0xbb, 0x5b, 0xe1, 0x35, 0x71, 0x37, 0x96, 0x5d, 0xa2,
+	0x9c, 0xf6, 0x2f, 0xd1, 0xb7, 0xa8, 0xe2, 0x3c, 0xfb, 0x18, 0xed, 0x01, 0x7b, 0x9d, 0x8e, 0xab,
+	0x38, 0xbd, 0x12, 0xdf, 0x2d, 0x4c, 0x79, 0x7a, 0xd5, 0x46, 0x9d, 0x5e, 0xd3, 0x24, 0x8d, 0xe8,
+	0x77, 0xaa, 0x8d, 0x53, 0x2e, 0xb0, 0x78, 0x8d, 0x9c, 0xc9, 0x32, 0x15, 0xac, 0x85, 0xf9, 0x45,
+	0xeb, 0xb4, 0xb2, 0x75, 0x53, 0x34, 0x0c, 0x32, 0x75, 0x2b, 0x14, 0x28, 0x0a, 0x7f, 0x17, 0xa9,
+	0x2b, 0x9a, 0x82, 0xef, 0x5e, 0x3a, 0xe4, 0x95, 0x22, 0x30, 0xe4, 0xcb, 0x72, 0xa7, 0x7d, 0x5d,
+	0xc8, 0xaa, 0x83, 0x34, 0x5b, 0x36, 0xe3, 0x6b, 0x70, 0x27, 0xc0, 0xa0, 0x87, 0x51, 0xdc, 0xa8,
+	0x6d, 0xce, 0x3e, 0xae, 0x1f, 0xad, 0xb5, 0x6e, 0xda, 0xef, 0x56, 0x87, 0xdf, 0xf1, 0x1f, 0xa5,
+	0x9d, 0x9e, 0xbc, 0xfa, 0xd3, 0x15, 0xfa, 0x05, 0x2c, 0x46, 0x78, 0x45, 0x22, 0xc7, 0x92, 0x47,
+	0xe9, 0xdc, 0x97, 0x3a, 0x4a, 0x17, 0xc4, 0x26, 0xc7, 0xe2, 0x40, 0xdd, 0x02, 0x39, 0xb6, 0x78,
+	0xf5, 0xc8, 0xba, 0xa8, 0x0b, 0xd9, 0x87, 0x89, 0x68, 0xaa, 0x13, 0x52, 0x14, 0x40, 0xd9, 0xb1,
+	0xca, 0xf5, 0x17, 0xa0, 0x27, 0x77, 0x14, 0x09, 0x6d, 0xf4, 0x6f, 0xfa, 0xae, 0xa4, 0x94, 0x23,
+	0x12, 0xc6, 0xc4, 0xce, 0xde, 0xb8, 0xb5, 0xee, 0x62, 0x46, 0xfa, 0xc4, 0xc9, 0xf4, 0x31, 0x33,
+	0xd9, 0x3e, 0xc6, 0x5c, 0x07, 0xa3, 0xbc, 0xa9, 0x52, 0xf9, 0x6b, 0x8d, 0x93, 0xba, 0x18, 0xf4,
+	0x02, 0x8f, 0x75, 0x88, 0xa3, 0x5a, 0xd9, 0xb3, 0xa1, 0xe7, 0x60, 0x12, 0xb1, 0x0e, 0xdc, 0x89,
+	0x07, 0xbd, 0x1f, 0xa0, 0x2d, 0x72, 0xae, 0x7e, 0xb4, 0xdc, 0x12, 0xaf, 0x92, 0x56, 0xfa, 0x2a,
+	0x69, 0x1d, 0x87, 0xd7, 0x1d, 0xfd, 0x2f, 0x7f, 0x3c, 0xb8, 0x97, 0xe6, 0x55, 0xb2, 0x0b, 0x3a,
+	0xdd, 0x74, 0x61, 0xfe, 0x6a, 0x9e, 0x29, 0x5c, 0xcd, 0x19, 0xe6, 0xb3, 0x39, 0xe6, 0x7b, 0xb0,
+	0x33, 0x96, 0x9a, 0x32, 0xe2, 0xa7, 0x33, 0xb0, 0xa6, 0x90, 0xa7, 0x74, 0xd0, 0xf3, 0x31, 0x01,
+	0x2b, 0x13, 0xce, 0x60, 0x5e, 0x32, 0xb1, 0xc8, 0x2b, 0x1b, 0x71, 0x57, 0x2e, 0x3d, 0x4e, 0xf2,
+	0x5f, 0x91, 0xb6, 0x88, 0xb4, 0x03, 0x94, 0xe8, 0x38, 0xab, 0xa7, 0xd7, 0x98, 0xfd, 0x92, 0x7a,
+	0x3a, 0x79, 0x3d, 0xbd, 0x46, 0xad, 0xa0, 0xa7, 0x93, 0x71, 0xd8, 0x5c, 0xce, 0x61, 0x3b, 0xb0,
+	0x3d, 0xc6, 0x0d, 0xca, 0x5d, 0xef, 0xf1, 0x02, 0xe7, 0xa9, 0x77, 0x8a, 0x97, 0x34, 0xf6, 0xd8,
+	0x59, 0x6c, 0x47, 0xf4, 0x2a, 0x39, 0xe1, 0x55, 0x23, 0x20, 0xba, 0x3a, 0x35, 0x96, 0xc9, 0x5b,
+	0x5e, 0x94, 0xee, 0x7a, 0xf4, 0x6f, 0x1d, 0x66, 0xcf, 0x63, 0x57, 0xbf, 0x82, 0xc5, 0xfc, 0xeb,
+	0x66, 0x3d, 0x5b, 0xbe, 0xc5, 0xe7, 0x86, 0xf1, 0xd6, 0xb8, 0x59, 0x45, 0xd9, 0xfc, 0xc9, 0xdf,
+	0xfe, 0xfb, 0xab, 0x99, 0x75, 0xd3, 0x68, 0x67, 0x5e, 0xca, 0xf2, 0xac, 0xb1, 0xa5, 0x9e, 0x3e,
+	0xcc, 0xdf, 0x14, 0x4d, 0xa3, 0xb0, 0xad, 0x9a, 0x31, 0x36, 0x47, 0xcd, 0x28, 0x65, 0x1b, 0x5c,
+	0xd9, 0xaa, 0xf9, 0x66, 0x56, 0x59, 0xe2, 0x62, 0x8b, 0xd1, 0xe4, 0x9d, 0xa8, 0xc7, 0xb0, 0x90,
+	0x7b, 0x42, 0xac, 0x15, 0xb6, 0xcc, 0x4e, 0x1a, 0xdb, 0x63, 0x26, 0x95, 0xca, 0x2d, 0xae, 0x72,
+	0xcd, 0x5c, 0xcd, 0xaa, 0x8c, 0x04, 0xd2, 0xe2, 0x4d, 0x4c, 0xa2, 0x34, 0xf7, 0xb4, 0x28, 0x2a,
+	0xcd, 0x4e, 0x1a, 0xdb, 0x63, 0x26, 0xc7, 0x2b, 0x95, 0xde, 0x94, 0x4a, 0x7f, 0x04, 0xf7, 0x4b,
+	0x4f, 0x80, 0x8d, 0xea, 0xbd, 0x15, 0xc0, 0xd8, 0x9b, 0x00, 0x50, 0x04, 0x36, 0x39, 0x01, 0xc3,
+	0x6c, 0x94, 0x08, 0x04, 0x96, 0x9f, 0xa0, 0xf5, 0x9f, 0x69, 0xf0, 0xa0, 0xdc, 0x93, 0x57, 0x87,
+	0x30, 0x83, 0x30, 0x1e, 0x4f, 0x42, 0x28, 0x0e, 0x8f, 0x39, 0x07, 0xd3, 0xdc, 0xac, 0x0a, 0xb6,
+	0xec, 0xb2, 0x6c, 0xae, 0xf5, 0x97, 0x1a, 0x3c, 0xac, 0xea, 0x5e, 0xcd, 0x82, 0xae, 0x0a, 0x8c,
+	0xf1, 0xf6, 0x64, 0x8c, 0x62, 0xf4, 0x0e, 0x67, 0xb4, 0x63, 0x6e, 0x67, 0x19, 0x89, 0xde, 0x36,
+	0x93, 0x84, 0x92, 0xd4, 0x27, 0x1a, 0x3c, 0xc8, 0xde, 0x28, 0xb2, 0x59, 0xa8, 0x2c, 0xaa, 0xec,
+	0x9d, 0x63, 0xec, 0x4f, 0x84, 0x8c, 0x77, 0x91, 0x2c, 0xbe, 0x81, 0x58, 0x20, 0xd9, 0xfc, 0x5c,
+	0x03, 0xbd, 0xa2, 0xe7, 0x2d, 0xd2, 0x29, 0x43, 0x8c, 0xfd, 0x89, 0x90, 0xf1, 0x74, 0x30, 0xb2,
+	0x8f, 0xde, 0xb5, 0x1c, 0xb9, 0x40, 0xd2, 0xf9, 0xad, 0x06, 0x2b, 0x23, 0xba, 0xc9, 0x9d, 0x82,
+	0xbe, 0x6a, 0x98, 0x71, 0x30, 0x15, 0x4c, 0x51, 0x3b, 0xe0, 0xd4, 0xf6, 0xcc, 0x9d, 0x2c, 0x35,
+	0x9e, 0xc9, 0x96, 0x4d, 0x7c, 0xdf, 0x42, 0xb9, 0x4a, 0xf2, 0xfb, 0x54, 0x83, 0x87, 0x55, 0xbd,
+	0x5e, 0x31, 0xa3, 0x2a, 0x30, 0xc6, 0xdb, 0x93, 0x31, 0x8a, 0xd6, 0x3e, 0xa7, 0xb5, 0x6d, 0x6e,
+	0xe5, 0x3c, 0x26, 0x17, 0xc8, 0x4e, 0x4c, 0x52, 0xfa, 0x8d, 0x06, 0x2b, 0x23, 0x3e, 0x1c, 0xee,
+	0x94, 0x6a, 0xaa, 0x0a, 0x66, 0x1c, 0x4c, 0x05, 0x53, 0xdc, 0xbe, 0xc2, 0xb9, 0xed, 0x9a, 0x6f,
+	0xe5, 0xeb, 0x8f, 0xe5, 0xbe, 0xdd, 0xa5, 0x1f, 0xb8, 0x78, 0x82, 0x55, 0x7c, 0x0c, 0x2c, 0x26,
+	0x58, 0x19, 0x62, 0xec, 0x4f, 0x84, 0x8c, 0x4f, 0xb0, 0x88, 0xe3, 0x2d, 0x47, 0x2e, 0xb0, 0x9e,
+	0x25, 0x7a, 0x7f, 0xac, 0xc1, 0x52, 0xb1, 0x5d, 0x6b, 0x16, 0x4f, 0xbf, 0xfc, 0xbc, 0xb1, 0x3b,
+	0x7e, 0x5e, 0xb1, 0xd8, 0xe5, 0x2c, 0x36, 0xcd, 0x66, 0xee, 0x70, 0xe4, 0xe0, 0xec, 0x39, 0xa0,
+	0x7f, 0xa6, 0x81, 0x31, 0xa6, 0x7d, 0x2b, 0xda, 0x3d, 0x1a, 0x6a, 0x1c, 0x4e, 0x0d, 0x55, 0x24,
+	0x0f, 0x39, 0xc9, 0x77, 0xcc, 0xfd, 0x5c, 0xf4, 0xf8, 0x3a, 0xab, 0x47, 0x9c, 0x9b, 0xaf, 0xa5,
+	0x16, 0xa6, 0x84, 0x92, 0x10, 0x56, 0xf4, 0x1e, 0xc5, 0x10, 0x96, 0x21, 0xc6, 0xfe, 0x44, 0xc8,
+	0xf8, 0x10, 0xf2, 0x0c, 0xb7, 0x1c, 0xb1, 0xc0, 0x42, 0xa1, 0xf7, 0xf7, 0x1a, 0x34, 0x46, 0x36,
+	0x8e, 0x7b, 0x95, 0x1e, 0x29, 0x03, 0x8d, 0xf6, 0x94, 0x40, 0x45, 0xb0, 0xcd, 0x09, 0xee, 0x9b,
+	0x7b, 0x15, 0x8e, 0x73, 0xf8, 0x32, 0xee, 0x3b, 0xe5, 0xb6, 0xce, 0xf7, 0x9f, 0xbf, 0x68, 0x6a,
+	0x9f, 0xbf, 0x68, 0x6a, 0xff, 0x79, 0xd1, 0xd4, 0x3e, 0x7d, 0xd9, 0xbc, 0xf5, 0xf9, 0xcb, 0xe6,
+	0xad, 0x7f, 0xbc, 0x6c, 0xde, 0xfa, 0x5e, 0x27, 0xf3, 0xa6, 0x21, 0x3e, 0xeb, 0x23, 0x39, 0x08,
+	0x91, 0xa5, 0xef, 0x1a, 0xb9, 0xfd, 0x81, 0xf8, 0x36, 0xda, 0x0e, 0xa8, 0x33, 0xf0, 0xb1, 0xfd,
+	0xb1, 0x52, 0xcb, 0xdf, 0x3c, 0xbd, 0xdb, 0xbc, 0x3d, 0x7d, 0xef, 0x7f, 0x03, 0x00, 0x72, 0x70,
+	0x08, 0xbb, 0x00, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	ValsetConfirm(ctx context.Context, in *MsgValsetConfirm, opts ...grpc.CallOption) (*MsgValsetConfirmResponse, error)
+	SendToEth(ctx context.Context, in *MsgSendToEth, opts ...grpc.CallOption) (*MsgSendToEthResponse, error)
+	RequestBatch(ctx context.Context, in *MsgRequestBatch, opts ...grpc.CallOption) (*MsgRequestBatchResponse, error)
+	ConfirmBatch(ctx context.Context, in *MsgConfirmBatch, opts ...grpc.CallOption) (*MsgConfirmBatchResponse, error)
+	ConfirmLogicCall(ctx context.Context, in *MsgConfirmLogicCall, opts ...grpc.CallOption) (*MsgConfirmLogicCallResponse, error)
+	SendToCosmosClaim(ctx context.Context, in *MsgSendToCosmosClaim, opts ...grpc.CallOption) (*MsgSendToCosmosClaimResponse, error)
+	BatchSendToEthClaim(ctx context.Context, in *MsgBatchSendToEthClaim, opts ...grpc.CallOption) (*MsgBatchSendToEthClaimResponse, error)
+	ValsetUpdateClaim(ctx context.Context, in *MsgValsetUpdatedClaim, opts ...grpc.CallOption) (*MsgValsetUpdatedClaimResponse, error)
+	ERC20DeployedClaim(ctx context.Context, in *MsgERC20DeployedClaim, opts ...grpc.CallOption) (*MsgERC20DeployedClaimResponse, error)
+	LogicCallExecutedClaim(ctx context.Context, in *MsgLogicCallExecutedClaim, opts ...grpc.CallOption) (*MsgLogicCallExecutedClaimResponse, error)
+	EthereumHeightClaim(ctx context.Context, in *MsgEthereumHeightClaim, opts ...grpc.CallOption) (*MsgEthereumHeightClaimResponse, error)
+	SetOrchestratorAddress(ctx context.Context, in *MsgSetOrchestratorAddress, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressResponse, error)
+	RotateDelegateKeys(ctx context.Context, in *MsgRotateDelegateKeys, opts ...grpc.CallOption) (*MsgRotateDelegateKeysResponse, error)
+	CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error)
+	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	ClaimDepositEscrow(ctx context.Context, in *MsgClaimDepositEscrow, opts ...grpc.CallOption) (*MsgClaimDepositEscrowResponse, error)
+	SubmitDoubleSignEvidence(ctx context.Context, in *MsgSubmitDoubleSignEvidence, opts ...grpc.CallOption) (*MsgSubmitDoubleSignEvidenceResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
 func (c *msgClient) ValsetConfirm(ctx context.Context, in *MsgValsetConfirm, opts ...grpc.CallOption) (*MsgValsetConfirmResponse, error) {
@@ -1780,6 +2237,15 @@ func (c *msgClient) LogicCallExecutedClaim(ctx context.Context, in *MsgLogicCall
 	return out, nil
 }
 
+func (c *msgClient) EthereumHeightClaim(ctx context.Context, in *MsgEthereumHeightClaim, opts ...grpc.CallOption) (*MsgEthereumHeightClaimResponse, error) {
+	out := new(MsgEthereumHeightClaimResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/EthereumHeightClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetOrchestratorAddress(ctx context.Context, in *MsgSetOrchestratorAddress, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressResponse, error) {
 	out := new(MsgSetOrchestratorAddressResponse)
 	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SetOrchestratorAddress", in, out, opts...)
@@ -1789,6 +2255,15 @@ func (c *msgClient) SetOrchestratorAddress(ctx context.Context, in *MsgSetOrches
 	return out, nil
 }
 
+func (c *msgClient) RotateDelegateKeys(ctx context.Context, in *MsgRotateDelegateKeys, opts ...grpc.CallOption) (*MsgRotateDelegateKeysResponse, error) {
+	out := new(MsgRotateDelegateKeysResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RotateDelegateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error) {
 	out := new(MsgCancelSendToEthResponse)
 	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/CancelSendToEth", in, out, opts...)
@@ -1807,6 +2282,24 @@ func (c *msgClient) SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmi
 	return out, nil
 }
 
+func (c *msgClient) ClaimDepositEscrow(ctx context.Context, in *MsgClaimDepositEscrow, opts ...grpc.CallOption) (*MsgClaimDepositEscrowResponse, error) {
+	out := new(MsgClaimDepositEscrowResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/ClaimDepositEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitDoubleSignEvidence(ctx context.Context, in *MsgSubmitDoubleSignEvidence, opts ...grpc.CallOption) (*MsgSubmitDoubleSignEvidenceResponse, error) {
+	out := new(MsgSubmitDoubleSignEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitDoubleSignEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	ValsetConfirm(context.Context, *MsgValsetConfirm) (*MsgValsetConfirmResponse, error)
@@ -1819,9 +2312,13 @@ type MsgServer interface {
 	ValsetUpdateClaim(context.Context, *MsgValsetUpdatedClaim) (*MsgValsetUpdatedClaimResponse, error)
 	ERC20DeployedClaim(context.Context, *MsgERC20DeployedClaim) (*MsgERC20DeployedClaimResponse, error)
 	LogicCallExecutedClaim(context.Context, *MsgLogicCallExecutedClaim) (*MsgLogicCallExecutedClaimResponse, error)
+	EthereumHeightClaim(context.Context, *MsgEthereumHeightClaim) (*MsgEthereumHeightClaimResponse, error)
 	SetOrchestratorAddress(context.Context, *MsgSetOrchestratorAddress) (*MsgSetOrchestratorAddressResponse, error)
+	RotateDelegateKeys(context.Context, *MsgRotateDelegateKeys) (*MsgRotateDelegateKeysResponse, error)
 	CancelSendToEth(context.Context, *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error)
 	SubmitBadSignatureEvidence(context.Context, *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	ClaimDepositEscrow(context.Context, *MsgClaimDepositEscrow) (*MsgClaimDepositEscrowResponse, error)
+	SubmitDoubleSignEvidence(context.Context, *MsgSubmitDoubleSignEvidence) (*MsgSubmitDoubleSignEvidenceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1858,15 +2355,27 @@ func (*UnimplementedMsgServer) ERC20DeployedClaim(ctx context.Context, req *MsgE
 func (*UnimplementedMsgServer) LogicCallExecutedClaim(ctx context.Context, req *MsgLogicCallExecutedClaim) (*MsgLogicCallExecutedClaimResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LogicCallExecutedClaim not implemented")
 }
+func (*UnimplementedMsgServer) EthereumHeightClaim(ctx context.Context, req *MsgEthereumHeightClaim) (*MsgEthereumHeightClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthereumHeightClaim not implemented")
+}
 func (*UnimplementedMsgServer) SetOrchestratorAddress(ctx context.Context, req *MsgSetOrchestratorAddress) (*MsgSetOrchestratorAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetOrchestratorAddress not implemented")
 }
+func (*UnimplementedMsgServer) RotateDelegateKeys(ctx context.Context, req *MsgRotateDelegateKeys) (*MsgRotateDelegateKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateDelegateKeys not implemented")
+}
 func (*UnimplementedMsgServer) CancelSendToEth(ctx context.Context, req *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSendToEth not implemented")
 }
 func (*UnimplementedMsgServer) SubmitBadSignatureEvidence(ctx context.Context, req *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitBadSignatureEvidence not implemented")
 }
+func (*UnimplementedMsgServer) ClaimDepositEscrow(ctx context.Context, req *MsgClaimDepositEscrow) (*MsgClaimDepositEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimDepositEscrow not implemented")
+}
+func (*UnimplementedMsgServer) SubmitDoubleSignEvidence(ctx context.Context, req *MsgSubmitDoubleSignEvidence) (*MsgSubmitDoubleSignEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDoubleSignEvidence not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2052,6 +2561,24 @@ func _Msg_LogicCallExecutedClaim_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_EthereumHeightClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEthereumHeightClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EthereumHeightClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/EthereumHeightClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EthereumHeightClaim(ctx, req.(*MsgEthereumHeightClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetOrchestratorAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetOrchestratorAddress)
 	if err := dec(in); err != nil {
@@ -2070,6 +2597,24 @@ func _Msg_SetOrchestratorAddress_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotateDelegateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateDelegateKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateDelegateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/RotateDelegateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateDelegateKeys(ctx, req.(*MsgRotateDelegateKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CancelSendToEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCancelSendToEth)
 	if err := dec(in); err != nil {
@@ -2106,6 +2651,42 @@ func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimDepositEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimDepositEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimDepositEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/ClaimDepositEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimDepositEscrow(ctx, req.(*MsgClaimDepositEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitDoubleSignEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDoubleSignEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDoubleSignEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitDoubleSignEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDoubleSignEvidence(ctx, req.(*MsgSubmitDoubleSignEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "gravity.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2150,10 +2731,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LogicCallExecutedClaim",
 			Handler:    _Msg_LogicCallExecutedClaim_Handler,
 		},
+		{
+			MethodName: "EthereumHeightClaim",
+			Handler:    _Msg_EthereumHeightClaim_Handler,
+		},
 		{
 			MethodName: "SetOrchestratorAddress",
 			Handler:    _Msg_SetOrchestratorAddress_Handler,
 		},
+		{
+			MethodName: "RotateDelegateKeys",
+			Handler:    _Msg_RotateDelegateKeys_Handler,
+		},
 		{
 			MethodName: "CancelSendToEth",
 			Handler:    _Msg_CancelSendToEth_Handler,
@@ -2162,6 +2751,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitBadSignatureEvidence",
 			Handler:    _Msg_SubmitBadSignatureEvidence_Handler,
 		},
+		{
+			MethodName: "ClaimDepositEscrow",
+			Handler:    _Msg_ClaimDepositEscrow_Handler,
+		},
+		{
+			MethodName: "SubmitDoubleSignEvidence",
+			Handler:    _Msg_SubmitDoubleSignEvidence_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gravity/v1/msgs.proto",
@@ -2187,6 +2784,13 @@ func (m *MsgSetOrchestratorAddress) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.EthAddress) > 0 {
 		i -= len(m.EthAddress)
 		copy(dAtA[i:], m.EthAddress)
@@ -2234,7 +2838,7 @@ func (m *MsgSetOrchestratorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
+func (m *MsgRotateDelegateKeys) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2244,41 +2848,115 @@ func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgValsetConfirm) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRotateDelegateKeys) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgValsetConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRotateDelegateKeys) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.EthAddress) > 0 {
-		i -= len(m.EthAddress)
-		copy(dAtA[i:], m.EthAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthAddress)))
+	if len(m.NewEthAddress) > 0 {
+		i -= len(m.NewEthAddress)
+		copy(dAtA[i:], m.NewEthAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.NewEthAddress)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Orchestrator) > 0 {
-		i -= len(m.Orchestrator)
-		copy(dAtA[i:], m.Orchestrator)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+	if len(m.NewOrchestrator) > 0 {
+		i -= len(m.NewOrchestrator)
+		copy(dAtA[i:], m.NewOrchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.NewOrchestrator)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Validator)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateDelegateKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateDelegateKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateDelegateKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgValsetConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgValsetConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
@@ -2944,6 +3622,64 @@ func (m *MsgLogicCallExecutedClaimResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgEthereumHeightClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumHeightClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumHeightClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEthereumHeightClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEthereumHeightClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEthereumHeightClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgValsetUpdatedClaim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3173,6 +3909,150 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitDoubleSignEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDoubleSignEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDoubleSignEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SignatureB) > 0 {
+		i -= len(m.SignatureB)
+		copy(dAtA[i:], m.SignatureB)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.SignatureB)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SubjectB != nil {
+		{
+			size, err := m.SubjectB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SignatureA) > 0 {
+		i -= len(m.SignatureA)
+		copy(dAtA[i:], m.SignatureA)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.SignatureA)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubjectA != nil {
+		{
+			size, err := m.SubjectA.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDoubleSignEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDoubleSignEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDoubleSignEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimDepositEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimDepositEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDepositEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimDepositEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimDepositEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDepositEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsgs(v)
 	base := offset
@@ -3202,6 +4082,10 @@ func (m *MsgSetOrchestratorAddress) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -3214,31 +4098,32 @@ func (m *MsgSetOrchestratorAddressResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgValsetConfirm) Size() (n int) {
+func (m *MsgRotateDelegateKeys) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovMsgs(uint64(m.Nonce))
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.Orchestrator)
+	l = len(m.NewOrchestrator)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.EthAddress)
+	l = len(m.NewEthAddress)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.Signature)
+	l = len(m.EthSignature)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgValsetConfirmResponse) Size() (n int) {
+func (m *MsgRotateDelegateKeysResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3247,15 +4132,48 @@ func (m *MsgValsetConfirmResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgSendToEth) Size() (n int) {
+func (m *MsgValsetConfirm) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovMsgs(uint64(m.Nonce))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgValsetConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendToEth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
 	}
 	l = len(m.EthDest)
 	if l > 0 {
@@ -3536,6 +4454,31 @@ func (m *MsgLogicCallExecutedClaimResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgEthereumHeightClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.BlockHeight))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEthereumHeightClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgValsetUpdatedClaim) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3634,6 +4577,66 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitDoubleSignEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubjectA != nil {
+		l = m.SubjectA.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.SignatureA)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.SubjectB != nil {
+		l = m.SubjectB.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.SignatureB)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitDoubleSignEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimDepositEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimDepositEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovMsgs(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3765,6 +4768,38 @@ func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
 			}
 			m.EthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -3842,7 +4877,7 @@ func (m *MsgSetOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateDelegateKeys) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3865,17 +4900,17 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValsetConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateDelegateKeys: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValsetConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateDelegateKeys: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -3885,14 +4920,27 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOrchestrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3920,11 +4968,11 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			m.NewOrchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEthAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3952,11 +5000,11 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			m.NewEthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3984,7 +5032,7 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.EthSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4010,7 +5058,7 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValsetConfirmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateDelegateKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4033,10 +5081,10 @@ func (m *MsgValsetConfirmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValsetConfirmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateDelegateKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValsetConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateDelegateKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4063,7 +5111,7 @@ func (m *MsgValsetConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
+func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4086,17 +5134,17 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendToEth: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgValsetConfirm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgValsetConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var stringLen uint64
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4106,27 +5154,14 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthDest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4154,13 +5189,13 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthDest = string(dAtA[iNdEx:postIndex])
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4170,30 +5205,29 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4203,24 +5237,23 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4246,7 +5279,7 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendToEthResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgValsetConfirmResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4269,10 +5302,10 @@ func (m *MsgSendToEthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendToEthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgValsetConfirmResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgValsetConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4299,7 +5332,7 @@ func (m *MsgSendToEthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4322,10 +5355,10 @@ func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendToEth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4362,7 +5395,7 @@ func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthDest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4390,13 +5423,249 @@ func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.EthDest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendToEthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendToEthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if skippy < 0 {
 				return ErrInvalidLengthMsgs
@@ -6060,7 +7329,7 @@ func (m *MsgLogicCallExecutedClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgEthereumHeightClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6083,17 +7352,17 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValsetUpdatedClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEthereumHeightClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValsetUpdatedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEthereumHeightClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.EventNonce = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6103,16 +7372,16 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
-			m.ValsetNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6122,12 +7391,169 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValsetNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEthereumHeightClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumHeightClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumHeightClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValsetUpdatedClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValsetUpdatedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
+			}
+			m.ValsetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -6718,6 +8144,418 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitDoubleSignEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDoubleSignEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDoubleSignEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubjectA == nil {
+				m.SubjectA = &types1.Any{}
+			}
+			if err := m.SubjectA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubjectB == nil {
+				m.SubjectB = &types1.Any{}
+			}
+			if err := m.SubjectB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDoubleSignEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDoubleSignEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDoubleSignEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimDepositEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimDepositEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimDepositEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimDepositEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimDepositEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimDepositEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsgs(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,20 +14,24 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 		ethAddress                   = "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
 		cosmosAddress sdk.AccAddress = bytes.Repeat([]byte{0x1}, 20)
 		valAddress    sdk.ValAddress = bytes.Repeat([]byte{0x1}, 20)
+		ethSignature                 = bytes.Repeat([]byte{0x1}, 65)
 	)
 	specs := map[string]struct {
 		srcCosmosAddr sdk.AccAddress
 		srcValAddr    sdk.ValAddress
 		srcETHAddr    string
+		srcETHSig     []byte
 		expErr        bool
 	}{
 		"all good": {
 			srcCosmosAddr: cosmosAddress,
 			srcValAddr:    valAddress,
 			srcETHAddr:    ethAddress,
+			srcETHSig:     ethSignature,
 		},
 		"empty validator address": {
 			srcETHAddr:    ethAddress,
+			srcETHSig:     ethSignature,
 			srcCosmosAddr: cosmosAddress,
 			expErr:        true,
 		},
@@ -35,26 +39,35 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 			srcValAddr:    []byte{0x1},
 			srcCosmosAddr: cosmosAddress,
 			srcETHAddr:    ethAddress,
+			srcETHSig:     ethSignature,
 			expErr:        false,
 		},
 		"empty cosmos address": {
 			srcValAddr: valAddress,
 			srcETHAddr: ethAddress,
+			srcETHSig:  ethSignature,
 			expErr:     true,
 		},
 		"short cosmos address": {
 			srcCosmosAddr: []byte{0x1},
 			srcValAddr:    valAddress,
 			srcETHAddr:    ethAddress,
+			srcETHSig:     ethSignature,
 			expErr:        false,
 		},
+		"empty eth signature": {
+			srcCosmosAddr: cosmosAddress,
+			srcValAddr:    valAddress,
+			srcETHAddr:    ethAddress,
+			expErr:        true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
 			println(fmt.Sprintf("Spec is %v", msg))
 			ethAddr, err := NewEthAddress(spec.srcETHAddr)
 			assert.NoError(t, err)
-			msg := NewMsgSetOrchestratorAddress(spec.srcValAddr, spec.srcCosmosAddr, *ethAddr)
+			msg := NewMsgSetOrchestratorAddress(spec.srcValAddr, spec.srcCosmosAddr, *ethAddr, spec.srcETHSig)
 			// when
 			err = msg.ValidateBasic()
 			if spec.expErr {
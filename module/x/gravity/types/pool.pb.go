@@ -24,6 +24,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TransferState is the lifecycle stage an outgoing transfer has reached
+type TransferState int32
+
+const (
+	TRANSFER_STATE_UNSPECIFIED TransferState = 0
+	// the transfer is waiting in the pool to be batched, this is also the
+	// state of a transfer whose batch was cancelled or timed out
+	TRANSFER_STATE_POOLED TransferState = 1
+	// the transfer is in a batch waiting to be executed on Ethereum
+	TRANSFER_STATE_BATCHED TransferState = 2
+	// the batch containing the transfer was executed on Ethereum
+	TRANSFER_STATE_EXECUTED TransferState = 3
+	// the transfer was removed from the pool and refunded to the sender
+	TRANSFER_STATE_CANCELLED TransferState = 4
+)
+
+var TransferState_name = map[int32]string{
+	0: "TRANSFER_STATE_UNSPECIFIED",
+	1: "TRANSFER_STATE_POOLED",
+	2: "TRANSFER_STATE_BATCHED",
+	3: "TRANSFER_STATE_EXECUTED",
+	4: "TRANSFER_STATE_CANCELLED",
+}
+
+var TransferState_value = map[string]int32{
+	"TRANSFER_STATE_UNSPECIFIED": 0,
+	"TRANSFER_STATE_POOLED":      1,
+	"TRANSFER_STATE_BATCHED":     2,
+	"TRANSFER_STATE_EXECUTED":    3,
+	"TRANSFER_STATE_CANCELLED":   4,
+}
+
+func (x TransferState) String() string {
+	return proto.EnumName(TransferState_name, int32(x))
+}
+
+func (TransferState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{0}
+}
+
 // IDSet represents a set of IDs
 type IDSet struct {
 	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
@@ -114,32 +154,163 @@ func (m *BatchFees) GetToken() string {
 	return ""
 }
 
+// TransferStatus records the lifecycle of an outgoing transfer, the record is
+// kept for transfer_status_retention_blocks after the transfer was executed or cancelled
+// batch_nonce: the nonce of the batch the transfer is in, or was last in
+// times_requeued: how many times a batch containing the transfer was cancelled or timed out
+type TransferStatus struct {
+	Id            uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	State         TransferState `protobuf:"varint,2,opt,name=state,proto3,enum=gravity.v1.TransferState" json:"state,omitempty"`
+	Sender        string        `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	DestAddress   string        `protobuf:"bytes,4,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+	TokenContract string        `protobuf:"bytes,5,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	BatchNonce    uint64        `protobuf:"varint,6,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	TimesRequeued uint64        `protobuf:"varint,7,opt,name=times_requeued,json=timesRequeued,proto3" json:"times_requeued,omitempty"`
+	CreatedHeight uint64        `protobuf:"varint,8,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	UpdatedHeight uint64        `protobuf:"varint,9,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+}
+
+func (m *TransferStatus) Reset()         { *m = TransferStatus{} }
+func (m *TransferStatus) String() string { return proto.CompactTextString(m) }
+func (*TransferStatus) ProtoMessage()    {}
+func (*TransferStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{2}
+}
+func (m *TransferStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferStatus.Merge(m, src)
+}
+func (m *TransferStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferStatus proto.InternalMessageInfo
+
+func (m *TransferStatus) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TransferStatus) GetState() TransferState {
+	if m != nil {
+		return m.State
+	}
+	return TRANSFER_STATE_UNSPECIFIED
+}
+
+func (m *TransferStatus) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *TransferStatus) GetDestAddress() string {
+	if m != nil {
+		return m.DestAddress
+	}
+	return ""
+}
+
+func (m *TransferStatus) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *TransferStatus) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *TransferStatus) GetTimesRequeued() uint64 {
+	if m != nil {
+		return m.TimesRequeued
+	}
+	return 0
+}
+
+func (m *TransferStatus) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *TransferStatus) GetUpdatedHeight() uint64 {
+	if m != nil {
+		return m.UpdatedHeight
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("gravity.v1.TransferState", TransferState_name, TransferState_value)
 	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
 	proto.RegisterType((*BatchFees)(nil), "gravity.v1.BatchFees")
+	proto.RegisterType((*TransferStatus)(nil), "gravity.v1.TransferStatus")
 }
 
 func init() { proto.RegisterFile("gravity/v1/pool.proto", fileDescriptor_18d107f7cfc31f22) }
 
 var fileDescriptor_18d107f7cfc31f22 = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0x2f, 0x4a, 0x2c,
-	0xcb, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0xc8, 0xcf, 0xcf, 0xd1, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xe2, 0x82, 0x0a, 0xeb, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x85,
-	0xf5, 0x41, 0x2c, 0x88, 0x0a, 0x25, 0x49, 0x2e, 0x56, 0x4f, 0x97, 0xe0, 0xd4, 0x12, 0x21, 0x01,
-	0x2e, 0xe6, 0xcc, 0x94, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x96, 0x20, 0x10, 0x53, 0xa9, 0x80,
-	0x8b, 0xd3, 0x29, 0xb1, 0x24, 0x39, 0xc3, 0x2d, 0x35, 0xb5, 0x58, 0x48, 0x84, 0x8b, 0xb5, 0x24,
-	0x3f, 0x3b, 0x35, 0x4f, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xc2, 0x11, 0xf2, 0xe5, 0xe2,
-	0x2a, 0xc9, 0x2f, 0x49, 0xcc, 0x89, 0x4f, 0x4b, 0x4d, 0x2d, 0x96, 0x60, 0x02, 0x49, 0x39, 0xe9,
-	0x9d, 0xb8, 0x27, 0xcf, 0x70, 0xeb, 0x9e, 0xbc, 0x5a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x7e, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x31, 0x94, 0xd2, 0x2d, 0x4e, 0xc9, 0xd6,
-	0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0xf3, 0xcc, 0x2b, 0x09, 0xe2, 0x04, 0x9b, 0x00, 0xb2, 0xc4,
-	0x29, 0xe6, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x9c, 0x90, 0x0c, 0x4b,
-	0xcc, 0x29, 0xc9, 0x48, 0x4d, 0xd4, 0xcd, 0x4b, 0x2d, 0x81, 0x19, 0x08, 0xf5, 0xa5, 0x6e, 0x52,
-	0x51, 0x66, 0x4a, 0x7a, 0xaa, 0x7e, 0x6e, 0x7e, 0x4a, 0x69, 0x4e, 0xaa, 0x7e, 0x85, 0x3e, 0x2c,
-	0x50, 0xc0, 0x96, 0x25, 0xb1, 0x81, 0x7d, 0x6c, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x44, 0x58,
-	0x7f, 0x3a, 0x2c, 0x01, 0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0xdf, 0x6a, 0xdb, 0x30,
+	0x14, 0x87, 0xed, 0xfc, 0xdb, 0xa2, 0x2e, 0x21, 0x88, 0xb6, 0x73, 0xb2, 0xe1, 0x74, 0x81, 0x8d,
+	0x32, 0x88, 0x4d, 0xb7, 0x27, 0x48, 0x1c, 0x87, 0x06, 0xba, 0xb4, 0x38, 0x2e, 0x8c, 0x31, 0x30,
+	0x8a, 0x75, 0xea, 0x98, 0x26, 0x56, 0x66, 0xc9, 0x61, 0x7d, 0x83, 0x5d, 0xee, 0x1d, 0x76, 0xb1,
+	0x57, 0xe9, 0xee, 0x7a, 0x39, 0x76, 0x51, 0x46, 0xf2, 0x22, 0xc3, 0xb2, 0xcb, 0xd2, 0x5c, 0x59,
+	0xfa, 0x7e, 0x9f, 0x75, 0x24, 0xf9, 0x18, 0x1d, 0x04, 0x31, 0x59, 0x85, 0xe2, 0xc6, 0x5c, 0x9d,
+	0x98, 0x4b, 0xc6, 0xe6, 0xc6, 0x32, 0x66, 0x82, 0x61, 0x94, 0x63, 0x63, 0x75, 0xd2, 0xda, 0x0f,
+	0x58, 0xc0, 0x24, 0x36, 0xd3, 0x51, 0x66, 0x74, 0x9a, 0xa8, 0x3c, 0x1a, 0x4c, 0x40, 0xe0, 0x06,
+	0x2a, 0x86, 0x94, 0x6b, 0xea, 0x51, 0xf1, 0xb8, 0xe4, 0xa4, 0xc3, 0xce, 0x12, 0x55, 0xfb, 0x44,
+	0xf8, 0xb3, 0x21, 0x00, 0xc7, 0xfb, 0xa8, 0x2c, 0xd8, 0x35, 0x44, 0x9a, 0x7a, 0xa4, 0x1e, 0x57,
+	0x9d, 0x6c, 0x82, 0x3f, 0x20, 0x24, 0x98, 0x20, 0x73, 0xef, 0x0a, 0x80, 0x6b, 0x85, 0x34, 0xea,
+	0x1b, 0xb7, 0xf7, 0x6d, 0xe5, 0xcf, 0x7d, 0xfb, 0x4d, 0x10, 0x8a, 0x59, 0x32, 0x35, 0x7c, 0xb6,
+	0x30, 0x7d, 0xc6, 0x17, 0x8c, 0xe7, 0x8f, 0x2e, 0xa7, 0xd7, 0xa6, 0xb8, 0x59, 0x02, 0x37, 0x46,
+	0x91, 0x70, 0xaa, 0x72, 0x85, 0xb4, 0x48, 0xe7, 0x57, 0x01, 0xd5, 0xdd, 0x98, 0x44, 0xfc, 0x0a,
+	0xe2, 0x89, 0x20, 0x22, 0xe1, 0xb8, 0x8e, 0x0a, 0x21, 0x95, 0x45, 0x4b, 0x4e, 0x21, 0xa4, 0xd8,
+	0x44, 0x65, 0x2e, 0x88, 0x00, 0x59, 0xac, 0xfe, 0xae, 0x69, 0xfc, 0x3f, 0xa1, 0xb1, 0xfd, 0x2a,
+	0x38, 0x99, 0x87, 0x0f, 0x51, 0x85, 0x43, 0x44, 0x21, 0xd6, 0x8a, 0x72, 0xe7, 0xf9, 0x0c, 0xbf,
+	0x42, 0xcf, 0x28, 0x70, 0xe1, 0x11, 0x4a, 0x63, 0xe0, 0x5c, 0x2b, 0xc9, 0x74, 0x2f, 0x65, 0xbd,
+	0x0c, 0xe1, 0xd7, 0xa8, 0x2e, 0x8f, 0xe9, 0xf9, 0x2c, 0x12, 0x31, 0xf1, 0x85, 0x56, 0x96, 0x52,
+	0x4d, 0x52, 0x2b, 0x87, 0xb8, 0x8d, 0xf6, 0xa6, 0xe9, 0x3d, 0x79, 0x11, 0x8b, 0x7c, 0xd0, 0x2a,
+	0x72, 0xaf, 0x48, 0xa2, 0x71, 0x4a, 0xe4, 0x3a, 0xe1, 0x02, 0xb8, 0x17, 0xc3, 0x97, 0x04, 0x12,
+	0xa0, 0xda, 0x13, 0xe9, 0xd4, 0x24, 0x75, 0x72, 0x98, 0x6a, 0x7e, 0x0c, 0x44, 0x00, 0xf5, 0x66,
+	0x10, 0x06, 0x33, 0xa1, 0x3d, 0xcd, 0xb4, 0x9c, 0x9e, 0x4a, 0x98, 0x6a, 0xc9, 0x92, 0x6e, 0x6b,
+	0xd5, 0x4c, 0xcb, 0x69, 0xa6, 0xbd, 0xfd, 0xa9, 0xa2, 0xda, 0xa3, 0x0b, 0xc1, 0x3a, 0x6a, 0xb9,
+	0x4e, 0x6f, 0x3c, 0x19, 0xda, 0x8e, 0x37, 0x71, 0x7b, 0xae, 0xed, 0x5d, 0x8e, 0x27, 0x17, 0xb6,
+	0x35, 0x1a, 0x8e, 0xec, 0x41, 0x43, 0xc1, 0x4d, 0x74, 0xb0, 0x93, 0x5f, 0x9c, 0x9f, 0x9f, 0xd9,
+	0x83, 0x86, 0x8a, 0x5b, 0xe8, 0x70, 0x27, 0xea, 0xf7, 0x5c, 0xeb, 0xd4, 0x1e, 0x34, 0x0a, 0xf8,
+	0x05, 0x7a, 0xbe, 0x93, 0xd9, 0x1f, 0x6d, 0xeb, 0xd2, 0xb5, 0x07, 0x8d, 0x22, 0x7e, 0x89, 0xb4,
+	0x9d, 0xd0, 0xea, 0x8d, 0x2d, 0xfb, 0x2c, 0x5d, 0xb6, 0xd4, 0x2a, 0x7d, 0xfb, 0xa1, 0x2b, 0xfd,
+	0xcf, 0xb7, 0x6b, 0x5d, 0xbd, 0x5b, 0xeb, 0xea, 0xdf, 0xb5, 0xae, 0x7e, 0xdf, 0xe8, 0xca, 0xdd,
+	0x46, 0x57, 0x7e, 0x6f, 0x74, 0xe5, 0x53, 0x7f, 0xab, 0x85, 0xc8, 0x5c, 0xcc, 0x80, 0x74, 0x23,
+	0x10, 0x0f, 0x6d, 0x94, 0x7f, 0xf9, 0xee, 0x34, 0x0e, 0x69, 0x00, 0xe6, 0x82, 0xd1, 0x64, 0x0e,
+	0xe6, 0x57, 0xf3, 0xe1, 0x57, 0x90, 0x2d, 0x36, 0xad, 0xc8, 0x3e, 0x7f, 0xff, 0x6f, 0x00, 0x0d,
+	0x05, 0x5b, 0xe8, 0x22, 0x03, 0x00, 0x00,
 }
 
 func (m *IDSet) Marshal() (dAtA []byte, err error) {
@@ -223,6 +394,80 @@ func (m *BatchFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TransferStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TimesRequeued != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.TimesRequeued))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.State != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPool(v)
 	base := offset
@@ -265,6 +510,45 @@ func (m *BatchFees) Size() (n int) {
 	return n
 }
 
+func (m *TransferStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPool(uint64(m.Id))
+	}
+	if m.State != 0 {
+		n += 1 + sovPool(uint64(m.State))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovPool(uint64(m.BatchNonce))
+	}
+	if m.TimesRequeued != 0 {
+		n += 1 + sovPool(uint64(m.TimesRequeued))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovPool(uint64(m.CreatedHeight))
+	}
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovPool(uint64(m.UpdatedHeight))
+	}
+	return n
+}
+
 func sovPool(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -519,6 +803,269 @@ func (m *BatchFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TransferStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= TransferState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimesRequeued", wireType)
+			}
+			m.TimesRequeued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimesRequeued |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPool(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
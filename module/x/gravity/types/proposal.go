@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeDepositEscrowRelease defines the type for a DepositEscrowReleaseProposal
+	ProposalTypeDepositEscrowRelease = "DepositEscrowRelease"
+	// ProposalTypeTransferMinimum defines the type for a TransferMinimumProposal
+	ProposalTypeTransferMinimum = "TransferMinimum"
+)
+
+// Ensure that the proposals implement the gov Content interface
+var (
+	_ govtypes.Content = &DepositEscrowReleaseProposal{}
+	_ govtypes.Content = &TransferMinimumProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeDepositEscrowRelease)
+	govtypes.RegisterProposalTypeCodec(&DepositEscrowReleaseProposal{}, "gravity/DepositEscrowReleaseProposal")
+	govtypes.RegisterProposalType(ProposalTypeTransferMinimum)
+	govtypes.RegisterProposalTypeCodec(&TransferMinimumProposal{}, "gravity/TransferMinimumProposal")
+}
+
+// NewDepositEscrowReleaseProposal returns a new DepositEscrowReleaseProposal
+func NewDepositEscrowReleaseProposal(title, description string, receiver, recipient sdk.AccAddress) *DepositEscrowReleaseProposal {
+	return &DepositEscrowReleaseProposal{
+		Title:       title,
+		Description: description,
+		Receiver:    receiver.String(),
+		Recipient:   recipient.String(),
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *DepositEscrowReleaseProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *DepositEscrowReleaseProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *DepositEscrowReleaseProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *DepositEscrowReleaseProposal) ProposalType() string { return ProposalTypeDepositEscrowRelease }
+
+// ValidateBasic performs stateless checks
+func (p *DepositEscrowReleaseProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := IBCAddressFromBech32(p.Receiver); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient")
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p DepositEscrowReleaseProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Deposit Escrow Release Proposal:
+  Title:       %s
+  Description: %s
+  Receiver:    %s
+  Recipient:   %s
+`, p.Title, p.Description, p.Receiver, p.Recipient))
+	return b.String()
+}
+
+// NewTransferMinimumProposal returns a new TransferMinimumProposal
+func NewTransferMinimumProposal(title, description, denom string, minAmount, minFee sdk.Int) *TransferMinimumProposal {
+	return &TransferMinimumProposal{
+		Title:       title,
+		Description: description,
+		Minimum: TransferMinimum{
+			Denom:     denom,
+			MinAmount: minAmount,
+			MinFee:    minFee,
+		},
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *TransferMinimumProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *TransferMinimumProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *TransferMinimumProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *TransferMinimumProposal) ProposalType() string { return ProposalTypeTransferMinimum }
+
+// ValidateBasic performs stateless checks
+func (p *TransferMinimumProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	return p.Minimum.ValidateBasic()
+}
+
+// String implements the Stringer interface
+func (p TransferMinimumProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Transfer Minimum Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+  Min Amount:  %s
+  Min Fee:     %s
+`, p.Title, p.Description, p.Minimum.Denom, p.Minimum.MinAmount, p.Minimum.MinFee))
+	return b.String()
+}
@@ -89,3 +89,22 @@ func GetNativePrefixedAccAddress(foreignAddr sdk.AccAddress) (sdk.AccAddress, er
 	}
 	return sdk.AccAddressFromBech32(nativeStr)
 }
+
+// ValidateBasic performs stateless checks
+func (m TransferMinimum) ValidateBasic() error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if m.MinAmount.IsNil() || m.MinAmount.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalid, "min amount must not be negative")
+	}
+	if m.MinFee.IsNil() || m.MinFee.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalid, "min fee must not be negative")
+	}
+	return nil
+}
+
+// IsZero returns true if the minimum does not restrict any transfer
+func (m TransferMinimum) IsZero() bool {
+	return m.MinAmount.IsZero() && m.MinFee.IsZero()
+}
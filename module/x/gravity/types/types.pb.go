@@ -5,8 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,6 +25,92 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SlashReason is the gravity specific offense a validator was slashed for
+type SlashReason int32
+
+const (
+	SLASH_REASON_UNSPECIFIED SlashReason = 0
+	// too many valset confirmations missed in the confirm signing window
+	SLASH_REASON_VALSET_SIGNATURE SlashReason = 1
+	// too many batch confirmations missed in the confirm signing window
+	SLASH_REASON_BATCH_SIGNATURE SlashReason = 2
+	// too many logic call confirmations missed in the confirm signing window
+	SLASH_REASON_LOGIC_CALL_SIGNATURE SlashReason = 3
+	// an observed event was not claimed within the oracle liveness window
+	SLASH_REASON_ORACLE_LIVENESS SlashReason = 4
+	// a claim lost to a different observed claim at the same event nonce
+	SLASH_REASON_CONFLICTING_CLAIM SlashReason = 5
+	// the Ethereum key signed a checkpoint which was never created by the chain
+	SLASH_REASON_BAD_ETH_SIGNATURE SlashReason = 6
+	// the Ethereum key signed two different checkpoints for the same nonce
+	SLASH_REASON_DOUBLE_SIGN SlashReason = 7
+)
+
+var SlashReason_name = map[int32]string{
+	0: "SLASH_REASON_UNSPECIFIED",
+	1: "SLASH_REASON_VALSET_SIGNATURE",
+	2: "SLASH_REASON_BATCH_SIGNATURE",
+	3: "SLASH_REASON_LOGIC_CALL_SIGNATURE",
+	4: "SLASH_REASON_ORACLE_LIVENESS",
+	5: "SLASH_REASON_CONFLICTING_CLAIM",
+	6: "SLASH_REASON_BAD_ETH_SIGNATURE",
+	7: "SLASH_REASON_DOUBLE_SIGN",
+}
+
+var SlashReason_value = map[string]int32{
+	"SLASH_REASON_UNSPECIFIED":          0,
+	"SLASH_REASON_VALSET_SIGNATURE":     1,
+	"SLASH_REASON_BATCH_SIGNATURE":      2,
+	"SLASH_REASON_LOGIC_CALL_SIGNATURE": 3,
+	"SLASH_REASON_ORACLE_LIVENESS":      4,
+	"SLASH_REASON_CONFLICTING_CLAIM":    5,
+	"SLASH_REASON_BAD_ETH_SIGNATURE":    6,
+	"SLASH_REASON_DOUBLE_SIGN":          7,
+}
+
+func (x SlashReason) String() string {
+	return proto.EnumName(SlashReason_name, int32(x))
+}
+
+func (SlashReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{0}
+}
+
+// DepositOutcome is what happened to the tokens of an observed deposit from Ethereum
+type DepositOutcome int32
+
+const (
+	DEPOSIT_OUTCOME_UNSPECIFIED DepositOutcome = 0
+	// the tokens were sent to the cosmos receiver
+	DEPOSIT_OUTCOME_CREDITED DepositOutcome = 1
+	// the cosmos receiver was invalid and the tokens were sent to the community pool
+	DEPOSIT_OUTCOME_COMMUNITY_POOL DepositOutcome = 2
+	// the tokens could not be sent to the cosmos receiver and were placed in escrow
+	DEPOSIT_OUTCOME_FAILED DepositOutcome = 3
+)
+
+var DepositOutcome_name = map[int32]string{
+	0: "DEPOSIT_OUTCOME_UNSPECIFIED",
+	1: "DEPOSIT_OUTCOME_CREDITED",
+	2: "DEPOSIT_OUTCOME_COMMUNITY_POOL",
+	3: "DEPOSIT_OUTCOME_FAILED",
+}
+
+var DepositOutcome_value = map[string]int32{
+	"DEPOSIT_OUTCOME_UNSPECIFIED":    0,
+	"DEPOSIT_OUTCOME_CREDITED":       1,
+	"DEPOSIT_OUTCOME_COMMUNITY_POOL": 2,
+	"DEPOSIT_OUTCOME_FAILED":         3,
+}
+
+func (x DepositOutcome) String() string {
+	return proto.EnumName(DepositOutcome_name, int32(x))
+}
+
+func (DepositOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{1}
+}
+
 // BridgeValidator represents a validator's ETH address and its power
 type BridgeValidator struct {
 	Power           uint64 `protobuf:"varint,1,opt,name=power,proto3" json:"power,omitempty"`
@@ -262,310 +348,3302 @@ func (m *ERC20ToDenom) GetDenom() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*BridgeValidator)(nil), "gravity.v1.BridgeValidator")
-	proto.RegisterType((*Valset)(nil), "gravity.v1.Valset")
-	proto.RegisterType((*LastObservedEthereumBlockHeight)(nil), "gravity.v1.LastObservedEthereumBlockHeight")
-	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+// ValsetHijackIncident records an observed validator set update on Ethereum
+// that does not match the validator set created on Cosmos with the same nonce,
+// this indicates that control of the bridge contract has been lost
+type ValsetHijackIncident struct {
+	EventNonce    uint64 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	BlockHeight   uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	ClaimedValset Valset `protobuf:"bytes,3,opt,name=claimed_valset,json=claimedValset,proto3" json:"claimed_valset"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func init() { proto.RegisterFile("gravity/v1/types.proto", fileDescriptor_163831c23fcc179f) }
-
-var fileDescriptor_163831c23fcc179f = []byte{
-	// 464 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xdb, 0x34, 0xa8, 0xdb, 0xa2, 0x82, 0x5b, 0x2a, 0xab, 0x48, 0x4e, 0xc9, 0x01, 0x85,
-	0x43, 0xbc, 0x4d, 0xb8, 0xc1, 0xa9, 0x86, 0x4a, 0x20, 0x21, 0x21, 0x99, 0xaa, 0x07, 0x84, 0x64,
-	0xad, 0xed, 0x91, 0x6d, 0xc5, 0xf6, 0x44, 0xbb, 0x1b, 0x97, 0xfe, 0x00, 0xee, 0xfc, 0xac, 0x1e,
-	0x7b, 0x44, 0x1c, 0x2a, 0x94, 0x1c, 0xf9, 0x13, 0x68, 0x3f, 0x0c, 0x05, 0x4e, 0xbb, 0xef, 0xed,
-	0xce, 0x9b, 0x99, 0x37, 0x43, 0x0e, 0x73, 0xce, 0xda, 0x52, 0x5e, 0xd1, 0x76, 0x4a, 0xe5, 0xd5,
-	0x02, 0x44, 0xb0, 0xe0, 0x28, 0xd1, 0x25, 0x96, 0x0f, 0xda, 0xe9, 0x91, 0x9f, 0xa2, 0xa8, 0x51,
-	0xd0, 0x84, 0x09, 0xa0, 0xed, 0x34, 0x01, 0xc9, 0xa6, 0x34, 0xc5, 0xb2, 0x31, 0x7f, 0x8f, 0x0e,
-	0x72, 0xcc, 0x51, 0x5f, 0xa9, 0xba, 0x19, 0x76, 0x14, 0x91, 0xbd, 0x90, 0x97, 0x59, 0x0e, 0x17,
-	0xac, 0x2a, 0x33, 0x26, 0x91, 0xbb, 0x07, 0x64, 0x6b, 0x81, 0x97, 0xc0, 0x3d, 0xe7, 0xd8, 0x19,
-	0xf7, 0x23, 0x03, 0xdc, 0x67, 0xe4, 0x01, 0xc8, 0x02, 0x38, 0x2c, 0xeb, 0x98, 0x65, 0x19, 0x07,
-	0x21, 0xbc, 0x8d, 0x63, 0x67, 0xbc, 0x1d, 0xed, 0x75, 0xfc, 0xa9, 0xa1, 0x47, 0x3f, 0x1d, 0x32,
-	0xb8, 0x60, 0x95, 0x00, 0xa9, 0xb4, 0x1a, 0x6c, 0x52, 0xe8, 0xb4, 0x34, 0x70, 0x5f, 0x92, 0x7b,
-	0x35, 0xd4, 0x09, 0x70, 0x25, 0xb1, 0x39, 0xde, 0x99, 0x3d, 0x0e, 0xfe, 0x34, 0x12, 0xfc, 0x53,
-	0x4f, 0xd8, 0xbf, 0xbe, 0x1d, 0xf6, 0xa2, 0x2e, 0xc2, 0x3d, 0x24, 0x83, 0x02, 0xca, 0xbc, 0x90,
-	0xde, 0xa6, 0xd6, 0xb4, 0xc8, 0xfd, 0x40, 0xee, 0x73, 0xb8, 0x64, 0x3c, 0x8b, 0x59, 0x8d, 0xcb,
-	0x46, 0x7a, 0x7d, 0x55, 0x5d, 0x18, 0xa8, 0xe8, 0xef, 0xb7, 0xc3, 0xa7, 0x79, 0x29, 0x8b, 0x65,
-	0x12, 0xa4, 0x58, 0x53, 0xeb, 0x94, 0x39, 0x26, 0x22, 0x9b, 0x5b, 0x53, 0xdf, 0x36, 0x32, 0xda,
-	0x35, 0x22, 0xa7, 0x5a, 0xc3, 0x7d, 0x42, 0x2c, 0x8e, 0x25, 0xce, 0xa1, 0xf1, 0xb6, 0x74, 0xc7,
-	0x3b, 0x86, 0x3b, 0x57, 0xd4, 0xe8, 0x8b, 0x43, 0x86, 0xef, 0x98, 0x90, 0xef, 0x13, 0x01, 0xbc,
-	0x85, 0xec, 0xcc, 0xba, 0x11, 0x56, 0x98, 0xce, 0xdf, 0x98, 0xda, 0x02, 0xb2, 0x6f, 0x92, 0xc5,
-	0x89, 0x62, 0x63, 0xdb, 0x80, 0x31, 0xe5, 0xa1, 0x79, 0xba, 0xfb, 0x7f, 0x46, 0x1e, 0xfd, 0x36,
-	0xfb, 0xaf, 0x88, 0x0d, 0x1d, 0xb1, 0x0f, 0xff, 0xe7, 0x18, 0xbd, 0x20, 0xbb, 0x67, 0xd1, 0xab,
-	0xd9, 0xc9, 0x39, 0xbe, 0x86, 0x06, 0x6b, 0x65, 0x3d, 0xf0, 0x74, 0x76, 0xa2, 0xb3, 0x6c, 0x47,
-	0x06, 0x28, 0x36, 0x53, 0xcf, 0x76, 0x76, 0x06, 0x84, 0x9f, 0xae, 0x57, 0xbe, 0x73, 0xb3, 0xf2,
-	0x9d, 0x1f, 0x2b, 0xdf, 0xf9, 0xba, 0xf6, 0x7b, 0x37, 0x6b, 0xbf, 0xf7, 0x6d, 0xed, 0xf7, 0x3e,
-	0x86, 0x77, 0x6c, 0x63, 0x95, 0x2c, 0x80, 0x4d, 0x1a, 0x90, 0x9d, 0x75, 0x76, 0x6a, 0x93, 0x44,
-	0x8f, 0x8c, 0xd6, 0x98, 0x2d, 0x2b, 0xa0, 0x9f, 0x69, 0xb7, 0xae, 0xda, 0xd6, 0x64, 0xa0, 0x57,
-	0xed, 0xf9, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x21, 0x3d, 0x3f, 0x3f, 0xc6, 0x02, 0x00, 0x00,
+func (m *ValsetHijackIncident) Reset()         { *m = ValsetHijackIncident{} }
+func (m *ValsetHijackIncident) String() string { return proto.CompactTextString(m) }
+func (*ValsetHijackIncident) ProtoMessage()    {}
+func (*ValsetHijackIncident) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{4}
 }
-
-func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValsetHijackIncident) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValsetHijackIncident) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValsetHijackIncident.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ValsetHijackIncident) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValsetHijackIncident.Merge(m, src)
+}
+func (m *ValsetHijackIncident) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValsetHijackIncident) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValsetHijackIncident.DiscardUnknown(m)
 }
 
-func (m *BridgeValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ValsetHijackIncident proto.InternalMessageInfo
+
+func (m *ValsetHijackIncident) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
 }
 
-func (m *BridgeValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumAddress) > 0 {
-		i -= len(m.EthereumAddress)
-		copy(dAtA[i:], m.EthereumAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumAddress)))
-		i--
-		dAtA[i] = 0x12
+func (m *ValsetHijackIncident) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	if m.Power != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ValsetHijackIncident) GetClaimedValset() Valset {
+	if m != nil {
+		return m.ClaimedValset
 	}
-	return len(dAtA) - i, nil
+	return Valset{}
 }
 
-func (m *Valset) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValsetHijackIncident) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Valset) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConfirmSigningInfo tracks the valset, batch and logic call confirmations a
+// validator has missed over its last confirm_signing_window signing requests
+type ConfirmSigningInfo struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// the number of signing requests counted since the window last started over
+	IndexOffset uint64 `protobuf:"varint,2,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// the number of signing requests in the window without a confirmation
+	MissedConfirmsCounter uint64 `protobuf:"varint,3,opt,name=missed_confirms_counter,json=missedConfirmsCounter,proto3" json:"missed_confirms_counter,omitempty"`
+	// bit array of the missed signing requests in the window, indexed by
+	// index_offset modulo the window
+	MissedConfirms []byte `protobuf:"bytes,4,opt,name=missed_confirms,json=missedConfirms,proto3" json:"missed_confirms,omitempty"`
 }
 
-func (m *Valset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardToken) > 0 {
-		i -= len(m.RewardToken)
-		copy(dAtA[i:], m.RewardToken)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardToken)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.RewardAmount.Size()
-		i -= size
-		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.Height != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *ConfirmSigningInfo) Reset()         { *m = ConfirmSigningInfo{} }
+func (m *ConfirmSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*ConfirmSigningInfo) ProtoMessage()    {}
+func (*ConfirmSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{5}
+}
+func (m *ConfirmSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *ConfirmSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmSigningInfo.Merge(m, src)
+}
+func (m *ConfirmSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmSigningInfo.DiscardUnknown(m)
 }
 
-func (m *LastObservedEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConfirmSigningInfo proto.InternalMessageInfo
+
+func (m *ConfirmSigningInfo) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LastObservedEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConfirmSigningInfo) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
 }
 
-func (m *LastObservedEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EthereumBlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CosmosBlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *ConfirmSigningInfo) GetMissedConfirmsCounter() uint64 {
+	if m != nil {
+		return m.MissedConfirmsCounter
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConfirmSigningInfo) GetMissedConfirms() []byte {
+	if m != nil {
+		return m.MissedConfirms
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EthereumHeightVote is the latest Ethereum block height a validator has
+// claimed with a MsgEthereumHeightClaim
+type EthereumHeightVote struct {
+	Validator           string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	EthereumBlockHeight uint64 `protobuf:"varint,2,opt,name=ethereum_block_height,json=ethereumBlockHeight,proto3" json:"ethereum_block_height,omitempty"`
+	// the Cosmos block height the vote was cast at
+	CosmosBlockHeight uint64 `protobuf:"varint,3,opt,name=cosmos_block_height,json=cosmosBlockHeight,proto3" json:"cosmos_block_height,omitempty"`
 }
 
-func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *EthereumHeightVote) Reset()         { *m = EthereumHeightVote{} }
+func (m *EthereumHeightVote) String() string { return proto.CompactTextString(m) }
+func (*EthereumHeightVote) ProtoMessage()    {}
+func (*EthereumHeightVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{6}
+}
+func (m *EthereumHeightVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumHeightVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumHeightVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Erc20)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EthereumHeightVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumHeightVote.Merge(m, src)
+}
+func (m *EthereumHeightVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumHeightVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumHeightVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumHeightVote proto.InternalMessageInfo
+
+func (m *EthereumHeightVote) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EthereumHeightVote) GetEthereumBlockHeight() uint64 {
+	if m != nil {
+		return m.EthereumBlockHeight
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *BridgeValidator) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EthereumHeightVote) GetCosmosBlockHeight() uint64 {
+	if m != nil {
+		return m.CosmosBlockHeight
 	}
-	var l int
-	_ = l
-	if m.Power != 0 {
-		n += 1 + sovTypes(uint64(m.Power))
+	return 0
+}
+
+// EthereumHeightSample is a Cosmos block height and block time paired with
+// the Ethereum block height observed at it, the samples kept over the
+// ethereum_height_history_blocks window calibrate the average block times
+type EthereumHeightSample struct {
+	CosmosBlockHeight uint64 `protobuf:"varint,1,opt,name=cosmos_block_height,json=cosmosBlockHeight,proto3" json:"cosmos_block_height,omitempty"`
+	// the Cosmos block time in unix milliseconds
+	CosmosBlockTime     uint64 `protobuf:"varint,2,opt,name=cosmos_block_time,json=cosmosBlockTime,proto3" json:"cosmos_block_time,omitempty"`
+	EthereumBlockHeight uint64 `protobuf:"varint,3,opt,name=ethereum_block_height,json=ethereumBlockHeight,proto3" json:"ethereum_block_height,omitempty"`
+}
+
+func (m *EthereumHeightSample) Reset()         { *m = EthereumHeightSample{} }
+func (m *EthereumHeightSample) String() string { return proto.CompactTextString(m) }
+func (*EthereumHeightSample) ProtoMessage()    {}
+func (*EthereumHeightSample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{7}
+}
+func (m *EthereumHeightSample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EthereumHeightSample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EthereumHeightSample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.EthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *EthereumHeightSample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EthereumHeightSample.Merge(m, src)
+}
+func (m *EthereumHeightSample) XXX_Size() int {
+	return m.Size()
+}
+func (m *EthereumHeightSample) XXX_DiscardUnknown() {
+	xxx_messageInfo_EthereumHeightSample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EthereumHeightSample proto.InternalMessageInfo
+
+func (m *EthereumHeightSample) GetCosmosBlockHeight() uint64 {
+	if m != nil {
+		return m.CosmosBlockHeight
 	}
-	return n
+	return 0
 }
 
-func (m *Valset) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EthereumHeightSample) GetCosmosBlockTime() uint64 {
+	if m != nil {
+		return m.CosmosBlockTime
 	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovTypes(uint64(m.Nonce))
+	return 0
+}
+
+func (m *EthereumHeightSample) GetEthereumBlockHeight() uint64 {
+	if m != nil {
+		return m.EthereumBlockHeight
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+// SlashRecord is the historical record of a gravity specific slash, the
+// subject is the valset, batch, logic call or event nonce the validator was
+// slashed over. subject_token is the token contract of a batch or the hex
+// encoded invalidation id of a logic call and empty for the other reasons
+type SlashRecord struct {
+	Id           uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Validator    string                                 `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Reason       SlashReason                            `protobuf:"varint,3,opt,name=reason,proto3,enum=gravity.v1.SlashReason" json:"reason,omitempty"`
+	SubjectNonce uint64                                 `protobuf:"varint,4,opt,name=subject_nonce,json=subjectNonce,proto3" json:"subject_nonce,omitempty"`
+	SubjectToken string                                 `protobuf:"bytes,5,opt,name=subject_token,json=subjectToken,proto3" json:"subject_token,omitempty"`
+	BlockHeight  uint64                                 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Fraction     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+	Jailed       bool                                   `protobuf:"varint,8,opt,name=jailed,proto3" json:"jailed,omitempty"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{8}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+func (m *SlashRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	l = m.RewardAmount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.RewardToken)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *SlashRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return n
+	return ""
 }
 
-func (m *LastObservedEthereumBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SlashRecord) GetReason() SlashReason {
+	if m != nil {
+		return m.Reason
 	}
-	var l int
-	_ = l
-	if m.CosmosBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	return SLASH_REASON_UNSPECIFIED
+}
+
+func (m *SlashRecord) GetSubjectNonce() uint64 {
+	if m != nil {
+		return m.SubjectNonce
 	}
-	if m.EthereumBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	return 0
+}
+
+func (m *SlashRecord) GetSubjectToken() string {
+	if m != nil {
+		return m.SubjectToken
+	}
+	return ""
+}
+
+func (m *SlashRecord) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SlashRecord) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+// DepositRecord is a compact record of an observed deposit from Ethereum,
+// it is kept after the attestation for the deposit has been pruned
+type DepositRecord struct {
+	EventNonce     uint64                                 `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EthBlockHeight uint64                                 `protobuf:"varint,2,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
+	TokenContract  string                                 `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	EthereumSender string                                 `protobuf:"bytes,5,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	CosmosReceiver string                                 `protobuf:"bytes,6,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	Outcome        DepositOutcome                         `protobuf:"varint,7,opt,name=outcome,proto3,enum=gravity.v1.DepositOutcome" json:"outcome,omitempty"`
+}
+
+func (m *DepositRecord) Reset()         { *m = DepositRecord{} }
+func (m *DepositRecord) String() string { return proto.CompactTextString(m) }
+func (*DepositRecord) ProtoMessage()    {}
+func (*DepositRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{9}
+}
+func (m *DepositRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositRecord.Merge(m, src)
+}
+func (m *DepositRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositRecord proto.InternalMessageInfo
+
+func (m *DepositRecord) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *DepositRecord) GetEthBlockHeight() uint64 {
+	if m != nil {
+		return m.EthBlockHeight
+	}
+	return 0
+}
+
+func (m *DepositRecord) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *DepositRecord) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
+}
+
+func (m *DepositRecord) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *DepositRecord) GetOutcome() DepositOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return DEPOSIT_OUTCOME_UNSPECIFIED
+}
+
+// DepositEscrow holds the tokens of a deposit from Ethereum which could not be
+// credited to its receiver, the tokens stay in the gravity module account until
+// they are claimed by the receiver or released by governance
+type DepositEscrow struct {
+	EventNonce uint64     `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	Receiver   string     `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount     types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *DepositEscrow) Reset()         { *m = DepositEscrow{} }
+func (m *DepositEscrow) String() string { return proto.CompactTextString(m) }
+func (*DepositEscrow) ProtoMessage()    {}
+func (*DepositEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{10}
+}
+func (m *DepositEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositEscrow.Merge(m, src)
+}
+func (m *DepositEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositEscrow proto.InternalMessageInfo
+
+func (m *DepositEscrow) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *DepositEscrow) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *DepositEscrow) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// DepositEscrowReleaseProposal releases all the escrowed deposits of receiver
+// to recipient, this recovers deposits to an address that can never receive them
+type DepositEscrowReleaseProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Receiver    string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Recipient   string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *DepositEscrowReleaseProposal) Reset()      { *m = DepositEscrowReleaseProposal{} }
+func (*DepositEscrowReleaseProposal) ProtoMessage() {}
+func (*DepositEscrowReleaseProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{11}
+}
+func (m *DepositEscrowReleaseProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositEscrowReleaseProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositEscrowReleaseProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositEscrowReleaseProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositEscrowReleaseProposal.Merge(m, src)
+}
+func (m *DepositEscrowReleaseProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositEscrowReleaseProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositEscrowReleaseProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositEscrowReleaseProposal proto.InternalMessageInfo
+
+// TransferMinimum is the smallest amount and bridge fee a transfer of the
+// denom to Ethereum may carry, smaller transfers are never relayed profitably
+// and only bloat the pool
+type TransferMinimum struct {
+	Denom     string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_amount"`
+	MinFee    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_fee,json=minFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee"`
+}
+
+func (m *TransferMinimum) Reset()         { *m = TransferMinimum{} }
+func (m *TransferMinimum) String() string { return proto.CompactTextString(m) }
+func (*TransferMinimum) ProtoMessage()    {}
+func (*TransferMinimum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{12}
+}
+func (m *TransferMinimum) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferMinimum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferMinimum.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferMinimum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferMinimum.Merge(m, src)
+}
+func (m *TransferMinimum) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferMinimum) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferMinimum.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferMinimum proto.InternalMessageInfo
+
+func (m *TransferMinimum) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// TransferMinimumProposal sets the transfer minimum of a denom, a minimum with
+// a zero amount and fee removes it
+type TransferMinimumProposal struct {
+	Title       string          `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string          `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Minimum     TransferMinimum `protobuf:"bytes,3,opt,name=minimum,proto3" json:"minimum"`
+}
+
+func (m *TransferMinimumProposal) Reset()      { *m = TransferMinimumProposal{} }
+func (*TransferMinimumProposal) ProtoMessage() {}
+func (*TransferMinimumProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{13}
+}
+func (m *TransferMinimumProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferMinimumProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferMinimumProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferMinimumProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferMinimumProposal.Merge(m, src)
+}
+func (m *TransferMinimumProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferMinimumProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferMinimumProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferMinimumProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("gravity.v1.SlashReason", SlashReason_name, SlashReason_value)
+	proto.RegisterEnum("gravity.v1.DepositOutcome", DepositOutcome_name, DepositOutcome_value)
+	proto.RegisterType((*BridgeValidator)(nil), "gravity.v1.BridgeValidator")
+	proto.RegisterType((*Valset)(nil), "gravity.v1.Valset")
+	proto.RegisterType((*LastObservedEthereumBlockHeight)(nil), "gravity.v1.LastObservedEthereumBlockHeight")
+	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+	proto.RegisterType((*ValsetHijackIncident)(nil), "gravity.v1.ValsetHijackIncident")
+	proto.RegisterType((*ConfirmSigningInfo)(nil), "gravity.v1.ConfirmSigningInfo")
+	proto.RegisterType((*EthereumHeightVote)(nil), "gravity.v1.EthereumHeightVote")
+	proto.RegisterType((*EthereumHeightSample)(nil), "gravity.v1.EthereumHeightSample")
+	proto.RegisterType((*SlashRecord)(nil), "gravity.v1.SlashRecord")
+	proto.RegisterType((*DepositRecord)(nil), "gravity.v1.DepositRecord")
+	proto.RegisterType((*DepositEscrow)(nil), "gravity.v1.DepositEscrow")
+	proto.RegisterType((*DepositEscrowReleaseProposal)(nil), "gravity.v1.DepositEscrowReleaseProposal")
+	proto.RegisterType((*TransferMinimum)(nil), "gravity.v1.TransferMinimum")
+	proto.RegisterType((*TransferMinimumProposal)(nil), "gravity.v1.TransferMinimumProposal")
+}
+
+func init() { proto.RegisterFile("gravity/v1/types.proto", fileDescriptor_163831c23fcc179f) }
+
+var fileDescriptor_163831c23fcc179f = []byte{
+	// 1357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0xa9, 0x93, 0x4c, 0x12, 0xc7, 0xdd, 0xa6, 0xa9, 0x49, 0x83, 0x9d, 0x18, 0x15,
+	0x42, 0xa5, 0xda, 0x4d, 0x40, 0x20, 0xb5, 0x07, 0x64, 0xaf, 0x37, 0xc9, 0x22, 0xc7, 0x1b, 0xed,
+	0x3a, 0x91, 0x40, 0x48, 0xab, 0xf5, 0xee, 0xb3, 0x3d, 0x8d, 0x77, 0xc7, 0xda, 0x5d, 0xbb, 0xed,
+	0x0f, 0x40, 0xea, 0x91, 0x0b, 0x88, 0x03, 0x42, 0x95, 0x10, 0xfc, 0x01, 0x24, 0x6e, 0x88, 0x6b,
+	0x8f, 0xe5, 0x86, 0x38, 0x54, 0xa8, 0xbd, 0x20, 0xf1, 0x27, 0xd0, 0xce, 0xcc, 0x3a, 0xbb, 0x76,
+	0xa3, 0xaa, 0xed, 0xc9, 0x7e, 0xdf, 0xbc, 0x79, 0xf3, 0xcd, 0xf7, 0xbe, 0x99, 0x59, 0xb4, 0xde,
+	0xf3, 0xcc, 0x31, 0x0e, 0x1e, 0x56, 0xc7, 0xbb, 0xd5, 0xe0, 0xe1, 0x10, 0xfc, 0xca, 0xd0, 0x23,
+	0x01, 0x11, 0x11, 0xc7, 0x2b, 0xe3, 0xdd, 0x8d, 0xa2, 0x45, 0x7c, 0x87, 0xf8, 0xd5, 0x8e, 0xe9,
+	0x43, 0x75, 0xbc, 0xdb, 0x81, 0xc0, 0xdc, 0xad, 0x5a, 0x04, 0xbb, 0x2c, 0x77, 0x63, 0xad, 0x47,
+	0x7a, 0x84, 0xfe, 0xad, 0x86, 0xff, 0x18, 0x5a, 0xd6, 0xd0, 0x6a, 0xdd, 0xc3, 0x76, 0x0f, 0x4e,
+	0xcd, 0x01, 0xb6, 0xcd, 0x80, 0x78, 0xe2, 0x1a, 0xba, 0x34, 0x24, 0xf7, 0xc1, 0x2b, 0x08, 0x5b,
+	0xc2, 0xce, 0x9c, 0xc6, 0x02, 0xf1, 0x43, 0x94, 0x87, 0xa0, 0x0f, 0x1e, 0x8c, 0x1c, 0xc3, 0xb4,
+	0x6d, 0x0f, 0x7c, 0xbf, 0x90, 0xde, 0x12, 0x76, 0x16, 0xb5, 0xd5, 0x08, 0xaf, 0x31, 0xb8, 0xfc,
+	0x9f, 0x80, 0xb2, 0xa7, 0xe6, 0xc0, 0x87, 0x20, 0xac, 0xe5, 0x12, 0xd7, 0x82, 0xa8, 0x16, 0x0d,
+	0xc4, 0xbb, 0x68, 0xde, 0x01, 0xa7, 0x03, 0x5e, 0x58, 0x22, 0xb3, 0xb3, 0xb4, 0x77, 0xbd, 0x72,
+	0xbe, 0x91, 0xca, 0x14, 0x9f, 0xfa, 0xdc, 0x93, 0x67, 0xa5, 0x94, 0x16, 0xcd, 0x10, 0xd7, 0x51,
+	0xb6, 0x0f, 0xb8, 0xd7, 0x0f, 0x0a, 0x19, 0x5a, 0x93, 0x47, 0xa2, 0x8e, 0x56, 0x3c, 0xb8, 0x6f,
+	0x7a, 0xb6, 0x61, 0x3a, 0x64, 0xe4, 0x06, 0x85, 0xb9, 0x90, 0x5d, 0xbd, 0x12, 0xce, 0xfe, 0xfb,
+	0x59, 0xe9, 0xfd, 0x1e, 0x0e, 0xfa, 0xa3, 0x4e, 0xc5, 0x22, 0x4e, 0x95, 0x2b, 0xc5, 0x7e, 0x6e,
+	0xf9, 0xf6, 0x19, 0x17, 0x55, 0x71, 0x03, 0x6d, 0x99, 0x15, 0xa9, 0xd1, 0x1a, 0xe2, 0x36, 0xe2,
+	0xb1, 0x11, 0x90, 0x33, 0x70, 0x0b, 0x97, 0xe8, 0x8e, 0x97, 0x18, 0xd6, 0x0e, 0xa1, 0xf2, 0xd7,
+	0x02, 0x2a, 0x35, 0x4d, 0x3f, 0x50, 0x3b, 0x3e, 0x78, 0x63, 0xb0, 0x65, 0xae, 0x46, 0x7d, 0x40,
+	0xac, 0xb3, 0x43, 0xc6, 0xad, 0x82, 0xae, 0xb0, 0xc5, 0x8c, 0x4e, 0x88, 0x1a, 0x7c, 0x03, 0x4c,
+	0x94, 0xcb, 0x6c, 0x28, 0x9e, 0xbf, 0x87, 0xae, 0x4e, 0xc4, 0x4e, 0xcc, 0x48, 0xd3, 0x19, 0x57,
+	0x60, 0x76, 0x8d, 0xf2, 0x1d, 0xb4, 0x2c, 0x6b, 0xd2, 0xde, 0xed, 0x36, 0x69, 0x80, 0x4b, 0x9c,
+	0x50, 0x7a, 0xf0, 0xac, 0xbd, 0xdb, 0x74, 0x95, 0x45, 0x8d, 0x05, 0x21, 0x6a, 0x87, 0xc3, 0xbc,
+	0x77, 0x2c, 0x28, 0xff, 0x2a, 0xa0, 0x35, 0xd6, 0xb1, 0x43, 0x7c, 0xcf, 0xb4, 0xce, 0x14, 0xd7,
+	0xc2, 0x36, 0xb8, 0x81, 0x58, 0x42, 0x4b, 0x30, 0x06, 0x37, 0x30, 0xe2, 0x5d, 0x44, 0x14, 0x6a,
+	0xd1, 0x56, 0x6e, 0xa3, 0xe5, 0x97, 0x10, 0x5c, 0xea, 0xc4, 0x36, 0xf3, 0x19, 0xca, 0x59, 0x03,
+	0x13, 0x3b, 0x60, 0x1b, 0x63, 0xba, 0x06, 0x6d, 0xdc, 0xd2, 0x9e, 0x18, 0x6f, 0x3a, 0x5b, 0x9d,
+	0xf7, 0x7a, 0x85, 0xe7, 0x73, 0x13, 0xad, 0xa3, 0xac, 0x07, 0xa6, 0x4f, 0x5c, 0xd6, 0x52, 0x8d,
+	0x47, 0xe5, 0xdf, 0x04, 0x24, 0x4a, 0xc4, 0xed, 0x62, 0xcf, 0xd1, 0x71, 0xcf, 0xc5, 0x6e, 0x4f,
+	0x71, 0xbb, 0x44, 0xdc, 0x44, 0x8b, 0xe3, 0xc8, 0x3c, 0x7c, 0xf3, 0xe7, 0x40, 0x48, 0x18, 0xbb,
+	0x36, 0x3c, 0x30, 0x48, 0xb7, 0xeb, 0xc3, 0x84, 0x30, 0xc5, 0x54, 0x0a, 0x89, 0x9f, 0xa0, 0x6b,
+	0x0e, 0xf6, 0x7d, 0xb0, 0x0d, 0x8b, 0x55, 0xf7, 0x0d, 0x2b, 0x74, 0x03, 0x78, 0xdc, 0x72, 0x57,
+	0xd9, 0x30, 0x5f, 0xdb, 0x97, 0xd8, 0xa0, 0xf8, 0x01, 0x5a, 0x9d, 0x9a, 0x47, 0x09, 0x2f, 0x6b,
+	0xb9, 0x64, 0x7e, 0xf9, 0x3b, 0x01, 0x89, 0x91, 0x4d, 0x98, 0x48, 0xa7, 0x24, 0x80, 0x57, 0x10,
+	0x7f, 0x03, 0x4f, 0x5c, 0xe4, 0xbb, 0xcc, 0x05, 0xbe, 0x2b, 0xff, 0x22, 0xa0, 0xb5, 0x24, 0x31,
+	0xdd, 0x74, 0x86, 0x03, 0x78, 0x6d, 0x03, 0xdf, 0x44, 0x97, 0x13, 0xf9, 0x01, 0x76, 0x80, 0x13,
+	0x5d, 0x8d, 0x65, 0xb7, 0xb1, 0x03, 0x17, 0x6f, 0x2c, 0x73, 0xb1, 0xd9, 0x7f, 0x4f, 0xa3, 0x25,
+	0x7d, 0x60, 0xfa, 0x7d, 0x0d, 0x2c, 0xe2, 0xd9, 0x62, 0x0e, 0xa5, 0xb1, 0xcd, 0xe9, 0xa4, 0xb1,
+	0x9d, 0x94, 0x32, 0x3d, 0x2d, 0x65, 0x75, 0x62, 0xa8, 0x70, 0x89, 0xdc, 0xde, 0xb5, 0xb8, 0x13,
+	0x79, 0xd9, 0x70, 0x38, 0x72, 0x9a, 0xf8, 0x1e, 0x5a, 0xf1, 0x47, 0x9d, 0x7b, 0x60, 0x45, 0x07,
+	0x61, 0x8e, 0xae, 0xb4, 0xcc, 0x41, 0x76, 0x14, 0x62, 0x49, 0xf1, 0xcb, 0x22, 0x4a, 0xa2, 0xb7,
+	0xc5, 0xcc, 0x79, 0xc9, 0xce, 0x9e, 0x97, 0xcf, 0xd1, 0x42, 0xd7, 0x33, 0xad, 0x00, 0x13, 0xb7,
+	0x30, 0x1f, 0xfa, 0xe7, 0xb5, 0xee, 0xb0, 0x06, 0x58, 0xda, 0x64, 0x7e, 0x78, 0x74, 0xee, 0x99,
+	0x78, 0x00, 0x76, 0x61, 0x61, 0x4b, 0xd8, 0x59, 0xd0, 0x78, 0x54, 0xfe, 0x33, 0x8d, 0x56, 0x1a,
+	0x30, 0x24, 0x3e, 0x0e, 0xb8, 0x82, 0xaf, 0x3c, 0xe9, 0x3b, 0xf4, 0x01, 0x78, 0x99, 0xf5, 0x72,
+	0x10, 0xf4, 0xe3, 0xcd, 0xbf, 0x81, 0x72, 0x54, 0x80, 0xf0, 0x18, 0x04, 0x21, 0x13, 0x2a, 0xf3,
+	0xa2, 0xb6, 0x42, 0x51, 0x89, 0x83, 0xe2, 0x3e, 0xca, 0xbe, 0xd5, 0x4d, 0xcd, 0x67, 0x87, 0xc7,
+	0x6e, 0xe2, 0x1f, 0x1f, 0x5c, 0x1b, 0x3c, 0xae, 0x7c, 0x2e, 0x82, 0x75, 0x8a, 0x86, 0x89, 0xdc,
+	0x94, 0x1e, 0x58, 0x80, 0xc7, 0xe0, 0x51, 0xf9, 0x17, 0xb5, 0x1c, 0x83, 0x35, 0x8e, 0x8a, 0x1f,
+	0xa3, 0x79, 0x32, 0x0a, 0x2c, 0xe2, 0x00, 0x6d, 0x40, 0x6e, 0x6f, 0x23, 0x6e, 0x10, 0xae, 0x9b,
+	0xca, 0x32, 0xb4, 0x28, 0x35, 0x7c, 0x08, 0x22, 0x4d, 0x65, 0xdf, 0xf2, 0xc8, 0xfd, 0x57, 0x6b,
+	0xba, 0x81, 0x16, 0x26, 0x54, 0x98, 0x4b, 0x27, 0xb1, 0xf8, 0xe9, 0x44, 0x1e, 0x76, 0x5d, 0xbe,
+	0x53, 0x61, 0x2c, 0x2b, 0xe1, 0x03, 0x5f, 0xe1, 0x0f, 0x7c, 0x45, 0x22, 0xd8, 0xe5, 0xb7, 0x26,
+	0x4f, 0x2f, 0xff, 0x28, 0xa0, 0xcd, 0x04, 0x0f, 0x0d, 0x06, 0x60, 0xfa, 0x70, 0xec, 0x91, 0x21,
+	0xf1, 0xcd, 0x41, 0xf8, 0x06, 0x04, 0x38, 0x18, 0x40, 0xf4, 0x32, 0xd0, 0x40, 0xdc, 0x42, 0x4b,
+	0x36, 0xf8, 0x96, 0x87, 0x87, 0xd4, 0x79, 0x8c, 0x4e, 0x1c, 0x4a, 0xb0, 0xcd, 0x4c, 0xb1, 0xdd,
+	0x44, 0x8b, 0x1e, 0x58, 0x78, 0x88, 0x21, 0xea, 0xa7, 0x76, 0x0e, 0xdc, 0x59, 0x7e, 0xf4, 0xb8,
+	0x94, 0xfa, 0xfe, 0x71, 0x29, 0xf5, 0xef, 0xe3, 0x52, 0xaa, 0xfc, 0x87, 0x80, 0x56, 0xdb, 0x9e,
+	0xe9, 0xfa, 0x5d, 0xf0, 0x8e, 0xb0, 0x8b, 0x9d, 0x91, 0x73, 0xfe, 0x2e, 0x09, 0xb1, 0x77, 0x49,
+	0x3c, 0x42, 0xc8, 0xc1, 0x6e, 0xf4, 0xa0, 0xa7, 0xdf, 0xc8, 0x26, 0x8b, 0x0e, 0x76, 0xf9, 0x6b,
+	0x7e, 0x80, 0xe6, 0xc3, 0x72, 0x5d, 0x80, 0x42, 0xe6, 0x8d, 0x6a, 0x65, 0x1d, 0xec, 0xee, 0x03,
+	0x94, 0x7f, 0x10, 0xd0, 0xb5, 0xa9, 0x1d, 0xbc, 0xb5, 0xba, 0x77, 0x29, 0xb9, 0xb0, 0x14, 0x6f,
+	0x78, 0xe2, 0xa3, 0x68, 0x6a, 0xb5, 0xc9, 0x47, 0x11, 0x0b, 0x93, 0x02, 0xdf, 0xfc, 0xf9, 0xfc,
+	0x76, 0xa4, 0xd7, 0xd7, 0x26, 0x2a, 0xe8, 0xcd, 0x9a, 0x7e, 0x68, 0x68, 0x72, 0x4d, 0x57, 0x5b,
+	0xc6, 0x49, 0x4b, 0x3f, 0x96, 0x25, 0x65, 0x5f, 0x91, 0x1b, 0xf9, 0x94, 0xb8, 0x8d, 0xde, 0x4d,
+	0x8c, 0x9e, 0xd6, 0x9a, 0xba, 0xdc, 0x36, 0x74, 0xe5, 0xa0, 0x55, 0x6b, 0x9f, 0x68, 0x72, 0x5e,
+	0x10, 0xb7, 0xd0, 0x66, 0x22, 0xa5, 0x5e, 0x6b, 0x4b, 0x87, 0xb1, 0x8c, 0xb4, 0x78, 0x03, 0x6d,
+	0x27, 0x32, 0x9a, 0xea, 0x81, 0x22, 0x19, 0x52, 0xad, 0xd9, 0x8c, 0xa5, 0x65, 0x66, 0x0a, 0xa9,
+	0x5a, 0x4d, 0x6a, 0xca, 0x46, 0x53, 0x39, 0x95, 0x5b, 0xb2, 0xae, 0xe7, 0xe7, 0xc4, 0x32, 0x2a,
+	0x26, 0x32, 0x24, 0xb5, 0xb5, 0xdf, 0x54, 0xa4, 0xb6, 0xd2, 0x3a, 0x30, 0xa4, 0x66, 0x4d, 0x39,
+	0xca, 0x5f, 0x9a, 0xc9, 0xa9, 0xd7, 0x1a, 0x86, 0xdc, 0x8e, 0x13, 0xca, 0xce, 0xec, 0xb9, 0xa1,
+	0x9e, 0xd4, 0x9b, 0x32, 0x4d, 0xc9, 0xcf, 0x6f, 0xcc, 0x3d, 0xfa, 0xa9, 0x98, 0xba, 0xf9, 0xad,
+	0x80, 0x72, 0xc9, 0xd3, 0x2c, 0x96, 0xd0, 0xf5, 0x86, 0x7c, 0xac, 0xea, 0x4a, 0xdb, 0x50, 0x4f,
+	0xda, 0x92, 0x7a, 0x24, 0x4f, 0xa9, 0xb5, 0x89, 0x0a, 0xd3, 0x09, 0x92, 0x26, 0x37, 0x94, 0xb6,
+	0xdc, 0xc8, 0x0b, 0x21, 0xb3, 0x99, 0x51, 0xf5, 0xe8, 0xe8, 0xa4, 0xa5, 0xb4, 0xbf, 0x30, 0x8e,
+	0x55, 0xb5, 0x99, 0x4f, 0x8b, 0x1b, 0x68, 0x7d, 0x3a, 0x67, 0xbf, 0xa6, 0x34, 0xe5, 0x46, 0x3e,
+	0xc3, 0x78, 0xd5, 0xbf, 0x7a, 0xf2, 0xbc, 0x28, 0x3c, 0x7d, 0x5e, 0x14, 0xfe, 0x79, 0x5e, 0x14,
+	0xbe, 0x79, 0x51, 0x4c, 0x3d, 0x7d, 0x51, 0x4c, 0xfd, 0xf5, 0xa2, 0x98, 0xfa, 0xb2, 0x1e, 0x33,
+	0xaa, 0x39, 0x08, 0xfa, 0x60, 0xde, 0x72, 0x21, 0x88, 0xcc, 0xca, 0xfd, 0x72, 0xab, 0x43, 0xbf,
+	0xa0, 0xab, 0x0e, 0xb1, 0x47, 0x03, 0xa8, 0x3e, 0xa8, 0x72, 0x9c, 0x19, 0xb9, 0x93, 0xa5, 0x5f,
+	0xfe, 0x1f, 0xfd, 0x3f, 0x00, 0x9e, 0xf1, 0x1b, 0xd9, 0x55, 0x0c, 0x00, 0x00,
+}
+
+func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Power != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Valset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Valset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Valset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardToken) > 0 {
+		i -= len(m.RewardToken)
+		copy(dAtA[i:], m.RewardToken)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.RewardAmount.Size()
+		i -= size
+		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastObservedEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastObservedEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastObservedEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CosmosBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValsetHijackIncident) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValsetHijackIncident) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValsetHijackIncident) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.ClaimedValset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfirmSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedConfirms) > 0 {
+		i -= len(m.MissedConfirms)
+		copy(dAtA[i:], m.MissedConfirms)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MissedConfirms)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MissedConfirmsCounter != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MissedConfirmsCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumHeightVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumHeightVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumHeightVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EthereumBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EthereumHeightSample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EthereumHeightSample) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EthereumHeightSample) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CosmosBlockTime != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CosmosBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SubjectToken) > 0 {
+		i -= len(m.SubjectToken)
+		copy(dAtA[i:], m.SubjectToken)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SubjectToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SubjectNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SubjectNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Outcome != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EthBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositEscrowReleaseProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositEscrowReleaseProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositEscrowReleaseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferMinimum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferMinimum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferMinimum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferMinimumProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferMinimumProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferMinimumProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Minimum.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BridgeValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovTypes(uint64(m.Power))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Valset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = m.RewardAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.RewardToken)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *LastObservedEthereumBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	}
+	if m.EthereumBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	}
+	return n
+}
+
+func (m *ERC20ToDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ValsetHijackIncident) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.EventNonce))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	l = m.ClaimedValset.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfirmSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovTypes(uint64(m.IndexOffset))
+	}
+	if m.MissedConfirmsCounter != 0 {
+		n += 1 + sovTypes(uint64(m.MissedConfirmsCounter))
+	}
+	l = len(m.MissedConfirms)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *EthereumHeightVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EthereumBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	}
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	}
+	return n
+}
+
+func (m *EthereumHeightSample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	}
+	if m.CosmosBlockTime != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockTime))
+	}
+	if m.EthereumBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	}
+	return n
+}
+
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTypes(uint64(m.Reason))
+	}
+	if m.SubjectNonce != 0 {
+		n += 1 + sovTypes(uint64(m.SubjectNonce))
+	}
+	l = len(m.SubjectToken)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Jailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *DepositRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.EventNonce))
+	}
+	if m.EthBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthBlockHeight))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovTypes(uint64(m.Outcome))
+	}
+	return n
+}
+
+func (m *DepositEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.EventNonce))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *DepositEscrowReleaseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferMinimum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.MinAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *TransferMinimumProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Minimum.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Valset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Valset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Valset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, BridgeValidator{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+			}
+			m.EthereumBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValsetHijackIncident) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValsetHijackIncident: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValsetHijackIncident: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedValset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimedValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfirmSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedConfirmsCounter", wireType)
+			}
+			m.MissedConfirmsCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedConfirmsCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedConfirms", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedConfirms = append(m.MissedConfirms[:0], dAtA[iNdEx:postIndex]...)
+			if m.MissedConfirms == nil {
+				m.MissedConfirms = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumHeightVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumHeightVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumHeightVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+			}
+			m.EthereumBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EthereumHeightSample) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EthereumHeightSample: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EthereumHeightSample: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockTime", wireType)
+			}
+			m.CosmosBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+			}
+			m.EthereumBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= SlashReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectNonce", wireType)
+			}
+			m.SubjectNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubjectNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+			}
+			m.EthBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= DepositOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *ERC20ToDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
+func (m *DepositEscrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -588,17 +3666,17 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositEscrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			m.Power = 0
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -608,14 +3686,14 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -643,7 +3721,40 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -669,7 +3780,7 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Valset) Unmarshal(dAtA []byte) error {
+func (m *DepositEscrowReleaseProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -692,17 +3803,17 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Valset: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositEscrowReleaseProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Valset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositEscrowReleaseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -712,16 +3823,29 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -731,48 +3855,27 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, BridgeValidator{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -800,13 +3903,11 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -834,7 +3935,7 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -860,7 +3961,7 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+func (m *TransferMinimum) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -883,17 +3984,17 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferMinimum: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferMinimum: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.CosmosBlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -903,16 +4004,29 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
 			}
-			m.EthereumBlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -922,11 +4036,60 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -951,7 +4114,7 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+func (m *TransferMinimumProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -974,15 +4137,15 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferMinimumProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferMinimumProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1010,11 +4173,11 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1042,7 +4205,40 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minimum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minimum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
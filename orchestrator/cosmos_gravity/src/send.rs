@@ -13,10 +13,13 @@ use ethereum_gravity::message_signatures::{
 use ethereum_gravity::utils::downcast_uint256;
 use gravity_proto::cosmos_sdk_proto::cosmos::base::abci::v1beta1::TxResponse;
 use gravity_proto::cosmos_sdk_proto::cosmos::tx::v1beta1::BroadcastMode;
+use gravity_proto::gravity::DelegateKeysSignMsg;
 use gravity_proto::gravity::MsgConfirmLogicCall;
 use gravity_proto::gravity::MsgErc20DeployedClaim;
+use gravity_proto::gravity::MsgEthereumHeightClaim;
 use gravity_proto::gravity::MsgLogicCallExecutedClaim;
 use gravity_proto::gravity::MsgRequestBatch;
+use gravity_proto::gravity::MsgRotateDelegateKeys;
 use gravity_proto::gravity::MsgSendToCosmosClaim;
 use gravity_proto::gravity::MsgSendToEth;
 use gravity_proto::gravity::MsgSetOrchestratorAddress;
@@ -25,6 +28,7 @@ use gravity_proto::gravity::MsgValsetUpdatedClaim;
 use gravity_proto::gravity::{MsgBatchSendToEthClaim, MsgSubmitBadSignatureEvidence};
 use gravity_proto::gravity::{MsgCancelSendToEth, MsgConfirmBatch};
 use gravity_utils::types::*;
+use prost::Message;
 use std::{collections::HashMap, time::Duration};
 
 use crate::utils::BadSignatureEvidence;
@@ -34,13 +38,15 @@ pub const TIMEOUT: Duration = Duration::from_secs(60);
 
 /// Send a transaction updating the eth address for the sending
 /// Cosmos address. The sending Cosmos address should be a validator
-/// this can only be called once! Key rotation code is possible but
-/// not currently implemented
+/// this can only be called once! Use rotate_gravity_delegate_keys to
+/// replace the keys afterwards. The delegate Ethereum key signs over the
+/// gravity id and both Cosmos addresses to prove ownership of the address
 pub async fn set_gravity_delegate_addresses(
     contact: &Contact,
-    delegate_eth_address: EthAddress,
+    delegate_eth_key: EthPrivateKey,
     delegate_cosmos_address: Address,
     private_key: PrivateKey,
+    gravity_id: String,
     fee: Coin,
 ) -> Result<TxResponse, CosmosGrpcError> {
     trace!("Updating Gravity Delegate addresses");
@@ -54,10 +60,23 @@ pub async fn set_gravity_delegate_addresses(
         .to_bech32(format!("{}valoper", contact.get_prefix()))
         .unwrap();
 
+    let delegate_eth_address = delegate_eth_key.to_public_key().unwrap();
+    let sign_msg = DelegateKeysSignMsg {
+        gravity_id,
+        validator: our_valoper_address.to_string(),
+        orchestrator: delegate_cosmos_address.to_string(),
+    };
+    let mut message = Vec::new();
+    sign_msg
+        .encode(&mut message)
+        .expect("Failed to encode delegate keys message");
+    let eth_signature = delegate_eth_key.sign_ethereum_msg(&message);
+
     let msg_set_orch_address = MsgSetOrchestratorAddress {
         validator: our_valoper_address.to_string(),
         orchestrator: delegate_cosmos_address.to_string(),
         eth_address: delegate_eth_address.to_string(),
+        eth_signature: bytes_to_hex_str(&eth_signature.to_bytes()),
     };
 
     let msg = Msg::new(
@@ -75,6 +94,73 @@ pub async fn set_gravity_delegate_addresses(
         .await
 }
 
+/// Send a transaction replacing the delegate Ethereum key and/or the delegate Cosmos
+/// address of the sending validator, which must have set its delegate keys before.
+/// When the Ethereum key changes it signs over the gravity id and the orchestrator
+/// address in use after the rotation, which is the new one if provided and otherwise
+/// the current one
+#[allow(clippy::too_many_arguments)]
+pub async fn rotate_gravity_delegate_keys(
+    contact: &Contact,
+    new_delegate_eth_key: Option<EthPrivateKey>,
+    new_delegate_cosmos_address: Option<Address>,
+    current_delegate_cosmos_address: Address,
+    private_key: PrivateKey,
+    gravity_id: String,
+    fee: Coin,
+) -> Result<TxResponse, CosmosGrpcError> {
+    trace!("Rotating Gravity Delegate addresses");
+    let our_valoper_address = private_key
+        .to_address(&contact.get_prefix())
+        .unwrap()
+        .to_bech32(format!("{}valoper", contact.get_prefix()))
+        .unwrap();
+
+    let orchestrator = new_delegate_cosmos_address.unwrap_or(current_delegate_cosmos_address);
+    let (new_eth_address, eth_signature) = match new_delegate_eth_key {
+        Some(key) => {
+            let sign_msg = DelegateKeysSignMsg {
+                gravity_id,
+                validator: our_valoper_address.to_string(),
+                orchestrator: orchestrator.to_string(),
+            };
+            let mut message = Vec::new();
+            sign_msg
+                .encode(&mut message)
+                .expect("Failed to encode delegate keys message");
+            let eth_signature = key.sign_ethereum_msg(&message);
+            (
+                key.to_public_key().unwrap().to_string(),
+                bytes_to_hex_str(&eth_signature.to_bytes()),
+            )
+        }
+        None => (String::new(), String::new()),
+    };
+
+    let msg_rotate_delegate_keys = MsgRotateDelegateKeys {
+        validator: our_valoper_address.to_string(),
+        new_orchestrator: new_delegate_cosmos_address
+            .map(|a| a.to_string())
+            .unwrap_or_default(),
+        new_eth_address,
+        eth_signature,
+    };
+
+    let msg = Msg::new(
+        "/gravity.v1.MsgRotateDelegateKeys",
+        msg_rotate_delegate_keys,
+    );
+    contact
+        .send_message(
+            &[msg],
+            Some(MEMO.to_string()),
+            &[fee],
+            Some(TIMEOUT),
+            private_key,
+        )
+        .await
+}
+
 /// Send in a confirmation for an array of validator sets, it's far more efficient to send these
 /// as a single message
 #[allow(clippy::too_many_arguments)]
@@ -386,6 +472,33 @@ pub async fn send_to_eth(
         .await
 }
 
+/// Send a heartbeat claiming the latest Ethereum block height the orchestrator has seen, this lets
+/// the Cosmos chain advance its view of Ethereum, and with it batch and logic call timeouts, when
+/// no Ethereum events are happening
+pub async fn send_ethereum_height_claim(
+    contact: &Contact,
+    private_key: PrivateKey,
+    block_height: u64,
+    fee: Coin,
+) -> Result<TxResponse, CosmosGrpcError> {
+    let our_address = private_key.to_address(&contact.get_prefix()).unwrap();
+
+    let claim = MsgEthereumHeightClaim {
+        block_height,
+        orchestrator: our_address.to_string(),
+    };
+    let msg = Msg::new("/gravity.v1.MsgEthereumHeightClaim", claim);
+    contact
+        .send_message(
+            &[msg],
+            Some(MEMO.to_string()),
+            &[fee],
+            Some(TIMEOUT),
+            private_key,
+        )
+        .await
+}
+
 pub async fn send_request_batch(
     private_key: PrivateKey,
     denom: String,
@@ -194,6 +194,7 @@ pub struct KeyOpts {
 #[derive(Clap)]
 pub enum KeysSubcommand {
     RegisterOrchestratorAddress(RegisterOrchestratorAddressOpts),
+    RotateDelegateKeys(RotateDelegateKeysOpts),
     SetEthereumKey(SetEthereumKeyOpts),
     SetOrchestratorKey(SetOrchestratorKeyOpts),
     Show,
@@ -225,6 +226,32 @@ pub struct RegisterOrchestratorAddressOpts {
     pub no_save: bool,
 }
 
+/// Replace the registered delegate keys of a validator, the Ethereum key and/or the
+/// Orchestrator key can be rotated. Confirms signed with the replaced Ethereum key are
+/// accepted until a validator set containing the new key reaches Ethereum
+#[derive(Clap)]
+#[clap(setting = AppSettings::ColoredHelp)]
+pub struct RotateDelegateKeysOpts {
+    /// The Cosmos private key of the validator
+    #[clap(short, long, parse(try_from_str))]
+    pub validator_phrase: CosmosPrivateKey,
+    /// (Optional) The new Ethereum private key, the current one is kept if not provided
+    #[clap(short, long, parse(try_from_str))]
+    pub ethereum_key: Option<EthPrivateKey>,
+    /// (Optional) The phrase for the new Cosmos key, the current one is kept if not provided
+    #[clap(short, long, parse(try_from_str))]
+    pub cosmos_phrase: Option<String>,
+    /// (Optional) The Cosmos gRPC server that will be used to submit the transaction
+    #[clap(long, default_value = "http://localhost:9090")]
+    pub cosmos_grpc: String,
+    /// The Cosmos Denom and amount to pay Cosmos chain fees
+    #[clap(short, long, parse(try_from_str))]
+    pub fees: Coin,
+    /// Do not save the new keys to disk for later use with `orchestrator start`
+    #[clap(long)]
+    pub no_save: bool,
+}
+
 /// Add an Ethereum private key for use with either the Relayer or the Orchestrator
 #[derive(Clap)]
 #[clap(setting = AppSettings::ColoredHelp)]
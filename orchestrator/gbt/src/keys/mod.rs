@@ -1,4 +1,5 @@
 pub mod register_orchestrator_address;
+pub mod rotate_delegate_keys;
 
 use crate::{
     args::{SetEthereumKeyOpts, SetOrchestratorKeyOpts},
@@ -0,0 +1,109 @@
+use std::path::PathBuf;
+use std::process::exit;
+
+use crate::args::RotateDelegateKeysOpts;
+use crate::config::config_exists;
+use crate::config::load_keys;
+use crate::config::save_keys;
+use crate::utils::TIMEOUT;
+use cosmos_gravity::query::get_gravity_params;
+use cosmos_gravity::send::rotate_gravity_delegate_keys;
+use deep_space::address::Address as CosmosAddress;
+use deep_space::private_key::PrivateKey as CosmosPrivateKey;
+use gravity_proto::gravity::QueryDelegateKeysByValidatorAddress;
+use gravity_utils::connection_prep::check_for_fee;
+use gravity_utils::connection_prep::{create_rpc_connections, wait_for_cosmos_node_ready};
+
+pub async fn rotate_delegate_keys(args: RotateDelegateKeysOpts, prefix: String, home_dir: PathBuf) {
+    let fee = args.fees;
+    let cosmos_grpc = args.cosmos_grpc;
+    let validator_key = args.validator_phrase;
+
+    if args.ethereum_key.is_none() && args.cosmos_phrase.is_none() {
+        error!("Please provide a new Ethereum key and/or a new Cosmos phrase to rotate to!");
+        exit(1);
+    }
+    if !args.no_save && !config_exists(&home_dir) {
+        error!("Please run `gbt init` before running this command!");
+        exit(1);
+    }
+
+    let connections = create_rpc_connections(prefix, Some(cosmos_grpc), None, TIMEOUT).await;
+    let contact = connections.contact.unwrap();
+    let mut grpc = connections.grpc.unwrap();
+    wait_for_cosmos_node_ready(&contact).await;
+
+    let validator_addr = validator_key.to_address(&contact.get_prefix()).unwrap();
+    check_for_fee(&fee, validator_addr, &contact).await;
+
+    let cosmos_key = args.cosmos_phrase.as_ref().map(|phrase| {
+        CosmosPrivateKey::from_phrase(phrase, "").expect("Failed to parse cosmos key")
+    });
+    let cosmos_address = cosmos_key.map(|key| key.to_address(&contact.get_prefix()).unwrap());
+
+    // the new Ethereum key signs for the orchestrator in use after the rotation, which
+    // is the registered one when only the Ethereum key is replaced
+    let valoper_address = validator_addr
+        .to_bech32(format!("{}valoper", contact.get_prefix()))
+        .unwrap();
+    let current = grpc
+        .get_delegate_key_by_validator(QueryDelegateKeysByValidatorAddress {
+            validator_address: valoper_address,
+        })
+        .await
+        .expect("Failed to get the current delegate keys, are they registered?")
+        .into_inner();
+    let current_orchestrator: CosmosAddress = current
+        .orchestrator_address
+        .parse()
+        .expect("Invalid orchestrator address registered");
+
+    let params = get_gravity_params(&mut grpc)
+        .await
+        .expect("Failed to get Gravity params");
+    let res = rotate_gravity_delegate_keys(
+        &contact,
+        args.ethereum_key,
+        cosmos_address,
+        current_orchestrator,
+        validator_key,
+        params.gravity_id,
+        fee.clone(),
+    )
+    .await
+    .expect("Failed to rotate delegate keys");
+    let res = contact.wait_for_tx(res, TIMEOUT).await;
+
+    if let Err(e) = res {
+        error!(
+            "Failed trying to rotate delegate keys error {:?}, correct the error and try again",
+            e
+        );
+        exit(1);
+    }
+
+    if let Some(key) = args.ethereum_key {
+        info!(
+            "Rotated Delegate Ethereum address {} -> {}",
+            current.eth_address,
+            key.to_public_key().unwrap()
+        );
+    }
+    if let Some(address) = cosmos_address {
+        info!(
+            "Rotated Delegate Cosmos address {} -> {}",
+            current_orchestrator, address
+        );
+    }
+    if !args.no_save {
+        let mut keys = load_keys(&home_dir);
+        if let Some(key) = args.ethereum_key {
+            keys.ethereum_key = Some(key);
+        }
+        if let Some(phrase) = args.cosmos_phrase {
+            keys.orchestrator_phrase = Some(phrase);
+        }
+        save_keys(&home_dir, keys);
+        info!("Keys saved! Restart your orchestrator to use the new keys");
+    }
+}
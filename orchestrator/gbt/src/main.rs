@@ -15,6 +15,7 @@ use client::eth_to_cosmos::eth_to_cosmos;
 use config::{get_home_dir, load_config};
 use env_logger::Env;
 use keys::register_orchestrator_address::register_orchestrator_address;
+use keys::rotate_delegate_keys::rotate_delegate_keys;
 use keys::set_eth_key;
 use keys::set_orchestrator_key;
 
@@ -62,6 +63,9 @@ async fn main() {
                 )
                 .await
             }
+            KeysSubcommand::RotateDelegateKeys(rotate_delegate_keys_opts) => {
+                rotate_delegate_keys(rotate_delegate_keys_opts, address_prefix, home_dir).await
+            }
             KeysSubcommand::Show => show_keys(&home_dir, &address_prefix),
             KeysSubcommand::SetEthereumKey(set_eth_key_opts) => {
                 set_eth_key(&home_dir, set_eth_key_opts)
@@ -13,6 +13,9 @@
 /// the key in which the attestation is stored is keyed on the exact details of the claim
 /// but there is no reason to store those exact details becuause the next message sender
 /// will kindly provide you with them.
+/// VOTE_POWERS:
+/// The power of each voter and the total power at the time they voted, these are
+/// used to tally the attestation under ATTESTATION_POWER_POLICY_SNAPSHOT
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct Attestation {
     #[prost(bool, tag="1")]
@@ -23,6 +26,37 @@ pub struct Attestation {
     pub height: u64,
     #[prost(message, optional, tag="4")]
     pub claim: ::core::option::Option<::prost_types::Any>,
+    #[prost(message, repeated, tag="5")]
+    pub vote_powers: ::prost::alloc::vec::Vec<VotePower>,
+}
+/// VotePower records the consensus power of a validator and the total power of
+/// the validator set at the time the validator voted on an attestation
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct VotePower {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(int64, tag="2")]
+    pub power: i64,
+    #[prost(string, tag="3")]
+    pub total_power: ::prost::alloc::string::String,
+}
+/// ConflictingClaim records a validator which voted for an attestation that lost
+/// to a different attestation at the same event nonce, this usually means the
+/// orchestrator of the validator is running a faulty Ethereum node
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ConflictingClaim {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub event_nonce: u64,
+    #[prost(enumeration="ClaimType", tag="3")]
+    pub claim_type: i32,
+    #[prost(string, tag="4")]
+    pub claim_hash: ::prost::alloc::string::String,
+    #[prost(string, tag="5")]
+    pub observed_claim_hash: ::prost::alloc::string::String,
+    #[prost(uint64, tag="6")]
+    pub block_height: u64,
 }
 /// ERC20Token unique identifier for an Ethereum ERC20 token.
 /// CONTRACT:
@@ -48,6 +82,29 @@ pub enum ClaimType {
     Erc20Deployed = 3,
     LogicCallExecuted = 4,
     ValsetUpdated = 5,
+    EthereumHeight = 6,
+}
+/// AttestationPowerPolicy selects the voting power attestations are tallied with
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum AttestationPowerPolicy {
+    /// tally the current power of the voters against the current total power
+    Current = 0,
+    /// tally each vote with the share of the total power the voter held when
+    /// the vote was cast
+    Snapshot = 1,
+}
+/// DelegateKeysSignMsg is the message signed by an Ethereum key to prove that
+/// the validator registering it as a delegate key controls it, the gravity id
+/// separates signatures made for different bridges
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DelegateKeysSignMsg {
+    #[prost(string, tag="1")]
+    pub gravity_id: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub orchestrator: ::prost::alloc::string::String,
 }
 /// SignType defines messages that have been signed by an orchestrator
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
@@ -71,6 +128,24 @@ pub struct OutgoingTxBatch {
     #[prost(uint64, tag="5")]
     pub block: u64,
 }
+/// BatchSizeLimit is the largest number of transactions a batch of a token may
+/// contain
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchSizeLimit {
+    #[prost(string, tag="1")]
+    pub token: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub max_batch_size: u64,
+}
+/// AutoBatchFeeThreshold is the total fee the pooled transactions of a token
+/// must pay before a batch of them is built in the end blocker
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AutoBatchFeeThreshold {
+    #[prost(string, tag="1")]
+    pub token: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub min_total_fee: ::prost::alloc::string::String,
+}
 /// OutgoingTransferTx represents an individual send from gravity to ETH
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct OutgoingTransferTx {
@@ -104,6 +179,23 @@ pub struct OutgoingLogicCall {
     pub invalidation_nonce: u64,
     #[prost(uint64, tag="8")]
     pub block: u64,
+    /// the account the transfers and fees were escrowed from, they are
+    /// returned to it if the call is canceled
+    #[prost(string, tag="9")]
+    pub sender: ::prost::alloc::string::String,
+}
+/// BatchSelectionPolicy selects the order transactions are taken from the
+/// pool in when a batch is built
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum BatchSelectionPolicy {
+    /// take the transactions paying the highest fees first
+    FeeDesc = 0,
+    /// take the transactions in the order they were sent
+    Fifo = 1,
+    /// take the transactions with the highest fee scaled up by the number of
+    /// blocks they have waited in the pool first
+    AgeWeighted = 2,
 }
 /// BridgeValidator represents a validator's ETH address and its power
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -151,6 +243,184 @@ pub struct Erc20ToDenom {
     #[prost(string, tag="2")]
     pub denom: ::prost::alloc::string::String,
 }
+/// ValsetHijackIncident records an observed validator set update on Ethereum
+/// that does not match the validator set created on Cosmos with the same nonce,
+/// this indicates that control of the bridge contract has been lost
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ValsetHijackIncident {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+    #[prost(uint64, tag="2")]
+    pub block_height: u64,
+    #[prost(message, optional, tag="3")]
+    pub claimed_valset: ::core::option::Option<Valset>,
+    #[prost(string, tag="4")]
+    pub reason: ::prost::alloc::string::String,
+}
+/// ConfirmSigningInfo tracks the valset, batch and logic call confirmations a
+/// validator has missed over its last confirm_signing_window signing requests
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ConfirmSigningInfo {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    /// the number of signing requests counted since the window last started over
+    #[prost(uint64, tag="2")]
+    pub index_offset: u64,
+    /// the number of signing requests in the window without a confirmation
+    #[prost(uint64, tag="3")]
+    pub missed_confirms_counter: u64,
+    /// bit array of the missed signing requests in the window, indexed by
+    /// index_offset modulo the window
+    #[prost(bytes="vec", tag="4")]
+    pub missed_confirms: ::prost::alloc::vec::Vec<u8>,
+}
+/// EthereumHeightVote is the latest Ethereum block height a validator has
+/// claimed with a MsgEthereumHeightClaim
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EthereumHeightVote {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub ethereum_block_height: u64,
+    /// the Cosmos block height the vote was cast at
+    #[prost(uint64, tag="3")]
+    pub cosmos_block_height: u64,
+}
+/// EthereumHeightSample is a Cosmos block height and block time paired with
+/// the Ethereum block height observed at it, the samples kept over the
+/// ethereum_height_history_blocks window calibrate the average block times
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct EthereumHeightSample {
+    #[prost(uint64, tag="1")]
+    pub cosmos_block_height: u64,
+    /// the Cosmos block time in unix milliseconds
+    #[prost(uint64, tag="2")]
+    pub cosmos_block_time: u64,
+    #[prost(uint64, tag="3")]
+    pub ethereum_block_height: u64,
+}
+/// SlashRecord is the historical record of a gravity specific slash, the
+/// subject is the valset, batch, logic call or event nonce the validator was
+/// slashed over. subject_token is the token contract of a batch or the hex
+/// encoded invalidation id of a logic call and empty for the other reasons
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SlashRecord {
+    #[prost(uint64, tag="1")]
+    pub id: u64,
+    #[prost(string, tag="2")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(enumeration="SlashReason", tag="3")]
+    pub reason: i32,
+    #[prost(uint64, tag="4")]
+    pub subject_nonce: u64,
+    #[prost(string, tag="5")]
+    pub subject_token: ::prost::alloc::string::String,
+    #[prost(uint64, tag="6")]
+    pub block_height: u64,
+    #[prost(bytes="vec", tag="7")]
+    pub fraction: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bool, tag="8")]
+    pub jailed: bool,
+}
+/// DepositRecord is a compact record of an observed deposit from Ethereum,
+/// it is kept after the attestation for the deposit has been pruned
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DepositRecord {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+    #[prost(uint64, tag="2")]
+    pub eth_block_height: u64,
+    #[prost(string, tag="3")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(string, tag="4")]
+    pub amount: ::prost::alloc::string::String,
+    #[prost(string, tag="5")]
+    pub ethereum_sender: ::prost::alloc::string::String,
+    #[prost(string, tag="6")]
+    pub cosmos_receiver: ::prost::alloc::string::String,
+    #[prost(enumeration="DepositOutcome", tag="7")]
+    pub outcome: i32,
+}
+/// DepositEscrow holds the tokens of a deposit from Ethereum which could not be
+/// credited to its receiver, the tokens stay in the gravity module account until
+/// they are claimed by the receiver or released by governance
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DepositEscrow {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+    #[prost(string, tag="2")]
+    pub receiver: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="3")]
+    pub amount: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+/// DepositEscrowReleaseProposal releases all the escrowed deposits of receiver
+/// to recipient, this recovers deposits to an address that can never receive them
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DepositEscrowReleaseProposal {
+    #[prost(string, tag="1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub receiver: ::prost::alloc::string::String,
+    #[prost(string, tag="4")]
+    pub recipient: ::prost::alloc::string::String,
+}
+/// TransferMinimum is the smallest amount and bridge fee a transfer of the
+/// denom to Ethereum may carry, smaller transfers are never relayed profitably
+/// and only bloat the pool
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TransferMinimum {
+    #[prost(string, tag="1")]
+    pub denom: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub min_amount: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub min_fee: ::prost::alloc::string::String,
+}
+/// TransferMinimumProposal sets the transfer minimum of a denom, a minimum with
+/// a zero amount and fee removes it
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TransferMinimumProposal {
+    #[prost(string, tag="1")]
+    pub title: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub description: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="3")]
+    pub minimum: ::core::option::Option<TransferMinimum>,
+}
+/// SlashReason is the gravity specific offense a validator was slashed for
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum SlashReason {
+    Unspecified = 0,
+    /// too many valset confirmations missed in the confirm signing window
+    ValsetSignature = 1,
+    /// too many batch confirmations missed in the confirm signing window
+    BatchSignature = 2,
+    /// too many logic call confirmations missed in the confirm signing window
+    LogicCallSignature = 3,
+    /// an observed event was not claimed within the oracle liveness window
+    OracleLiveness = 4,
+    /// a claim lost to a different observed claim at the same event nonce
+    ConflictingClaim = 5,
+    /// the Ethereum key signed a checkpoint which was never created by the chain
+    BadEthSignature = 6,
+    /// the Ethereum key signed two different checkpoints for the same nonce
+    DoubleSign = 7,
+}
+/// DepositOutcome is what happened to the tokens of an observed deposit from Ethereum
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum DepositOutcome {
+    Unspecified = 0,
+    /// the tokens were sent to the cosmos receiver
+    Credited = 1,
+    /// the cosmos receiver was invalid and the tokens were sent to the community pool
+    CommunityPool = 2,
+    /// the tokens could not be sent to the cosmos receiver and were placed in escrow
+    Failed = 3,
+}
 /// MsgSetOrchestratorAddress
 /// this message allows validators to delegate their voting responsibilities
 /// to a given key. This key is then used as an optional authentication method
@@ -164,6 +434,9 @@ pub struct Erc20ToDenom {
 /// ETH_ADDRESS
 /// This is a hex encoded 0x Ethereum public key that will be used by this validator
 /// on Ethereum
+/// ETH_SIGNATURE
+/// This is a hex encoded signature by the Ethereum key over a DelegateKeysSignMsg
+/// proving that the validator controls the Ethereum address
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgSetOrchestratorAddress {
     #[prost(string, tag="1")]
@@ -172,10 +445,42 @@ pub struct MsgSetOrchestratorAddress {
     pub orchestrator: ::prost::alloc::string::String,
     #[prost(string, tag="3")]
     pub eth_address: ::prost::alloc::string::String,
+    #[prost(string, tag="4")]
+    pub eth_signature: ::prost::alloc::string::String,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgSetOrchestratorAddressResponse {
 }
+/// MsgRotateDelegateKeys
+/// this message allows a validator that has already set its delegate keys
+/// to replace its orchestrator address and/or its Ethereum address. The
+/// replaced keys are kept in a historical mapping so that confirms and
+/// attestations made with them still resolve to the validator
+/// VALIDATOR
+/// The validator field is a cosmosvaloper1... string (i.e. sdk.ValAddress)
+/// that has previously set delegate keys with MsgSetOrchestratorAddress
+/// NEW_ORCHESTRATOR
+/// The new cosmos1... orchestrator address, leave empty to keep the current one
+/// NEW_ETH_ADDRESS
+/// The new hex encoded 0x Ethereum address, leave empty to keep the current one.
+/// Changing the Ethereum address triggers a new validator set update
+/// ETH_SIGNATURE
+/// Required when the Ethereum address changes, a hex encoded signature by the new
+/// Ethereum key over a DelegateKeysSignMsg for the orchestrator used after the rotation
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgRotateDelegateKeys {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub new_orchestrator: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub new_eth_address: ::prost::alloc::string::String,
+    #[prost(string, tag="4")]
+    pub eth_signature: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgRotateDelegateKeysResponse {
+}
 /// MsgValsetConfirm
 /// this is the message sent by the validators when they wish to submit their
 /// signatures over the validator set at a given block height. A validator must
@@ -384,6 +689,22 @@ pub struct MsgLogicCallExecutedClaim {
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgLogicCallExecutedClaimResponse {
 }
+/// This is a heartbeat that informs the Cosmos module of the
+/// latest Ethereum block height the orchestrator has seen, it
+/// is submitted periodically so the observed Ethereum height
+/// advances when no other events happen on Ethereum.
+/// Unlike the other claims it has no event nonce, the heights
+/// voted by the validators are tallied in the end blocker
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgEthereumHeightClaim {
+    #[prost(uint64, tag="1")]
+    pub block_height: u64,
+    #[prost(string, tag="2")]
+    pub orchestrator: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgEthereumHeightClaimResponse {
+}
 /// This informs the Cosmos module that a validator
 /// set has been updated.
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -435,7 +756,94 @@ pub struct MsgSubmitBadSignatureEvidence {
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct MsgSubmitBadSignatureEvidenceResponse {
 }
-# [doc = r" Generated client implementations."] pub mod msg_client { # ! [allow (unused_variables , dead_code , missing_docs)] use tonic :: codegen :: * ; # [doc = " Msg defines the state transitions possible within gravity"] pub struct MsgClient < T > { inner : tonic :: client :: Grpc < T > , } impl MsgClient < tonic :: transport :: Channel > { # [doc = r" Attempt to create a new client by connecting to a given endpoint."] pub async fn connect < D > (dst : D) -> Result < Self , tonic :: transport :: Error > where D : std :: convert :: TryInto < tonic :: transport :: Endpoint > , D :: Error : Into < StdError > , { let conn = tonic :: transport :: Endpoint :: new (dst) ? . connect () . await ? ; Ok (Self :: new (conn)) } } impl < T > MsgClient < T > where T : tonic :: client :: GrpcService < tonic :: body :: BoxBody > , T :: ResponseBody : Body + HttpBody + Send + 'static , T :: Error : Into < StdError > , < T :: ResponseBody as HttpBody > :: Error : Into < StdError > + Send , { pub fn new (inner : T) -> Self { let inner = tonic :: client :: Grpc :: new (inner) ; Self { inner } } pub fn with_interceptor (inner : T , interceptor : impl Into < tonic :: Interceptor >) -> Self { let inner = tonic :: client :: Grpc :: with_interceptor (inner , interceptor) ; Self { inner } } pub async fn valset_confirm (& mut self , request : impl tonic :: IntoRequest < super :: MsgValsetConfirm > ,) -> Result < tonic :: Response < super :: MsgValsetConfirmResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ValsetConfirm") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: MsgSendToEth > ,) -> Result < tonic :: Response < super :: MsgSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn request_batch (& mut self , request : impl tonic :: IntoRequest < super :: MsgRequestBatch > ,) -> Result < tonic :: Response < super :: MsgRequestBatchResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/RequestBatch") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn confirm_batch (& mut self , request : impl tonic :: IntoRequest < super :: MsgConfirmBatch > ,) -> Result < tonic :: Response < super :: MsgConfirmBatchResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ConfirmBatch") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn confirm_logic_call (& mut self , request : impl tonic :: IntoRequest < super :: MsgConfirmLogicCall > ,) -> Result < tonic :: Response < super :: MsgConfirmLogicCallResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ConfirmLogicCall") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn send_to_cosmos_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgSendToCosmosClaim > ,) -> Result < tonic :: Response < super :: MsgSendToCosmosClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SendToCosmosClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_send_to_eth_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgBatchSendToEthClaim > ,) -> Result < tonic :: Response < super :: MsgBatchSendToEthClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/BatchSendToEthClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_update_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgValsetUpdatedClaim > ,) -> Result < tonic :: Response < super :: MsgValsetUpdatedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ValsetUpdateClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn erc20_deployed_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgErc20DeployedClaim > ,) -> Result < tonic :: Response < super :: MsgErc20DeployedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ERC20DeployedClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn logic_call_executed_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgLogicCallExecutedClaim > ,) -> Result < tonic :: Response < super :: MsgLogicCallExecutedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/LogicCallExecutedClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn set_orchestrator_address (& mut self , request : impl tonic :: IntoRequest < super :: MsgSetOrchestratorAddress > ,) -> Result < tonic :: Response < super :: MsgSetOrchestratorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SetOrchestratorAddress") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn cancel_send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: MsgCancelSendToEth > ,) -> Result < tonic :: Response < super :: MsgCancelSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/CancelSendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn submit_bad_signature_evidence (& mut self , request : impl tonic :: IntoRequest < super :: MsgSubmitBadSignatureEvidence > ,) -> Result < tonic :: Response < super :: MsgSubmitBadSignatureEvidenceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SubmitBadSignatureEvidence") ; self . inner . unary (request . into_request () , path , codec) . await } } impl < T : Clone > Clone for MsgClient < T > { fn clone (& self) -> Self { Self { inner : self . inner . clone () , } } } impl < T > std :: fmt :: Debug for MsgClient < T > { fn fmt (& self , f : & mut std :: fmt :: Formatter < '_ >) -> std :: fmt :: Result { write ! (f , "MsgClient {{ ... }}") } } }// Params represent the Gravity genesis and store parameters
+/// This call allows anyone to submit evidence that a validator has
+/// signed two different checkpoints for the same valset nonce, batch
+/// nonce of a token, or logic call invalidation nonce. Either of the
+/// signatures may be legitimate on its own, together they show that
+/// the Ethereum key of the validator equivocated.
+/// The subjects must both be batches, valsets, or logic calls.
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSubmitDoubleSignEvidence {
+    #[prost(message, optional, tag="1")]
+    pub subject_a: ::core::option::Option<::prost_types::Any>,
+    #[prost(string, tag="2")]
+    pub signature_a: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="3")]
+    pub subject_b: ::core::option::Option<::prost_types::Any>,
+    #[prost(string, tag="4")]
+    pub signature_b: ::prost::alloc::string::String,
+    #[prost(string, tag="5")]
+    pub sender: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgSubmitDoubleSignEvidenceResponse {
+}
+/// MsgClaimDepositEscrow
+/// this message allows the receiver of deposits from Ethereum which could not be
+/// credited to it, for example because it was a blocked address at the time, to
+/// claim the escrowed tokens once it is able to receive them
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgClaimDepositEscrow {
+    #[prost(string, tag="1")]
+    pub receiver: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct MsgClaimDepositEscrowResponse {
+}
+# [doc = r" Generated client implementations."] pub mod msg_client { # ! [allow (unused_variables , dead_code , missing_docs)] use tonic :: codegen :: * ; # [doc = " Msg defines the state transitions possible within gravity"] pub struct MsgClient < T > { inner : tonic :: client :: Grpc < T > , } impl MsgClient < tonic :: transport :: Channel > { # [doc = r" Attempt to create a new client by connecting to a given endpoint."] pub async fn connect < D > (dst : D) -> Result < Self , tonic :: transport :: Error > where D : std :: convert :: TryInto < tonic :: transport :: Endpoint > , D :: Error : Into < StdError > , { let conn = tonic :: transport :: Endpoint :: new (dst) ? . connect () . await ? ; Ok (Self :: new (conn)) } } impl < T > MsgClient < T > where T : tonic :: client :: GrpcService < tonic :: body :: BoxBody > , T :: ResponseBody : Body + HttpBody + Send + 'static , T :: Error : Into < StdError > , < T :: ResponseBody as HttpBody > :: Error : Into < StdError > + Send , { pub fn new (inner : T) -> Self { let inner = tonic :: client :: Grpc :: new (inner) ; Self { inner } } pub fn with_interceptor (inner : T , interceptor : impl Into < tonic :: Interceptor >) -> Self { let inner = tonic :: client :: Grpc :: with_interceptor (inner , interceptor) ; Self { inner } } pub async fn valset_confirm (& mut self , request : impl tonic :: IntoRequest < super :: MsgValsetConfirm > ,) -> Result < tonic :: Response < super :: MsgValsetConfirmResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ValsetConfirm") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: MsgSendToEth > ,) -> Result < tonic :: Response < super :: MsgSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn request_batch (& mut self , request : impl tonic :: IntoRequest < super :: MsgRequestBatch > ,) -> Result < tonic :: Response < super :: MsgRequestBatchResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/RequestBatch") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn confirm_batch (& mut self , request : impl tonic :: IntoRequest < super :: MsgConfirmBatch > ,) -> Result < tonic :: Response < super :: MsgConfirmBatchResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ConfirmBatch") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn confirm_logic_call (& mut self , request : impl tonic :: IntoRequest < super :: MsgConfirmLogicCall > ,) -> Result < tonic :: Response < super :: MsgConfirmLogicCallResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ConfirmLogicCall") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn send_to_cosmos_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgSendToCosmosClaim > ,) -> Result < tonic :: Response < super :: MsgSendToCosmosClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SendToCosmosClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_send_to_eth_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgBatchSendToEthClaim > ,) -> Result < tonic :: Response < super :: MsgBatchSendToEthClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/BatchSendToEthClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_update_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgValsetUpdatedClaim > ,) -> Result < tonic :: Response < super :: MsgValsetUpdatedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ValsetUpdateClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn erc20_deployed_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgErc20DeployedClaim > ,) -> Result < tonic :: Response < super :: MsgErc20DeployedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ERC20DeployedClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn logic_call_executed_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgLogicCallExecutedClaim > ,) -> Result < tonic :: Response < super :: MsgLogicCallExecutedClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/LogicCallExecutedClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn ethereum_height_claim (& mut self , request : impl tonic :: IntoRequest < super :: MsgEthereumHeightClaim > ,) -> Result < tonic :: Response < super :: MsgEthereumHeightClaimResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/EthereumHeightClaim") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn set_orchestrator_address (& mut self , request : impl tonic :: IntoRequest < super :: MsgSetOrchestratorAddress > ,) -> Result < tonic :: Response < super :: MsgSetOrchestratorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SetOrchestratorAddress") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn rotate_delegate_keys (& mut self , request : impl tonic :: IntoRequest < super :: MsgRotateDelegateKeys > ,) -> Result < tonic :: Response < super :: MsgRotateDelegateKeysResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/RotateDelegateKeys") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn cancel_send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: MsgCancelSendToEth > ,) -> Result < tonic :: Response < super :: MsgCancelSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/CancelSendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn submit_bad_signature_evidence (& mut self , request : impl tonic :: IntoRequest < super :: MsgSubmitBadSignatureEvidence > ,) -> Result < tonic :: Response < super :: MsgSubmitBadSignatureEvidenceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SubmitBadSignatureEvidence") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn claim_deposit_escrow (& mut self , request : impl tonic :: IntoRequest < super :: MsgClaimDepositEscrow > ,) -> Result < tonic :: Response < super :: MsgClaimDepositEscrowResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/ClaimDepositEscrow") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn submit_double_sign_evidence (& mut self , request : impl tonic :: IntoRequest < super :: MsgSubmitDoubleSignEvidence > ,) -> Result < tonic :: Response < super :: MsgSubmitDoubleSignEvidenceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Msg/SubmitDoubleSignEvidence") ; self . inner . unary (request . into_request () , path , codec) . await } } impl < T : Clone > Clone for MsgClient < T > { fn clone (& self) -> Self { Self { inner : self . inner . clone () , } } } impl < T > std :: fmt :: Debug for MsgClient < T > { fn fmt (& self , f : & mut std :: fmt :: Formatter < '_ >) -> std :: fmt :: Result { write ! (f , "MsgClient {{ ... }}") } } }/// IDSet represents a set of IDs
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct IdSet {
+    #[prost(uint64, repeated, tag="1")]
+    pub ids: ::prost::alloc::vec::Vec<u64>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BatchFees {
+    #[prost(string, tag="1")]
+    pub token: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub total_fees: ::prost::alloc::string::String,
+}
+/// TransferStatus records the lifecycle of an outgoing transfer, the record is
+/// kept for transfer_status_retention_blocks after the transfer was executed or cancelled
+/// batch_nonce: the nonce of the batch the transfer is in, or was last in
+/// times_requeued: how many times a batch containing the transfer was cancelled or timed out
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TransferStatus {
+    #[prost(uint64, tag="1")]
+    pub id: u64,
+    #[prost(enumeration="TransferState", tag="2")]
+    pub state: i32,
+    #[prost(string, tag="3")]
+    pub sender: ::prost::alloc::string::String,
+    #[prost(string, tag="4")]
+    pub dest_address: ::prost::alloc::string::String,
+    #[prost(string, tag="5")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(uint64, tag="6")]
+    pub batch_nonce: u64,
+    #[prost(uint64, tag="7")]
+    pub times_requeued: u64,
+    #[prost(uint64, tag="8")]
+    pub created_height: u64,
+    #[prost(uint64, tag="9")]
+    pub updated_height: u64,
+}
+/// TransferState is the lifecycle stage an outgoing transfer has reached
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum TransferState {
+    Unspecified = 0,
+    /// the transfer is waiting in the pool to be batched, this is also the
+    /// state of a transfer whose batch was cancelled or timed out
+    Pooled = 1,
+    /// the transfer is in a batch waiting to be executed on Ethereum
+    Batched = 2,
+    /// the batch containing the transfer was executed on Ethereum
+    Executed = 3,
+    /// the transfer was removed from the pool and refunded to the sender
+    Cancelled = 4,
+}
+// Params represent the Gravity genesis and store parameters
 // gravity_id:
 // a random 32 byte value to prevent signature reuse, for example if the
 // cosmos validators decided to use the same Ethereum keys for another chain
@@ -534,6 +942,92 @@ pub struct MsgSubmitBadSignatureEvidenceResponse {
 /// set and steal funds on Ethereum without consequence.
 /// The practical outcome of this flag being set to 'false' is that deposits from Ethereum will not show up and withdraws from
 /// Cosmos will not execute on Ethereum.
+///
+/// transfer_status_retention_blocks
+///
+/// The number of blocks the status of an outgoing transfer is kept for after the transfer has been executed
+/// on Ethereum or cancelled, zero keeps the status of every transfer forever.
+///
+/// attestation_power_policy
+///
+/// Selects whether attestations are tallied with the power each voter held when their vote was cast
+/// (snapshot) or with the power they hold at tally time (current). Snapshots keep the oracle threshold
+/// predictable when a lot of stake moves between the vote and the tally, for example during large redelegations.
+///
+/// attestation_thresholds
+///
+/// The share of the voting power which must vote for an attestation of a claim type before it is observed, each
+/// threshold must be above 1/2. Claim types without a threshold use the default of 66%. This allows governance to
+/// require more votes for events like validator set updates than for routine ones. For the Ethereum height
+/// heartbeat it is the share of the voting power which must have claimed a height before it is observed.
+///
+/// oracle_liveness_window
+/// slash_fraction_oracle_liveness
+///
+/// A validator which has not submitted a claim for an event that was observed more than oracle_liveness_window
+/// blocks after it was first claimed is slashed by slash_fraction_oracle_liveness and jailed, once for every time
+/// it falls behind. Unbonding validators are held to this for unbond_slashing_valsets_window blocks after they
+/// started unbonding. A window of zero disables oracle liveness slashing.
+///
+/// slash_fraction_conflicting_claim
+///
+/// The fraction a validator is slashed by for voting for an attestation which lost to a different attestation at
+/// the same event nonce, the validator is not jailed since this is usually caused by a faulty Ethereum node.
+///
+/// confirm_signing_window
+/// min_signed_per_window
+///
+/// Like the x/slashing liveness window, every valset, batch and logic call a validator is required to confirm
+/// is counted in a window of the last confirm_signing_window signing requests. A validator is only slashed and
+/// jailed for a missed confirmation once it has signed less than min_signed_per_window of its window, after
+/// which its window starts over. A window of zero slashes a validator for every missed confirmation.
+///
+/// bad_signature_evidence_bounty_fraction
+/// bad_signature_evidence_bounty
+///
+/// The bounty paid to the sender of bad signature evidence which slashed a validator, so that outside watchers
+/// have a reason to submit it. The sender receives bad_signature_evidence_bounty_fraction of the stake slashed
+/// from the validator, which is minted back out of the burned stake, and bad_signature_evidence_bounty from the
+/// community pool if the pool can afford it. As with valset_reward a coin with a blank denom or zero amount pays
+/// no fixed bounty.
+///
+/// ethereum_height_history_blocks
+///
+/// The number of Cosmos blocks the observed Ethereum heights are kept for. The real average Cosmos and Ethereum
+/// block times are computed from the oldest and newest observation in this window and used in place of
+/// average_block_time and average_ethereum_block_time to project batch timeouts. Zero disables the calibration.
+///
+/// min_average_block_time
+/// max_average_block_time
+/// min_average_ethereum_block_time
+/// max_average_ethereum_block_time
+///
+/// The bounds in milliseconds the calibrated average block times are clamped to, so that a burst of empty
+/// blocks or an Ethereum outage can not push batch timeouts arbitrarily far into the future or the past.
+///
+/// batch_size_limits
+///
+/// The largest number of transactions a batch of a token may contain, tokens without a limit are batched 100
+/// transactions at a time. Tokens which are expensive to transfer on Ethereum may need smaller batches to stay
+/// under the block gas limit.
+///
+/// batch_selection_policy
+/// batch_age_weight
+///
+/// Selects the order transactions are taken from the pool in when a batch is built, and which batch is considered
+/// more profitable than the last one. Fee descending takes the highest fees first, FIFO takes the transactions in
+/// the order they were sent. Age weighted ranks transactions by their fee times 1 + batch_age_weight for every
+/// block they have waited in the pool, so that low fee transfers are eventually batched instead of starving.
+///
+/// auto_batch_fee_thresholds
+/// auto_batch_max_tx_age
+///
+/// Batches are normally only built when a relayer sends MsgRequestBatch. At the end of every block a batch of a
+/// token is also built once the fees of the batch it would have reach its auto_batch_fee_threshold, or once its
+/// oldest pooled transfer was sent more than auto_batch_max_tx_age blocks ago, so that tokens without an active
+/// relayer do not sit in the pool forever. As with MsgRequestBatch a batch is only built if it would be more
+/// profitable than the last batch of the token. Tokens without a threshold and a max age of zero are never
+/// batched automatically.
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct Params {
     #[prost(string, tag="1")]
@@ -574,6 +1068,55 @@ pub struct Params {
     pub reset_bridge_nonce: u64,
     #[prost(bool, tag="20")]
     pub bridge_active: bool,
+    #[prost(uint64, tag="21")]
+    pub transfer_status_retention_blocks: u64,
+    #[prost(enumeration="AttestationPowerPolicy", tag="22")]
+    pub attestation_power_policy: i32,
+    #[prost(message, repeated, tag="23")]
+    pub attestation_thresholds: ::prost::alloc::vec::Vec<ClaimTypeThreshold>,
+    #[prost(uint64, tag="24")]
+    pub oracle_liveness_window: u64,
+    #[prost(bytes="vec", tag="25")]
+    pub slash_fraction_oracle_liveness: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes="vec", tag="26")]
+    pub slash_fraction_conflicting_claim: ::prost::alloc::vec::Vec<u8>,
+    #[prost(uint64, tag="27")]
+    pub confirm_signing_window: u64,
+    #[prost(bytes="vec", tag="28")]
+    pub min_signed_per_window: ::prost::alloc::vec::Vec<u8>,
+    #[prost(bytes="vec", tag="29")]
+    pub bad_signature_evidence_bounty_fraction: ::prost::alloc::vec::Vec<u8>,
+    #[prost(message, optional, tag="30")]
+    pub bad_signature_evidence_bounty: ::core::option::Option<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(uint64, tag="31")]
+    pub ethereum_height_history_blocks: u64,
+    #[prost(uint64, tag="32")]
+    pub min_average_block_time: u64,
+    #[prost(uint64, tag="33")]
+    pub max_average_block_time: u64,
+    #[prost(uint64, tag="34")]
+    pub min_average_ethereum_block_time: u64,
+    #[prost(uint64, tag="35")]
+    pub max_average_ethereum_block_time: u64,
+    #[prost(message, repeated, tag="36")]
+    pub batch_size_limits: ::prost::alloc::vec::Vec<BatchSizeLimit>,
+    #[prost(enumeration="BatchSelectionPolicy", tag="37")]
+    pub batch_selection_policy: i32,
+    #[prost(bytes="vec", tag="38")]
+    pub batch_age_weight: ::prost::alloc::vec::Vec<u8>,
+    #[prost(message, repeated, tag="39")]
+    pub auto_batch_fee_thresholds: ::prost::alloc::vec::Vec<AutoBatchFeeThreshold>,
+    #[prost(uint64, tag="40")]
+    pub auto_batch_max_tx_age: u64,
+}
+/// ClaimTypeThreshold is the share of the voting power required to observe an
+/// attestation of a claim type
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ClaimTypeThreshold {
+    #[prost(enumeration="ClaimType", tag="1")]
+    pub claim_type: i32,
+    #[prost(bytes="vec", tag="2")]
+    pub threshold: ::prost::alloc::vec::Vec<u8>,
 }
 /// GenesisState struct
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -602,19 +1145,91 @@ pub struct GenesisState {
     pub erc20_to_denoms: ::prost::alloc::vec::Vec<Erc20ToDenom>,
     #[prost(message, repeated, tag="12")]
     pub unbatched_transfers: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
+    #[prost(message, optional, tag="13")]
+    pub gravity_nonces: ::core::option::Option<GravityNonces>,
+    #[prost(message, repeated, tag="14")]
+    pub last_event_nonces: ::prost::alloc::vec::Vec<LastEventNonceByValidator>,
+    #[prost(message, optional, tag="15")]
+    pub last_observed_ethereum_height: ::core::option::Option<LastObservedEthereumBlockHeight>,
+    #[prost(message, optional, tag="16")]
+    pub last_observed_valset: ::core::option::Option<Valset>,
+    #[prost(bytes="vec", repeated, tag="17")]
+    pub past_eth_signature_checkpoints: ::prost::alloc::vec::Vec<::prost::alloc::vec::Vec<u8>>,
+    #[prost(message, repeated, tag="18")]
+    pub valset_hijack_incidents: ::prost::alloc::vec::Vec<ValsetHijackIncident>,
+    #[prost(message, repeated, tag="19")]
+    pub past_delegate_keys: ::prost::alloc::vec::Vec<PastDelegateKey>,
+    #[prost(message, repeated, tag="20")]
+    pub transfer_statuses: ::prost::alloc::vec::Vec<TransferStatus>,
+    #[prost(message, repeated, tag="21")]
+    pub deposit_records: ::prost::alloc::vec::Vec<DepositRecord>,
+    #[prost(message, repeated, tag="22")]
+    pub deposit_escrows: ::prost::alloc::vec::Vec<DepositEscrow>,
+    #[prost(message, repeated, tag="23")]
+    pub bridge_escrow: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+    #[prost(message, repeated, tag="24")]
+    pub oracle_liveness_slashes: ::prost::alloc::vec::Vec<LastEventNonceByValidator>,
+    #[prost(message, repeated, tag="25")]
+    pub conflicting_claims: ::prost::alloc::vec::Vec<ConflictingClaim>,
+    #[prost(message, repeated, tag="26")]
+    pub confirm_signing_infos: ::prost::alloc::vec::Vec<ConfirmSigningInfo>,
+    #[prost(bytes="vec", repeated, tag="27")]
+    pub bad_signature_evidence: ::prost::alloc::vec::Vec<::prost::alloc::vec::Vec<u8>>,
+    #[prost(message, repeated, tag="28")]
+    pub slash_records: ::prost::alloc::vec::Vec<SlashRecord>,
+    #[prost(message, repeated, tag="29")]
+    pub ethereum_height_votes: ::prost::alloc::vec::Vec<EthereumHeightVote>,
+    #[prost(message, repeated, tag="30")]
+    pub ethereum_height_samples: ::prost::alloc::vec::Vec<EthereumHeightSample>,
+    #[prost(message, repeated, tag="31")]
+    pub transfer_minimums: ::prost::alloc::vec::Vec<TransferMinimum>,
+}
+/// GravityNonces holds the counters and cursors of the gravity module, these
+/// are exported so that a restarted chain does not reuse ids or slash twice
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct GravityNonces {
+    /// the nonce of the last valset request created
+    #[prost(uint64, tag="1")]
+    pub latest_valset_nonce: u64,
+    /// the last valset nonce slashed for missing signatures
+    #[prost(uint64, tag="2")]
+    pub last_slashed_valset_nonce: u64,
+    /// the last batch block height slashed for missing signatures
+    #[prost(uint64, tag="3")]
+    pub last_slashed_batch_block: u64,
+    /// the last logic call block height slashed for missing signatures
+    #[prost(uint64, tag="4")]
+    pub last_slashed_logic_call_block: u64,
+    /// the block height a validator last started unbonding at
+    #[prost(uint64, tag="5")]
+    pub last_unbonding_block_height: u64,
+    /// the last id assigned to a transfer in the outgoing pool
+    #[prost(uint64, tag="6")]
+    pub last_tx_pool_id: u64,
+    /// the last nonce assigned to an outgoing batch
+    #[prost(uint64, tag="7")]
+    pub last_batch_id: u64,
 }
-/// IDSet represents a set of IDs
+/// LastEventNonceByValidator records the last Ethereum event nonce
+/// a validator has submitted a claim for, in oracle_liveness_slashes it
+/// records the event nonce a validator was last slashed for not claiming
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct IdSet {
-    #[prost(uint64, repeated, tag="1")]
-    pub ids: ::prost::alloc::vec::Vec<u64>,
+pub struct LastEventNonceByValidator {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(uint64, tag="2")]
+    pub event_nonce: u64,
 }
+/// PastDelegateKey records a delegate key that has been replaced by
+/// MsgRotateDelegateKeys, only one of orchestrator or eth_address is set
 #[derive(Clone, PartialEq, ::prost::Message)]
-pub struct BatchFees {
+pub struct PastDelegateKey {
     #[prost(string, tag="1")]
-    pub token: ::prost::alloc::string::String,
+    pub validator: ::prost::alloc::string::String,
     #[prost(string, tag="2")]
-    pub total_fees: ::prost::alloc::string::String,
+    pub orchestrator: ::prost::alloc::string::String,
+    #[prost(string, tag="3")]
+    pub eth_address: ::prost::alloc::string::String,
 }
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct QueryParamsRequest {
@@ -854,4 +1469,257 @@ pub struct QueryPendingSendToEthResponse {
     #[prost(message, repeated, tag="2")]
     pub unbatched_transfers: ::prost::alloc::vec::Vec<OutgoingTransferTx>,
 }
-# [doc = r" Generated client implementations."] pub mod query_client { # ! [allow (unused_variables , dead_code , missing_docs)] use tonic :: codegen :: * ; # [doc = " Query defines the gRPC querier service"] pub struct QueryClient < T > { inner : tonic :: client :: Grpc < T > , } impl QueryClient < tonic :: transport :: Channel > { # [doc = r" Attempt to create a new client by connecting to a given endpoint."] pub async fn connect < D > (dst : D) -> Result < Self , tonic :: transport :: Error > where D : std :: convert :: TryInto < tonic :: transport :: Endpoint > , D :: Error : Into < StdError > , { let conn = tonic :: transport :: Endpoint :: new (dst) ? . connect () . await ? ; Ok (Self :: new (conn)) } } impl < T > QueryClient < T > where T : tonic :: client :: GrpcService < tonic :: body :: BoxBody > , T :: ResponseBody : Body + HttpBody + Send + 'static , T :: Error : Into < StdError > , < T :: ResponseBody as HttpBody > :: Error : Into < StdError > + Send , { pub fn new (inner : T) -> Self { let inner = tonic :: client :: Grpc :: new (inner) ; Self { inner } } pub fn with_interceptor (inner : T , interceptor : impl Into < tonic :: Interceptor >) -> Self { let inner = tonic :: client :: Grpc :: with_interceptor (inner , interceptor) ; Self { inner } } # [doc = " Deployments queries deployments"] pub async fn params (& mut self , request : impl tonic :: IntoRequest < super :: QueryParamsRequest > ,) -> Result < tonic :: Response < super :: QueryParamsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/Params") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn current_valset (& mut self , request : impl tonic :: IntoRequest < super :: QueryCurrentValsetRequest > ,) -> Result < tonic :: Response < super :: QueryCurrentValsetResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/CurrentValset") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_request (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetRequestRequest > ,) -> Result < tonic :: Response < super :: QueryValsetRequestResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetRequest") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_confirm (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetConfirmRequest > ,) -> Result < tonic :: Response < super :: QueryValsetConfirmResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetConfirm") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_confirms_by_nonce (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetConfirmsByNonceRequest > ,) -> Result < tonic :: Response < super :: QueryValsetConfirmsByNonceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetConfirmsByNonce") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_valset_requests (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastValsetRequestsRequest > ,) -> Result < tonic :: Response < super :: QueryLastValsetRequestsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastValsetRequests") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_valset_request_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingValsetRequestByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingValsetRequestByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingValsetRequestByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_batch_request_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingBatchRequestByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingBatchRequestByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingBatchRequestByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_logic_call_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingLogicCallByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingLogicCallByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingLogicCallByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_event_nonce_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastEventNonceByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastEventNonceByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastEventNonceByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_fees (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchFeeRequest > ,) -> Result < tonic :: Response < super :: QueryBatchFeeResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchFees") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn outgoing_tx_batches (& mut self , request : impl tonic :: IntoRequest < super :: QueryOutgoingTxBatchesRequest > ,) -> Result < tonic :: Response < super :: QueryOutgoingTxBatchesResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OutgoingTxBatches") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn outgoing_logic_calls (& mut self , request : impl tonic :: IntoRequest < super :: QueryOutgoingLogicCallsRequest > ,) -> Result < tonic :: Response < super :: QueryOutgoingLogicCallsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OutgoingLogicCalls") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_request_by_nonce (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchRequestByNonceRequest > ,) -> Result < tonic :: Response < super :: QueryBatchRequestByNonceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchRequestByNonce") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_confirms (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchConfirmsRequest > ,) -> Result < tonic :: Response < super :: QueryBatchConfirmsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchConfirms") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn logic_confirms (& mut self , request : impl tonic :: IntoRequest < super :: QueryLogicConfirmsRequest > ,) -> Result < tonic :: Response < super :: QueryLogicConfirmsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LogicConfirms") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn erc20_to_denom (& mut self , request : impl tonic :: IntoRequest < super :: QueryErc20ToDenomRequest > ,) -> Result < tonic :: Response < super :: QueryErc20ToDenomResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ERC20ToDenom") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn denom_to_erc20 (& mut self , request : impl tonic :: IntoRequest < super :: QueryDenomToErc20Request > ,) -> Result < tonic :: Response < super :: QueryDenomToErc20Response > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/DenomToERC20") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_attestations (& mut self , request : impl tonic :: IntoRequest < super :: QueryAttestationsRequest > ,) -> Result < tonic :: Response < super :: QueryAttestationsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetAttestations") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_validator (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByValidatorAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByValidatorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByValidator") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_eth (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByEthAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByEthAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_orchestrator (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByOrchestratorAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByOrchestratorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByOrchestrator") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_pending_send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: QueryPendingSendToEth > ,) -> Result < tonic :: Response < super :: QueryPendingSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetPendingSendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } } impl < T : Clone > Clone for QueryClient < T > { fn clone (& self) -> Self { Self { inner : self . inner . clone () , } } } impl < T > std :: fmt :: Debug for QueryClient < T > { fn fmt (& self , f : & mut std :: fmt :: Formatter < '_ >) -> std :: fmt :: Result { write ! (f , "QueryClient {{ ... }}") } } }
\ No newline at end of file
+/// PendingTransfer is an outgoing transfer that has not been executed on Ethereum,
+/// batch_nonce is zero while the transfer is waiting in the pool
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PendingTransfer {
+    #[prost(message, optional, tag="1")]
+    pub transfer: ::core::option::Option<OutgoingTransferTx>,
+    #[prost(uint64, tag="2")]
+    pub batch_nonce: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryTransfersBySenderRequest {
+    #[prost(string, tag="1")]
+    pub sender_address: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryTransfersBySenderResponse {
+    #[prost(message, repeated, tag="1")]
+    pub transfers: ::prost::alloc::vec::Vec<PendingTransfer>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryTransfersByDestinationRequest {
+    #[prost(string, tag="1")]
+    pub eth_address: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryTransfersByDestinationResponse {
+    #[prost(message, repeated, tag="1")]
+    pub transfers: ::prost::alloc::vec::Vec<PendingTransfer>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryTransferStatusRequest {
+    #[prost(uint64, tag="1")]
+    pub tx_id: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryTransferStatusResponse {
+    #[prost(message, optional, tag="1")]
+    pub status: ::core::option::Option<TransferStatus>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryDepositsByReceiverRequest {
+    #[prost(string, tag="1")]
+    pub receiver_address: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryDepositsByReceiverResponse {
+    #[prost(message, repeated, tag="1")]
+    pub deposits: ::prost::alloc::vec::Vec<DepositRecord>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryDepositsBySenderRequest {
+    #[prost(string, tag="1")]
+    pub eth_address: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryDepositsBySenderResponse {
+    #[prost(message, repeated, tag="1")]
+    pub deposits: ::prost::alloc::vec::Vec<DepositRecord>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryDepositsByTokenRequest {
+    #[prost(string, tag="1")]
+    pub token_contract: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryDepositsByTokenResponse {
+    #[prost(message, repeated, tag="1")]
+    pub deposits: ::prost::alloc::vec::Vec<DepositRecord>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryDepositEscrowsRequest {
+    #[prost(string, tag="1")]
+    pub receiver_address: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryDepositEscrowsResponse {
+    #[prost(message, repeated, tag="1")]
+    pub escrows: ::prost::alloc::vec::Vec<DepositEscrow>,
+}
+/// QueryBridgeEscrowRequest queries the supply of Cosmos originated tokens which is
+/// locked in the module account while it exists on Ethereum, an empty denom
+/// returns every Cosmos originated denom
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeEscrowRequest {
+    #[prost(string, tag="1")]
+    pub denom: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryBridgeEscrowResponse {
+    #[prost(message, repeated, tag="1")]
+    pub escrow: ::prost::alloc::vec::Vec<cosmos_sdk_proto::cosmos::base::v1beta1::Coin>,
+}
+/// QueryAttestationVoteWeightsRequest queries the weight of the votes on the
+/// attestations at an event nonce
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryAttestationVoteWeightsRequest {
+    #[prost(uint64, tag="1")]
+    pub event_nonce: u64,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryAttestationVoteWeightsResponse {
+    #[prost(message, repeated, tag="1")]
+    pub attestations: ::prost::alloc::vec::Vec<AttestationVoteWeights>,
+    #[prost(string, tag="2")]
+    pub current_total_power: ::prost::alloc::string::String,
+    #[prost(enumeration="AttestationPowerPolicy", tag="3")]
+    pub policy: i32,
+}
+/// AttestationVoteWeights shows the votes on an attestation, the snapshot and
+/// current weights are the share of the total power which voted for it under
+/// each AttestationPowerPolicy and the threshold is the share required for the
+/// attestation's claim type
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct AttestationVoteWeights {
+    #[prost(string, tag="1")]
+    pub claim_hash: ::prost::alloc::string::String,
+    #[prost(bool, tag="2")]
+    pub observed: bool,
+    #[prost(message, repeated, tag="3")]
+    pub votes: ::prost::alloc::vec::Vec<VoteWeight>,
+    #[prost(string, tag="4")]
+    pub snapshot_weight: ::prost::alloc::string::String,
+    #[prost(string, tag="5")]
+    pub current_weight: ::prost::alloc::string::String,
+    #[prost(string, tag="6")]
+    pub threshold: ::prost::alloc::string::String,
+}
+/// VoteWeight shows the power of a voter when they voted and now
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct VoteWeight {
+    #[prost(string, tag="1")]
+    pub validator: ::prost::alloc::string::String,
+    #[prost(int64, tag="2")]
+    pub snapshot_power: i64,
+    #[prost(string, tag="3")]
+    pub snapshot_total_power: ::prost::alloc::string::String,
+    #[prost(int64, tag="4")]
+    pub current_power: i64,
+}
+/// QueryConflictingClaimsRequest queries the claims of a validator which lost to
+/// a different claim at the same event nonce, an empty validator_address returns
+/// the conflicting claims of every validator
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryConflictingClaimsRequest {
+    #[prost(string, tag="1")]
+    pub validator_address: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryConflictingClaimsResponse {
+    #[prost(message, repeated, tag="1")]
+    pub conflicting_claims: ::prost::alloc::vec::Vec<ConflictingClaim>,
+}
+/// QueryConfirmSigningInfosRequest queries the confirmations a validator has
+/// missed in its signing window, an empty validator_address returns the signing
+/// info of every validator
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryConfirmSigningInfosRequest {
+    #[prost(string, tag="1")]
+    pub validator_address: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryConfirmSigningInfosResponse {
+    #[prost(message, repeated, tag="1")]
+    pub infos: ::prost::alloc::vec::Vec<ConfirmSigningInfo>,
+    #[prost(uint64, tag="2")]
+    pub confirm_signing_window: u64,
+    #[prost(bytes="vec", tag="3")]
+    pub min_signed_per_window: ::prost::alloc::vec::Vec<u8>,
+}
+/// QuerySlashingHistoryByValidatorRequest queries the gravity specific slashes
+/// of a validator in the order they happened
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySlashingHistoryByValidatorRequest {
+    #[prost(string, tag="1")]
+    pub validator_address: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySlashingHistoryByValidatorResponse {
+    #[prost(message, repeated, tag="1")]
+    pub slashes: ::prost::alloc::vec::Vec<SlashRecord>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+/// QuerySlashingHistoryByReasonRequest queries the gravity specific slashes
+/// for a reason in the order they happened
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySlashingHistoryByReasonRequest {
+    #[prost(enumeration="SlashReason", tag="1")]
+    pub reason: i32,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageRequest>,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QuerySlashingHistoryByReasonResponse {
+    #[prost(message, repeated, tag="1")]
+    pub slashes: ::prost::alloc::vec::Vec<SlashRecord>,
+    #[prost(message, optional, tag="2")]
+    pub pagination: ::core::option::Option<cosmos_sdk_proto::cosmos::base::query::v1beta1::PageResponse>,
+}
+/// QueryEstimatedEthereumHeightRequest queries the current Ethereum block
+/// height projected from the last observed Ethereum height using the average
+/// block times batch timeouts are computed with
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryEstimatedEthereumHeightRequest {
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryEstimatedEthereumHeightResponse {
+    #[prost(uint64, tag="1")]
+    pub estimated_ethereum_height: u64,
+    #[prost(message, optional, tag="2")]
+    pub last_observed_ethereum_height: ::core::option::Option<LastObservedEthereumBlockHeight>,
+    /// the average Cosmos block time in milliseconds
+    #[prost(uint64, tag="3")]
+    pub average_block_time: u64,
+    /// the average Ethereum block time in milliseconds
+    #[prost(uint64, tag="4")]
+    pub average_ethereum_block_time: u64,
+}
+/// QueryTransferMinimumsRequest queries the smallest amount and bridge fee a
+/// MsgSendToEth of a denom may carry, an empty denom returns the minimums of
+/// every denom
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryTransferMinimumsRequest {
+    #[prost(string, tag="1")]
+    pub denom: ::prost::alloc::string::String,
+}
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct QueryTransferMinimumsResponse {
+    #[prost(message, repeated, tag="1")]
+    pub minimums: ::prost::alloc::vec::Vec<TransferMinimum>,
+}
+# [doc = r" Generated client implementations."] pub mod query_client { # ! [allow (unused_variables , dead_code , missing_docs)] use tonic :: codegen :: * ; # [doc = " Query defines the gRPC querier service"] pub struct QueryClient < T > { inner : tonic :: client :: Grpc < T > , } impl QueryClient < tonic :: transport :: Channel > { # [doc = r" Attempt to create a new client by connecting to a given endpoint."] pub async fn connect < D > (dst : D) -> Result < Self , tonic :: transport :: Error > where D : std :: convert :: TryInto < tonic :: transport :: Endpoint > , D :: Error : Into < StdError > , { let conn = tonic :: transport :: Endpoint :: new (dst) ? . connect () . await ? ; Ok (Self :: new (conn)) } } impl < T > QueryClient < T > where T : tonic :: client :: GrpcService < tonic :: body :: BoxBody > , T :: ResponseBody : Body + HttpBody + Send + 'static , T :: Error : Into < StdError > , < T :: ResponseBody as HttpBody > :: Error : Into < StdError > + Send , { pub fn new (inner : T) -> Self { let inner = tonic :: client :: Grpc :: new (inner) ; Self { inner } } pub fn with_interceptor (inner : T , interceptor : impl Into < tonic :: Interceptor >) -> Self { let inner = tonic :: client :: Grpc :: with_interceptor (inner , interceptor) ; Self { inner } } # [doc = " Deployments queries deployments"] pub async fn params (& mut self , request : impl tonic :: IntoRequest < super :: QueryParamsRequest > ,) -> Result < tonic :: Response < super :: QueryParamsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/Params") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn current_valset (& mut self , request : impl tonic :: IntoRequest < super :: QueryCurrentValsetRequest > ,) -> Result < tonic :: Response < super :: QueryCurrentValsetResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/CurrentValset") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_request (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetRequestRequest > ,) -> Result < tonic :: Response < super :: QueryValsetRequestResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetRequest") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_confirm (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetConfirmRequest > ,) -> Result < tonic :: Response < super :: QueryValsetConfirmResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetConfirm") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn valset_confirms_by_nonce (& mut self , request : impl tonic :: IntoRequest < super :: QueryValsetConfirmsByNonceRequest > ,) -> Result < tonic :: Response < super :: QueryValsetConfirmsByNonceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ValsetConfirmsByNonce") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_valset_requests (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastValsetRequestsRequest > ,) -> Result < tonic :: Response < super :: QueryLastValsetRequestsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastValsetRequests") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_valset_request_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingValsetRequestByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingValsetRequestByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingValsetRequestByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_batch_request_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingBatchRequestByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingBatchRequestByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingBatchRequestByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_pending_logic_call_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastPendingLogicCallByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastPendingLogicCallByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastPendingLogicCallByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn last_event_nonce_by_addr (& mut self , request : impl tonic :: IntoRequest < super :: QueryLastEventNonceByAddrRequest > ,) -> Result < tonic :: Response < super :: QueryLastEventNonceByAddrResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LastEventNonceByAddr") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_fees (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchFeeRequest > ,) -> Result < tonic :: Response < super :: QueryBatchFeeResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchFees") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn outgoing_tx_batches (& mut self , request : impl tonic :: IntoRequest < super :: QueryOutgoingTxBatchesRequest > ,) -> Result < tonic :: Response < super :: QueryOutgoingTxBatchesResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OutgoingTxBatches") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn outgoing_logic_calls (& mut self , request : impl tonic :: IntoRequest < super :: QueryOutgoingLogicCallsRequest > ,) -> Result < tonic :: Response < super :: QueryOutgoingLogicCallsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/OutgoingLogicCalls") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_request_by_nonce (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchRequestByNonceRequest > ,) -> Result < tonic :: Response < super :: QueryBatchRequestByNonceResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchRequestByNonce") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn batch_confirms (& mut self , request : impl tonic :: IntoRequest < super :: QueryBatchConfirmsRequest > ,) -> Result < tonic :: Response < super :: QueryBatchConfirmsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BatchConfirms") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn logic_confirms (& mut self , request : impl tonic :: IntoRequest < super :: QueryLogicConfirmsRequest > ,) -> Result < tonic :: Response < super :: QueryLogicConfirmsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/LogicConfirms") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn erc20_to_denom (& mut self , request : impl tonic :: IntoRequest < super :: QueryErc20ToDenomRequest > ,) -> Result < tonic :: Response < super :: QueryErc20ToDenomResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ERC20ToDenom") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn denom_to_erc20 (& mut self , request : impl tonic :: IntoRequest < super :: QueryDenomToErc20Request > ,) -> Result < tonic :: Response < super :: QueryDenomToErc20Response > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/DenomToERC20") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_attestations (& mut self , request : impl tonic :: IntoRequest < super :: QueryAttestationsRequest > ,) -> Result < tonic :: Response < super :: QueryAttestationsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetAttestations") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_validator (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByValidatorAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByValidatorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByValidator") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_eth (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByEthAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByEthAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_delegate_key_by_orchestrator (& mut self , request : impl tonic :: IntoRequest < super :: QueryDelegateKeysByOrchestratorAddress > ,) -> Result < tonic :: Response < super :: QueryDelegateKeysByOrchestratorAddressResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetDelegateKeyByOrchestrator") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_pending_send_to_eth (& mut self , request : impl tonic :: IntoRequest < super :: QueryPendingSendToEth > ,) -> Result < tonic :: Response < super :: QueryPendingSendToEthResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetPendingSendToEth") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_transfers_by_sender (& mut self , request : impl tonic :: IntoRequest < super :: QueryTransfersBySenderRequest > ,) -> Result < tonic :: Response < super :: QueryTransfersBySenderResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetTransfersBySender") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn get_transfers_by_destination (& mut self , request : impl tonic :: IntoRequest < super :: QueryTransfersByDestinationRequest > ,) -> Result < tonic :: Response < super :: QueryTransfersByDestinationResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/GetTransfersByDestination") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn transfer_status (& mut self , request : impl tonic :: IntoRequest < super :: QueryTransferStatusRequest > ,) -> Result < tonic :: Response < super :: QueryTransferStatusResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/TransferStatus") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn deposits_by_receiver (& mut self , request : impl tonic :: IntoRequest < super :: QueryDepositsByReceiverRequest > ,) -> Result < tonic :: Response < super :: QueryDepositsByReceiverResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/DepositsByReceiver") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn deposits_by_sender (& mut self , request : impl tonic :: IntoRequest < super :: QueryDepositsBySenderRequest > ,) -> Result < tonic :: Response < super :: QueryDepositsBySenderResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/DepositsBySender") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn deposits_by_token (& mut self , request : impl tonic :: IntoRequest < super :: QueryDepositsByTokenRequest > ,) -> Result < tonic :: Response < super :: QueryDepositsByTokenResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/DepositsByToken") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn deposit_escrows (& mut self , request : impl tonic :: IntoRequest < super :: QueryDepositEscrowsRequest > ,) -> Result < tonic :: Response < super :: QueryDepositEscrowsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/DepositEscrows") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn bridge_escrow (& mut self , request : impl tonic :: IntoRequest < super :: QueryBridgeEscrowRequest > ,) -> Result < tonic :: Response < super :: QueryBridgeEscrowResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/BridgeEscrow") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn attestation_vote_weights (& mut self , request : impl tonic :: IntoRequest < super :: QueryAttestationVoteWeightsRequest > ,) -> Result < tonic :: Response < super :: QueryAttestationVoteWeightsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/AttestationVoteWeights") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn conflicting_claims (& mut self , request : impl tonic :: IntoRequest < super :: QueryConflictingClaimsRequest > ,) -> Result < tonic :: Response < super :: QueryConflictingClaimsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ConflictingClaims") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn confirm_signing_infos (& mut self , request : impl tonic :: IntoRequest < super :: QueryConfirmSigningInfosRequest > ,) -> Result < tonic :: Response < super :: QueryConfirmSigningInfosResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/ConfirmSigningInfos") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn slashing_history_by_validator (& mut self , request : impl tonic :: IntoRequest < super :: QuerySlashingHistoryByValidatorRequest > ,) -> Result < tonic :: Response < super :: QuerySlashingHistoryByValidatorResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/SlashingHistoryByValidator") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn slashing_history_by_reason (& mut self , request : impl tonic :: IntoRequest < super :: QuerySlashingHistoryByReasonRequest > ,) -> Result < tonic :: Response < super :: QuerySlashingHistoryByReasonResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/SlashingHistoryByReason") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn estimated_ethereum_height (& mut self , request : impl tonic :: IntoRequest < super :: QueryEstimatedEthereumHeightRequest > ,) -> Result < tonic :: Response < super :: QueryEstimatedEthereumHeightResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/EstimatedEthereumHeight") ; self . inner . unary (request . into_request () , path , codec) . await } pub async fn transfer_minimums (& mut self , request : impl tonic :: IntoRequest < super :: QueryTransferMinimumsRequest > ,) -> Result < tonic :: Response < super :: QueryTransferMinimumsResponse > , tonic :: Status > { self . inner . ready () . await . map_err (| e | { tonic :: Status :: new (tonic :: Code :: Unknown , format ! ("Service was not ready: {}" , e . into ())) }) ? ; let codec = tonic :: codec :: ProstCodec :: default () ; let path = http :: uri :: PathAndQuery :: from_static ("/gravity.v1.Query/TransferMinimums") ; self . inner . unary (request . into_request () , path , codec) . await } } impl < T : Clone > Clone for QueryClient < T > { fn clone (& self) -> Self { Self { inner : self . inner . clone () , } } } impl < T > std :: fmt :: Debug for QueryClient < T > { fn fmt (& self , f : & mut std :: fmt :: Formatter < '_ >) -> std :: fmt :: Result { write ! (f , "QueryClient {{ ... }}") } } }
\ No newline at end of file
@@ -98,6 +98,9 @@ impl Into<gravity_proto::gravity::OutgoingLogicCall> for &LogicCall {
             // note the dummy value, this is not used in signatures
             // so it's not required for our evidence based slashing implementation
             block: 0,
+            // the escrowing sender is only tracked on the Cosmos side for refunds
+            // and is likewise not part of the signed payload
+            sender: String::new(),
         }
     }
 }
@@ -2,7 +2,8 @@
 //! that can only be run by a validator. This single binary the 'Orchestrator' runs not only these two rules but also the untrusted role of a relayer, that does not need any permissions and has it's
 //! own crate and binary so that anyone may run it.
 
-use crate::{ethereum_event_watcher::check_for_events, oracle_resync::get_last_checked_block};
+use crate::ethereum_event_watcher::{check_for_events, get_block_delay};
+use crate::oracle_resync::get_last_checked_block;
 use clarity::{address::Address as EthAddress, Uint256};
 use clarity::{utils::bytes_to_hex_str, PrivateKey as EthPrivateKey};
 use cosmos_gravity::{
@@ -10,13 +11,16 @@ use cosmos_gravity::{
         get_oldest_unsigned_logic_call, get_oldest_unsigned_transaction_batch,
         get_oldest_unsigned_valsets,
     },
-    send::{send_batch_confirm, send_logic_call_confirm, send_valset_confirms},
+    send::{
+        send_batch_confirm, send_ethereum_height_claim, send_logic_call_confirm,
+        send_valset_confirms,
+    },
 };
 use deep_space::error::CosmosGrpcError;
 use deep_space::Contact;
 use deep_space::{client::ChainStatus, utils::FeeInfo};
 use deep_space::{coin::Coin, private_key::PrivateKey as CosmosPrivateKey};
-use ethereum_gravity::utils::get_gravity_id;
+use ethereum_gravity::utils::{downcast_uint256, get_gravity_id};
 use futures::future::join;
 use futures::future::join3;
 use gravity_proto::cosmos_sdk_proto::cosmos::base::abci::v1beta1::TxResponse;
@@ -35,6 +39,9 @@ use web30::client::Web3;
 /// loop except the relayer loop
 pub const ETH_SIGNER_LOOP_SPEED: Duration = Duration::from_secs(11);
 pub const ETH_ORACLE_LOOP_SPEED: Duration = Duration::from_secs(13);
+/// How often the oracle claims the latest Ethereum block height, so that the chain sees
+/// Ethereum advance even when there are no events to relay
+pub const ETH_HEIGHT_HEARTBEAT_INTERVAL: Duration = Duration::from_secs(120);
 
 /// This loop combines the three major roles required to make
 /// up the 'Orchestrator', all three of these are async loops
@@ -115,6 +122,9 @@ pub async fn eth_oracle_main_loop(
     let mut last_checked_event: Uint256 = 0u8.into();
     info!("Oracle resync complete, Oracle now operational");
     let mut grpc_client = grpc_client;
+    // the heartbeat only claims blocks as deep as the events we relay
+    let block_delay = get_block_delay(&web3).await;
+    let mut last_height_heartbeat: Option<Instant> = None;
 
     loop {
         let loop_start = Instant::now();
@@ -122,13 +132,14 @@ pub async fn eth_oracle_main_loop(
         let latest_eth_block = web3.eth_block_number().await;
         let latest_cosmos_block = contact.get_chain_status().await;
 
-        match (latest_eth_block, latest_cosmos_block) {
+        let latest_eth_block = match (latest_eth_block, latest_cosmos_block) {
             (Ok(latest_eth_block), Ok(ChainStatus::Moving { block_height })) => {
                 trace!(
                     "Latest Eth block {} Latest Cosmos block {}",
                     latest_eth_block,
                     block_height,
                 );
+                latest_eth_block
             }
             (Ok(_latest_eth_block), Ok(ChainStatus::Syncing)) => {
                 warn!("Cosmos node syncing, Eth oracle paused");
@@ -155,6 +166,28 @@ pub async fn eth_oracle_main_loop(
                 delay_for(DELAY).await;
                 continue;
             }
+        };
+
+        // Lets Cosmos know how far Ethereum has advanced, even if nothing happened on it
+        let heartbeat_due = match last_height_heartbeat {
+            Some(last) => Instant::now() - last >= ETH_HEIGHT_HEARTBEAT_INTERVAL,
+            None => true,
+        };
+        if heartbeat_due && latest_eth_block > block_delay {
+            match downcast_uint256(latest_eth_block - block_delay.clone()) {
+                Some(height) => {
+                    match send_ethereum_height_claim(&contact, cosmos_key, height, fee.clone())
+                        .await
+                    {
+                        Ok(_) => {
+                            trace!("Claimed Ethereum height {}", height);
+                            last_height_heartbeat = Some(Instant::now());
+                        }
+                        Err(e) => warn!("Failed to claim Ethereum height {} {:?}", height, e),
+                    }
+                }
+                None => error!("Ethereum block height does not fit in a u64!"),
+            }
         }
 
         // Relays events from Ethereum -> Cosmos
@@ -1,10 +1,12 @@
 //! This test verifies that orchestrator keys are correctly set from the genesis file and set
-//! on chain start. Currently it is not possible to rotate orchestrator keys live as the key rotation
-//! logic has not been implemented. If at some point in the future that is implemented it would be
-//! checked in this test
+//! on chain start. It then rotates the delegate keys of one validator live with MsgRotateDelegateKeys
+//! and checks that the new keys resolve to that validator
 
-use crate::utils::ValidatorKeys;
+use crate::utils::{get_user_key, ValidatorKeys};
+use crate::{get_fee, TOTAL_TIMEOUT};
 use clarity::Address as EthAddress;
+use cosmos_gravity::query::get_gravity_params;
+use cosmos_gravity::send::rotate_gravity_delegate_keys;
 use deep_space::address::Address as CosmosAddress;
 use deep_space::Contact;
 use gravity_proto::gravity::{
@@ -24,28 +26,65 @@ pub async fn orch_keys(
     for k in keys.iter() {
         let eth_address = k.eth_key.to_public_key().unwrap();
         let orch_address = k.orch_key.to_address(&contact.get_prefix()).unwrap();
-        let eth_response = grpc_client
-            .get_delegate_key_by_eth(QueryDelegateKeysByEthAddress {
-                eth_address: eth_address.to_string(),
-            })
-            .await
-            .unwrap()
-            .into_inner();
+        check_delegate_keys(&mut grpc_client, eth_address, orch_address).await;
+    }
+
+    info!("About to rotate the delegate addresses of the first validator");
+    let new_keys = get_user_key();
+    let current_orch_address = keys[0].orch_key.to_address(&contact.get_prefix()).unwrap();
+    let params = get_gravity_params(&mut grpc_client)
+        .await
+        .expect("Failed to get Gravity params");
+    let res = rotate_gravity_delegate_keys(
+        contact,
+        Some(new_keys.eth_key),
+        Some(new_keys.cosmos_address),
+        current_orch_address,
+        keys[0].validator_key,
+        params.gravity_id,
+        get_fee(),
+    )
+    .await
+    .expect("Failed to rotate delegate keys");
+    contact
+        .wait_for_tx(res, TOTAL_TIMEOUT)
+        .await
+        .expect("Failed to rotate delegate keys");
+    check_delegate_keys(
+        &mut grpc_client,
+        new_keys.eth_address,
+        new_keys.cosmos_address,
+    )
+    .await;
+    info!("Successfully rotated the delegate addresses of the first validator");
+}
 
-        let parsed_response_orch_address: CosmosAddress =
-            eth_response.orchestrator_address.parse().unwrap();
-        assert_eq!(parsed_response_orch_address, orch_address);
+async fn check_delegate_keys(
+    grpc_client: &mut GravityQueryClient<Channel>,
+    eth_address: EthAddress,
+    orch_address: CosmosAddress,
+) {
+    let eth_response = grpc_client
+        .get_delegate_key_by_eth(QueryDelegateKeysByEthAddress {
+            eth_address: eth_address.to_string(),
+        })
+        .await
+        .unwrap()
+        .into_inner();
 
-        let orchestrator_response = grpc_client
-            .get_delegate_key_by_orchestrator(QueryDelegateKeysByOrchestratorAddress {
-                orchestrator_address: orch_address.to_string(),
-            })
-            .await
-            .unwrap()
-            .into_inner();
+    let parsed_response_orch_address: CosmosAddress =
+        eth_response.orchestrator_address.parse().unwrap();
+    assert_eq!(parsed_response_orch_address, orch_address);
 
-        let parsed_response_eth_address: EthAddress =
-            orchestrator_response.eth_address.parse().unwrap();
-        assert_eq!(parsed_response_eth_address, eth_address);
-    }
+    let orchestrator_response = grpc_client
+        .get_delegate_key_by_orchestrator(QueryDelegateKeysByOrchestratorAddress {
+            orchestrator_address: orch_address.to_string(),
+        })
+        .await
+        .unwrap()
+        .into_inner();
+
+    let parsed_response_eth_address: EthAddress =
+        orchestrator_response.eth_address.parse().unwrap();
+    assert_eq!(parsed_response_eth_address, eth_address);
 }
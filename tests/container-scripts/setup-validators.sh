@@ -41,7 +41,8 @@ ARGS="$GAIA_HOME --keyring-backend test"
 # Generate a validator key, orchestrator key, and eth key for each validator
 $BIN keys add $ARGS validator$i 2>> /validator-phrases
 $BIN keys add $ARGS orchestrator$i 2>> /orchestrator-phrases
-$BIN eth_keys add >> /validator-eth-keys
+# the eth key is stored in the validator home so that gentx can sign the delegate keys with it
+$BIN eth_keys add $GAIA_HOME >> /validator-eth-keys
 
 VALIDATOR_KEY=$($BIN keys show validator$i -a $ARGS)
 ORCHESTRATOR_KEY=$($BIN keys show orchestrator$i -a $ARGS)